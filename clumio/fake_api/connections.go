@@ -0,0 +1,107 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the handlers of the AWS connection and AWS environment endpoints of the fake Clumio API.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// createAwsConnection serves POST /connections/aws. The AWS environment of the connection is
+// created along with it. A single connection can exist per AWS account and region.
+func (s *Server) createAwsConnection(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "account_native_id", "aws_region") {
+		return
+	}
+	if connections := s.collections[AwsConnections].list(filter{
+		"account_native_id": {"$eq": body["account_native_id"]},
+		"aws_region":        {"$eq": body["aws_region"]},
+	}); len(connections) > 0 {
+		writeError(w, http.StatusConflict, fmt.Sprintf(
+			"A connection to AWS account %v in region %v already exists.",
+			body["account_native_id"], body["aws_region"]))
+		return
+	}
+
+	id := s.newId()
+	connection := clone(body)
+	connection["id"] = id
+	connection["token"] = s.newId()
+	connection["namespace"] = "clumio"
+	connection["clumio_aws_account_id"] = ClumioAwsAccountId
+	connection["clumio_aws_region"] = body["aws_region"]
+	connection["external_id"] = ""
+	connection["data_plane_account_id"] = ""
+	connection["connection_status"] = "connecting"
+	connection["organizational_unit_id"] = organizationalUnit(r)
+	s.collections[AwsConnections].put(id, connection)
+
+	environmentId := s.newId()
+	s.collections[AwsEnvironments].put(environmentId, object{
+		"id":                     environmentId,
+		"account_native_id":      body["account_native_id"],
+		"aws_region":             body["aws_region"],
+		"connection_id":          id,
+		"connection_status":      "connecting",
+		"organizational_unit_id": organizationalUnit(r),
+	})
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path+"/"+id, connection))
+}
+
+// listAwsConnections serves GET /connections/aws.
+func (s *Server) listAwsConnections(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, AwsConnections, nil)
+}
+
+// readAwsConnection serves GET /connections/aws/{connection_id}.
+func (s *Server) readAwsConnection(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, AwsConnections, "connection_id")
+}
+
+// updateAwsConnection serves PATCH /connections/aws/{connection_id}. Only the description of a
+// connection can be updated.
+func (s *Server) updateAwsConnection(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("connection_id")
+	connection, ok := s.collections[AwsConnections].get(id)
+	if !ok {
+		writeNotFound(w, AwsConnections, id)
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil {
+		return
+	}
+	if description, ok := body["description"]; ok {
+		connection["description"] = description
+	}
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path, connection))
+}
+
+// deleteAwsConnection serves DELETE /connections/aws/{connection_id}. The AWS environment of the
+// connection is deleted along with it.
+func (s *Server) deleteAwsConnection(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("connection_id")
+	if !s.collections[AwsConnections].remove(id) {
+		writeNotFound(w, AwsConnections, id)
+		return
+	}
+	for _, environment := range s.collections[AwsEnvironments].list(filter{
+		"connection_id": {"$eq": id},
+	}) {
+		s.collections[AwsEnvironments].remove(fmt.Sprint(environment["id"]))
+	}
+	writeJSON(w, http.StatusOK, object{})
+}
+
+// listAwsEnvironments serves GET /datasources/aws/environments.
+func (s *Server) listAwsEnvironments(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, AwsEnvironments, nil)
+}
+
+// readAwsEnvironment serves GET /datasources/aws/environments/{environment_id}.
+func (s *Server) readAwsEnvironment(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, AwsEnvironments, "environment_id")
+}
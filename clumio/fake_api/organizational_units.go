@@ -0,0 +1,307 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the handlers of the organizational unit and user endpoints of the fake Clumio API.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+)
+
+// createOrganizationalUnit serves POST /organizational-units. The organizational unit is created
+// right away, under the organizational unit of the request if no parent is given, and the task
+// returned completes without further changes.
+func (s *Server) createOrganizationalUnit(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "name") {
+		return
+	}
+	parentId := organizationalUnit(r)
+	if body["parent_id"] != nil && body["parent_id"] != "" {
+		parentId = fmt.Sprint(body["parent_id"])
+	}
+	if _, ok := s.collections[OrganizationalUnits].get(parentId); !ok {
+		writeError(w, http.StatusBadRequest, "The request is invalid.", fieldError{
+			Field: "parent_id",
+			ErrorMessage: fmt.Sprintf(
+				"The organizational unit with ID %s was not found.", parentId),
+		})
+		return
+	}
+
+	id := s.newId()
+	ou := clone(body)
+	ou["id"] = id
+	ou["parent_id"] = parentId
+	ou["children_count"] = 0
+	ou["user_count"] = 0
+	ou["users"] = []any{}
+	ou["configured_datasource_types"] = []any{}
+	ou["descendant_ids"] = []any{}
+	s.collections[OrganizationalUnits].put(id, ou)
+	s.updateOrganizationalUnitTree()
+
+	res := withLinks(r.URL.Path+"/"+id, ou)
+	res["task_id"] = s.newTask("create_organizational_unit", nil)
+	writeJSON(w, http.StatusAccepted, res)
+}
+
+// listOrganizationalUnits serves GET /organizational-units.
+func (s *Server) listOrganizationalUnits(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, OrganizationalUnits, nil)
+}
+
+// readOrganizationalUnit serves GET /organizational-units/{id}.
+func (s *Server) readOrganizationalUnit(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, OrganizationalUnits, "id")
+}
+
+// patchOrganizationalUnit serves PATCH /organizational-units/{id}. The update is applied right
+// away.
+func (s *Server) patchOrganizationalUnit(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	ou, ok := s.collections[OrganizationalUnits].get(id)
+	if !ok {
+		writeNotFound(w, OrganizationalUnits, id)
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil {
+		return
+	}
+	for _, field := range []string{"name", "description"} {
+		if value, ok := body[field]; ok && value != nil {
+			ou[field] = value
+		}
+	}
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path, ou))
+}
+
+// deleteOrganizationalUnit serves DELETE /organizational-units/{id}. The organizational unit is
+// deleted when the task returned completes. The root organizational unit and the organizational
+// units which have children cannot be deleted.
+func (s *Server) deleteOrganizationalUnit(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.collections[OrganizationalUnits].get(id); !ok {
+		writeNotFound(w, OrganizationalUnits, id)
+		return
+	}
+	if id == RootOrganizationalUnitId {
+		writeError(w, http.StatusBadRequest, "The root organizational unit cannot be deleted.")
+		return
+	}
+	children := s.collections[OrganizationalUnits].list(filter{"parent_id": {"$eq": id}})
+	if len(children) > 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(
+			"The organizational unit with ID %s has %d children.", id, len(children)))
+		return
+	}
+
+	taskId := s.newTask("delete_organizational_unit", func() {
+		s.collections[OrganizationalUnits].remove(id)
+		s.updateOrganizationalUnitTree()
+	})
+	writeJSON(w, http.StatusAccepted, object{"task_id": taskId})
+}
+
+// updateOrganizationalUnitTree updates the children count and descendant IDs of every
+// organizational unit.
+func (s *Server) updateOrganizationalUnitTree() {
+	ous := s.collections[OrganizationalUnits]
+	children := map[string][]string{}
+	for _, ou := range ous.list(filter{}) {
+		if parentId, ok := ou["parent_id"].(string); ok {
+			children[parentId] = append(children[parentId], fmt.Sprint(ou["id"]))
+		}
+	}
+	var descendants func(id string) []any
+	descendants = func(id string) []any {
+		ids := make([]any, 0)
+		for _, childId := range children[id] {
+			ids = append(ids, childId)
+			ids = append(ids, descendants(childId)...)
+		}
+		return ids
+	}
+	for _, ou := range ous.list(filter{}) {
+		id := fmt.Sprint(ou["id"])
+		ou["children_count"] = len(children[id])
+		ou["descendant_ids"] = descendants(id)
+	}
+}
+
+// createUser serves POST /users.
+func (s *Server) createUser(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "email", "full_name") {
+		return
+	}
+	if users := s.collections[Users].list(filter{
+		"email": {"$eq": body["email"]},
+	}); len(users) > 0 {
+		writeError(w, http.StatusConflict,
+			fmt.Sprintf("A user with email %v already exists.", body["email"]))
+		return
+	}
+
+	accessControl, _ := body["access_control_configuration"].([]any)
+	if accessControl == nil {
+		accessControl = []any{}
+	}
+	id := s.newNumericId()
+	user := object{
+		"id":                           id,
+		"email":                        body["email"],
+		"full_name":                    body["full_name"],
+		"access_control_configuration": accessControl,
+		"inviter":                      "1",
+		"is_confirmed":                 false,
+		"is_enabled":                   true,
+		"last_activity_timestamp":      "",
+	}
+	s.setUserCounts(user)
+	s.collections[Users].put(id, user)
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path+"/"+id, user))
+}
+
+// listUsers serves GET /users.
+func (s *Server) listUsers(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, Users, nil)
+}
+
+// readUser serves GET /users/{user_id}.
+func (s *Server) readUser(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, Users, "user_id")
+}
+
+// updateUser serves PATCH /users/{user_id}. The organizational units of the roles given in
+// access_control_configuration_updates are added to, or removed from, those of the user.
+func (s *Server) updateUser(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("user_id")
+	user, ok := s.collections[Users].get(id)
+	if !ok {
+		writeNotFound(w, Users, id)
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil {
+		return
+	}
+	for _, field := range []string{"full_name", "is_enabled"} {
+		if value, ok := body[field]; ok && value != nil {
+			user[field] = value
+		}
+	}
+
+	// Apply the updates of the roles as a map of the organizational unit IDs of every role.
+	roles := map[string][]string{}
+	var roleIds []string
+	for _, role := range roleAssignments(user["access_control_configuration"]) {
+		roleId := fmt.Sprint(role["role_id"])
+		roleIds = append(roleIds, roleId)
+		roles[roleId] = stringSlice(role["organizational_unit_ids"])
+	}
+	updates := toObject(body["access_control_configuration_updates"])
+	for _, role := range roleAssignments(updates["add"]) {
+		roleId := fmt.Sprint(role["role_id"])
+		if _, ok := roles[roleId]; !ok {
+			roleIds = append(roleIds, roleId)
+		}
+		for _, ouId := range stringSlice(role["organizational_unit_ids"]) {
+			if !slices.Contains(roles[roleId], ouId) {
+				roles[roleId] = append(roles[roleId], ouId)
+			}
+		}
+	}
+	for _, role := range roleAssignments(updates["remove"]) {
+		roleId := fmt.Sprint(role["role_id"])
+		for _, ouId := range stringSlice(role["organizational_unit_ids"]) {
+			roles[roleId] = slices.DeleteFunc(roles[roleId], func(id string) bool {
+				return id == ouId
+			})
+		}
+	}
+	assignments := make([]any, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		if len(roles[roleId]) == 0 {
+			continue
+		}
+		ouIds := make([]any, 0, len(roles[roleId]))
+		for _, ouId := range roles[roleId] {
+			ouIds = append(ouIds, ouId)
+		}
+		assignments = append(assignments, object{
+			"role_id":                 roleId,
+			"organizational_unit_ids": ouIds,
+		})
+	}
+	user["access_control_configuration"] = assignments
+	s.setUserCounts(user)
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path, user))
+}
+
+// deleteUser serves DELETE /users/{user_id}.
+func (s *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("user_id")
+	user, ok := s.collections[Users].get(id)
+	if !ok {
+		writeNotFound(w, Users, id)
+		return
+	}
+	user["access_control_configuration"] = []any{}
+	s.setUserCounts(user)
+	s.collections[Users].remove(id)
+	writeJSON(w, http.StatusOK, object{})
+}
+
+// setUserCounts sets the organizational unit count of the given user and updates the users of the
+// organizational units from its roles.
+func (s *Server) setUserCounts(user object) {
+	userId := fmt.Sprint(user["id"])
+	ouRoles := map[string]string{}
+	for _, role := range roleAssignments(user["access_control_configuration"]) {
+		for _, ouId := range stringSlice(role["organizational_unit_ids"]) {
+			ouRoles[ouId] = fmt.Sprint(role["role_id"])
+		}
+	}
+	user["organizational_unit_count"] = len(ouRoles)
+
+	for _, ou := range s.collections[OrganizationalUnits].list(filter{}) {
+		users := make([]any, 0)
+		for _, ouUser := range roleAssignments(ou["users"]) {
+			if fmt.Sprint(ouUser["user_id"]) != userId {
+				users = append(users, ouUser)
+			}
+		}
+		if roleId, ok := ouRoles[fmt.Sprint(ou["id"])]; ok {
+			users = append(users, object{"user_id": userId, "assigned_role": roleId})
+		}
+		ou["users"] = users
+		ou["user_count"] = len(users)
+	}
+}
+
+// roleAssignments returns the given list of objects, such as the access control configuration of a
+// user, as a slice of objects.
+func roleAssignments(value any) []object {
+	values, _ := value.([]any)
+	objects := make([]object, 0, len(values))
+	for _, value := range values {
+		if obj := toObject(value); obj != nil {
+			objects = append(objects, obj)
+		}
+	}
+	return objects
+}
+
+// stringSlice returns the given list of values as a slice of strings.
+func stringSlice(value any) []string {
+	values, _ := value.([]any)
+	strs := make([]string, 0, len(values))
+	for _, value := range values {
+		strs = append(strs, fmt.Sprint(value))
+	}
+	return strs
+}
@@ -0,0 +1,260 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the handlers of the policy definition, policy rule and policy assignment endpoints of
+// the fake Clumio API.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const (
+	// lockStatusUnlocked is the lock status of a policy which is not being updated.
+	lockStatusUnlocked = "unlocked"
+	// lockStatusUpdating is the lock status of a policy while it is being updated.
+	lockStatusUpdating = "updating"
+)
+
+// createPolicy serves POST /policies/definitions. The policy is created right away and the task
+// returned completes without further changes.
+func (s *Server) createPolicy(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "name", "operations") {
+		return
+	}
+
+	id := s.newId()
+	policy := clone(body)
+	policy["id"] = id
+	policy["lock_status"] = lockStatusUnlocked
+	policy["organizational_unit_id"] = organizationalUnit(r)
+	if policy["activation_status"] == nil {
+		policy["activation_status"] = "activated"
+	}
+	s.collections[Policies].put(id, policy)
+
+	res := withLinks(r.URL.Path+"/"+id, policy)
+	res["task_id"] = s.newTask("policy_create", nil)
+	writeJSON(w, http.StatusAccepted, res)
+}
+
+// listPolicies serves GET /policies/definitions.
+func (s *Server) listPolicies(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, Policies, nil)
+}
+
+// readPolicy serves GET /policies/definitions/{policy_id}.
+func (s *Server) readPolicy(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, Policies, "policy_id")
+}
+
+// updatePolicy serves PUT /policies/definitions/{policy_id}. The policy is locked until the task
+// returned completes, at which point the update is applied.
+func (s *Server) updatePolicy(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("policy_id")
+	policy, ok := s.collections[Policies].get(id)
+	if !ok {
+		writeNotFound(w, Policies, id)
+		return
+	}
+	if policy["lock_status"] != lockStatusUnlocked {
+		writeError(w, http.StatusConflict,
+			fmt.Sprintf("The policy with ID %s is locked by another update.", id))
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "name", "operations") {
+		return
+	}
+
+	policy["lock_status"] = lockStatusUpdating
+	taskId := s.newTask("policy_update", func() {
+		merge(policy, body)
+		policy["lock_status"] = lockStatusUnlocked
+	})
+	res := withLinks(r.URL.Path, policy)
+	res["task_id"] = taskId
+	writeJSON(w, http.StatusAccepted, res)
+}
+
+// deletePolicy serves DELETE /policies/definitions/{policy_id}. The policy is deleted when the task
+// returned completes. A policy which is assigned to a protection group cannot be deleted.
+func (s *Server) deletePolicy(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("policy_id")
+	if _, ok := s.collections[Policies].get(id); !ok {
+		writeNotFound(w, Policies, id)
+		return
+	}
+	assigned := filter{"protection_info.policy_id": {"$eq": id}, "is_deleted": {"$eq": false}}
+	if groups := s.collections[ProtectionGroups].list(assigned); len(groups) > 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf(
+			"The policy with ID %s is assigned to %d protection groups.", id, len(groups)))
+		return
+	}
+
+	taskId := s.newTask("policy_delete", func() {
+		s.collections[Policies].remove(id)
+	})
+	writeJSON(w, http.StatusAccepted, object{"task_id": taskId})
+}
+
+// createPolicyRule serves POST /policies/rules. The policy rule is created right away and the task
+// returned completes without further changes.
+func (s *Server) createPolicyRule(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil ||
+		!requireFields(w, body, "name", "condition", "action.assign_policy.policy_id") {
+		return
+	}
+	if !s.requirePolicyOfRule(w, body) {
+		return
+	}
+
+	id := s.newId()
+	rule := clone(body)
+	rule["id"] = id
+	rule["organizational_unit_id"] = organizationalUnit(r)
+	s.collections[PolicyRules].put(id, rule)
+
+	writeJSON(w, http.StatusAccepted, object{
+		"rule":    withLinks(r.URL.Path+"/"+id, rule),
+		"task_id": s.newTask("policy_rule_create", nil),
+	})
+}
+
+// listPolicyRules serves GET /policies/rules.
+func (s *Server) listPolicyRules(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, PolicyRules, nil)
+}
+
+// readPolicyRule serves GET /policies/rules/{rule_id}.
+func (s *Server) readPolicyRule(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, PolicyRules, "rule_id")
+}
+
+// updatePolicyRule serves PUT /policies/rules/{rule_id}. The update is applied when the task
+// returned completes.
+func (s *Server) updatePolicyRule(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("rule_id")
+	rule, ok := s.collections[PolicyRules].get(id)
+	if !ok {
+		writeNotFound(w, PolicyRules, id)
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil ||
+		!requireFields(w, body, "name", "condition", "action.assign_policy.policy_id") {
+		return
+	}
+	if !s.requirePolicyOfRule(w, body) {
+		return
+	}
+
+	taskId := s.newTask("policy_rule_update", func() {
+		merge(rule, body)
+		if body["priority"] == nil {
+			delete(rule, "priority")
+		}
+	})
+	writeJSON(w, http.StatusAccepted, object{
+		"rule":    withLinks(r.URL.Path, rule),
+		"task_id": taskId,
+	})
+}
+
+// deletePolicyRule serves DELETE /policies/rules/{rule_id}. The policy rule is deleted when the
+// task returned completes.
+func (s *Server) deletePolicyRule(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("rule_id")
+	if _, ok := s.collections[PolicyRules].get(id); !ok {
+		writeNotFound(w, PolicyRules, id)
+		return
+	}
+	taskId := s.newTask("policy_rule_delete", func() {
+		s.collections[PolicyRules].remove(id)
+	})
+	writeJSON(w, http.StatusAccepted, object{"task_id": taskId})
+}
+
+// requirePolicyOfRule writes an error and returns false if the policy assigned by the given policy
+// rule does not exist.
+func (s *Server) requirePolicyOfRule(w http.ResponseWriter, rule object) bool {
+	policyId, _ := lookup(rule, "action.assign_policy.policy_id")
+	if _, ok := s.collections[Policies].get(fmt.Sprint(policyId)); ok {
+		return true
+	}
+	writeError(w, http.StatusBadRequest, "The request is invalid.", fieldError{
+		Field:        "action.assign_policy.policy_id",
+		ErrorMessage: fmt.Sprintf("The policy with ID %v was not found.", policyId),
+	})
+	return false
+}
+
+// setPolicyAssignments serves POST /policies/assignments. The policies are assigned to, or
+// unassigned from, the protection groups when the task returned completes. An empty policy ID
+// unassigns the policy.
+func (s *Server) setPolicyAssignments(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "items") {
+		return
+	}
+	items, ok := body["items"].([]any)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "The request is invalid.", fieldError{
+			Field: "items", ErrorMessage: "This field must be a list.",
+		})
+		return
+	}
+
+	type assignment struct {
+		group    object
+		policyId string
+	}
+	var assignments []assignment
+	for i, item := range items {
+		input, _ := item.(map[string]any)
+		entityType, _ := lookup(input, "entity.type")
+		entityId, _ := lookup(input, "entity.id")
+		if entityType != "protection_group" {
+			writeError(w, http.StatusBadRequest, "The request is invalid.", fieldError{
+				Field: fmt.Sprintf("items[%d].entity.type", i),
+				ErrorMessage: fmt.Sprintf(
+					"The fake Clumio API does not support assignments to %v.", entityType),
+			})
+			return
+		}
+		group, ok := s.collections[ProtectionGroups].get(fmt.Sprint(entityId))
+		if !ok || group["is_deleted"] == true {
+			writeNotFound(w, ProtectionGroups, fmt.Sprint(entityId))
+			return
+		}
+		policyId := ""
+		if input["action"] == "assign" {
+			policyId = fmt.Sprint(input["policy_id"])
+			if _, ok := s.collections[Policies].get(policyId); !ok {
+				writeNotFound(w, Policies, policyId)
+				return
+			}
+		}
+		assignments = append(assignments, assignment{group: group, policyId: policyId})
+	}
+
+	taskId := s.newTask("policy_assignment", func() {
+		for _, assignment := range assignments {
+			if assignment.policyId == "" {
+				assignment.group["protection_info"] = nil
+				assignment.group["protection_status"] = "unprotected"
+				continue
+			}
+			assignment.group["protection_info"] = object{
+				"policy_id":              assignment.policyId,
+				"inheriting_entity_id":   nil,
+				"inheriting_entity_type": nil,
+			}
+			assignment.group["protection_status"] = "protected"
+		}
+	})
+	writeJSON(w, http.StatusAccepted, object{"task_id": taskId})
+}
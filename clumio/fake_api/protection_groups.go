@@ -0,0 +1,217 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the handlers of the protection group, protection group S3 asset and S3 bucket endpoints
+// of the fake Clumio API.
+
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// notDeleted is the filter matching the protection groups and S3 assets which are not deleted.
+var notDeleted = filter{"is_deleted": {"$eq": false}}
+
+// createProtectionGroup serves POST /datasources/protection-groups.
+func (s *Server) createProtectionGroup(w http.ResponseWriter, r *http.Request) {
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "name") {
+		return
+	}
+	if groups := s.collections[ProtectionGroups].list(filter{
+		"name":       {"$eq": body["name"]},
+		"is_deleted": {"$eq": false},
+	}); len(groups) > 0 {
+		writeError(w, http.StatusConflict,
+			fmt.Sprintf("A protection group named %v already exists.", body["name"]))
+		return
+	}
+
+	id := s.newId()
+	group := clone(body)
+	group["id"] = id
+	group["organizational_unit_id"] = organizationalUnit(r)
+	group["is_deleted"] = false
+	group["protection_info"] = nil
+	group["protection_status"] = "unprotected"
+	group["bucket_count"] = 0
+	s.collections[ProtectionGroups].put(id, group)
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path+"/"+id, group))
+}
+
+// listProtectionGroups serves GET /datasources/protection-groups. Deleted protection groups are not
+// listed.
+func (s *Server) listProtectionGroups(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, ProtectionGroups, notDeleted)
+}
+
+// readProtectionGroup serves GET /datasources/protection-groups/{group_id}. Deleted protection
+// groups are returned with is_deleted set, as they are by the Clumio API.
+func (s *Server) readProtectionGroup(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, ProtectionGroups, "group_id")
+}
+
+// updateProtectionGroup serves PUT /datasources/protection-groups/{group_id}.
+func (s *Server) updateProtectionGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.activeProtectionGroup(w, r)
+	if !ok {
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "name") {
+		return
+	}
+	merge(group, body)
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path, group))
+}
+
+// deleteProtectionGroup serves DELETE /datasources/protection-groups/{group_id}. The protection
+// group and its S3 assets are marked deleted.
+func (s *Server) deleteProtectionGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.activeProtectionGroup(w, r)
+	if !ok {
+		return
+	}
+	group["is_deleted"] = true
+	for _, asset := range s.collections[ProtectionGroupS3Assets].list(filter{
+		"group_id": {"$eq": group["id"]},
+	}) {
+		asset["is_deleted"] = true
+	}
+	writeJSON(w, http.StatusOK, object{})
+}
+
+// addBucketToProtectionGroup serves POST /datasources/protection-groups/{group_id}/buckets. The S3
+// asset of the bucket in the protection group is created and returned.
+func (s *Server) addBucketToProtectionGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.activeProtectionGroup(w, r)
+	if !ok {
+		return
+	}
+	body := decodeBody(w, r)
+	if body == nil || !requireFields(w, body, "bucket_id") {
+		return
+	}
+	bucketId := fmt.Sprint(body["bucket_id"])
+	bucket, ok := s.collections[S3Buckets].get(bucketId)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "The request is invalid.", fieldError{
+			Field:        "bucket_id",
+			ErrorMessage: fmt.Sprintf("The S3 bucket with ID %s was not found.", bucketId),
+		})
+		return
+	}
+	if assets := s.collections[ProtectionGroupS3Assets].list(filter{
+		"group_id":   {"$eq": group["id"]},
+		"bucket_id":  {"$eq": bucketId},
+		"is_deleted": {"$eq": false},
+	}); len(assets) > 0 {
+		writeError(w, http.StatusConflict, fmt.Sprintf(
+			"The S3 bucket with ID %s is already in the protection group.", bucketId))
+		return
+	}
+
+	id := s.newId()
+	asset := object{
+		"id":                     id,
+		"bucket_id":              bucketId,
+		"bucket_name":            bucket["name"],
+		"group_id":               group["id"],
+		"protection_group_id":    group["id"],
+		"group_name":             group["name"],
+		"account_native_id":      bucket["account_native_id"],
+		"aws_region":             bucket["aws_region"],
+		"organizational_unit_id": group["organizational_unit_id"],
+		"is_deleted":             false,
+		"protection_info":        clone(toObject(group["protection_info"])),
+	}
+	s.collections[ProtectionGroupS3Assets].put(id, asset)
+	group["bucket_count"] = s.bucketCount(group)
+	bucket["protection_group_count"] = s.protectionGroupCount(bucketId)
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path+"/"+bucketId, asset))
+}
+
+// deleteBucketFromProtectionGroup serves
+// DELETE /datasources/protection-groups/{group_id}/buckets/{bucket_id}. The S3 asset of the bucket
+// in the protection group is marked deleted.
+func (s *Server) deleteBucketFromProtectionGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.activeProtectionGroup(w, r)
+	if !ok {
+		return
+	}
+	bucketId := r.PathValue("bucket_id")
+	assets := s.collections[ProtectionGroupS3Assets].list(filter{
+		"group_id":   {"$eq": group["id"]},
+		"bucket_id":  {"$eq": bucketId},
+		"is_deleted": {"$eq": false},
+	})
+	if len(assets) == 0 {
+		writeNotFound(w, ProtectionGroupS3Assets, bucketId)
+		return
+	}
+	for _, asset := range assets {
+		asset["is_deleted"] = true
+	}
+	group["bucket_count"] = s.bucketCount(group)
+	if bucket, ok := s.collections[S3Buckets].get(bucketId); ok {
+		bucket["protection_group_count"] = s.protectionGroupCount(bucketId)
+	}
+	writeJSON(w, http.StatusOK, object{})
+}
+
+// listProtectionGroupS3Assets serves GET /datasources/protection-groups/s3-assets. Deleted S3
+// assets are not listed.
+func (s *Server) listProtectionGroupS3Assets(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, ProtectionGroupS3Assets, notDeleted)
+}
+
+// readProtectionGroupS3Asset serves GET /datasources/protection-groups/s3-assets/{asset_id}.
+func (s *Server) readProtectionGroupS3Asset(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, ProtectionGroupS3Assets, "asset_id")
+}
+
+// listS3Buckets serves GET /datasources/aws/s3-buckets.
+func (s *Server) listS3Buckets(w http.ResponseWriter, r *http.Request) {
+	s.list(w, r, S3Buckets, nil)
+}
+
+// readS3Bucket serves GET /datasources/aws/s3-buckets/{bucket_id}.
+func (s *Server) readS3Bucket(w http.ResponseWriter, r *http.Request) {
+	s.read(w, r, S3Buckets, "bucket_id")
+}
+
+// activeProtectionGroup returns the protection group with the ID given in the path of the given
+// request. An error is written and false returned if it does not exist or is deleted.
+func (s *Server) activeProtectionGroup(w http.ResponseWriter, r *http.Request) (object, bool) {
+	id := r.PathValue("group_id")
+	group, ok := s.collections[ProtectionGroups].get(id)
+	if !ok || group["is_deleted"] == true {
+		writeNotFound(w, ProtectionGroups, id)
+		return nil, false
+	}
+	return group, true
+}
+
+// bucketCount returns the number of S3 buckets in the given protection group.
+func (s *Server) bucketCount(group object) int {
+	return len(s.collections[ProtectionGroupS3Assets].list(filter{
+		"group_id":   {"$eq": group["id"]},
+		"is_deleted": {"$eq": false},
+	}))
+}
+
+// protectionGroupCount returns the number of protection groups the S3 bucket with the given ID is
+// in.
+func (s *Server) protectionGroupCount(bucketId string) int {
+	return len(s.collections[ProtectionGroupS3Assets].list(filter{
+		"bucket_id":  {"$eq": bucketId},
+		"is_deleted": {"$eq": false},
+	}))
+}
+
+// toObject returns the given value as an object, or nil if it is not one.
+func toObject(value any) object {
+	obj, _ := value.(map[string]any)
+	return obj
+}
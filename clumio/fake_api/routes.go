@@ -0,0 +1,77 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the routes of the fake Clumio API.
+
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// registerRoutes registers the handlers of the endpoints of the Clumio API implemented by the fake
+// on the given mux.
+func (s *Server) registerRoutes(mux *http.ServeMux) {
+
+	// Tasks.
+	mux.Handle("GET /tasks/{task_id}", s.handle(s.readTask))
+
+	// Policies, policy rules and policy assignments.
+	mux.Handle("POST /policies/definitions", s.handle(s.createPolicy))
+	mux.Handle("GET /policies/definitions", s.handle(s.listPolicies))
+	mux.Handle("GET /policies/definitions/{policy_id}", s.handle(s.readPolicy))
+	mux.Handle("PUT /policies/definitions/{policy_id}", s.handle(s.updatePolicy))
+	mux.Handle("DELETE /policies/definitions/{policy_id}", s.handle(s.deletePolicy))
+	mux.Handle("POST /policies/rules", s.handle(s.createPolicyRule))
+	mux.Handle("GET /policies/rules", s.handle(s.listPolicyRules))
+	mux.Handle("GET /policies/rules/{rule_id}", s.handle(s.readPolicyRule))
+	mux.Handle("PUT /policies/rules/{rule_id}", s.handle(s.updatePolicyRule))
+	mux.Handle("DELETE /policies/rules/{rule_id}", s.handle(s.deletePolicyRule))
+	mux.Handle("POST /policies/assignments", s.handle(s.setPolicyAssignments))
+
+	// Protection groups, their S3 assets and the S3 buckets.
+	mux.Handle("POST /datasources/protection-groups", s.handle(s.createProtectionGroup))
+	mux.Handle("GET /datasources/protection-groups", s.handle(s.listProtectionGroups))
+	mux.Handle("GET /datasources/protection-groups/{group_id}", s.handle(s.readProtectionGroup))
+	mux.Handle("PUT /datasources/protection-groups/{group_id}",
+		s.handle(s.updateProtectionGroup))
+	mux.Handle("DELETE /datasources/protection-groups/{group_id}",
+		s.handle(s.deleteProtectionGroup))
+	mux.Handle("POST /datasources/protection-groups/{group_id}/buckets",
+		s.handle(s.addBucketToProtectionGroup))
+	mux.Handle("DELETE /datasources/protection-groups/{group_id}/buckets/{bucket_id}",
+		s.handle(s.deleteBucketFromProtectionGroup))
+	mux.Handle("GET /datasources/protection-groups/s3-assets",
+		s.handle(s.listProtectionGroupS3Assets))
+	mux.Handle("GET /datasources/protection-groups/s3-assets/{asset_id}",
+		s.handle(s.readProtectionGroupS3Asset))
+	mux.Handle("GET /datasources/aws/s3-buckets", s.handle(s.listS3Buckets))
+	mux.Handle("GET /datasources/aws/s3-buckets/{bucket_id}", s.handle(s.readS3Bucket))
+
+	// Organizational units and users.
+	mux.Handle("POST /organizational-units", s.handle(s.createOrganizationalUnit))
+	mux.Handle("GET /organizational-units", s.handle(s.listOrganizationalUnits))
+	mux.Handle("GET /organizational-units/{id}", s.handle(s.readOrganizationalUnit))
+	mux.Handle("PATCH /organizational-units/{id}", s.handle(s.patchOrganizationalUnit))
+	mux.Handle("DELETE /organizational-units/{id}", s.handle(s.deleteOrganizationalUnit))
+	mux.Handle("POST /users", s.handle(s.createUser))
+	mux.Handle("GET /users", s.handle(s.listUsers))
+	mux.Handle("GET /users/{user_id}", s.handle(s.readUser))
+	mux.Handle("PATCH /users/{user_id}", s.handle(s.updateUser))
+	mux.Handle("DELETE /users/{user_id}", s.handle(s.deleteUser))
+
+	// AWS connections and environments.
+	mux.Handle("POST /connections/aws", s.handle(s.createAwsConnection))
+	mux.Handle("GET /connections/aws", s.handle(s.listAwsConnections))
+	mux.Handle("GET /connections/aws/{connection_id}", s.handle(s.readAwsConnection))
+	mux.Handle("PATCH /connections/aws/{connection_id}", s.handle(s.updateAwsConnection))
+	mux.Handle("DELETE /connections/aws/{connection_id}", s.handle(s.deleteAwsConnection))
+	mux.Handle("GET /datasources/aws/environments", s.handle(s.listAwsEnvironments))
+	mux.Handle("GET /datasources/aws/environments/{environment_id}",
+		s.handle(s.readAwsEnvironment))
+
+	// Endpoints which the fake does not implement.
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotImplemented,
+			"The fake Clumio API does not implement "+r.Method+" "+r.URL.Path+".")
+	})
+}
@@ -0,0 +1,262 @@
+// Copyright 2025. Clumio, Inc.
+
+// Package fakeapi provides an in-process fake of the Clumio REST API which the acceptance tests of
+// the provider run against when built with the "fake" tag. The fake keeps the policies, policy
+// rules, policy assignments, protection groups and their S3 assets, S3 buckets, organizational
+// units, users and AWS connections in memory and runs the operations which the Clumio API runs
+// asynchronously as tasks which complete after being polled.
+//
+// The fake only implements the subset of the API used by the provider and does not validate the
+// requests beyond their required fields. Objects are stored as the JSON sent by the provider, so
+// that the fields it reads back are the fields it wrote, along with the fields set by Clumio.
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// Token is the API token accepted by the fake Clumio API.
+	Token = "fake-clumio-api-token"
+
+	// RootOrganizationalUnitId is the ID of the root organizational unit, which always exists.
+	RootOrganizationalUnitId = "00000000-0000-0000-0000-000000000000"
+
+	// ClumioAwsAccountId is the AWS account of Clumio returned for the AWS connections.
+	ClumioAwsAccountId = "111111111111"
+
+	// ouContextHeader is the header holding the organizational unit the request is made in.
+	ouContextHeader = "X-Clumio-Organizationalunit-Context"
+
+	// defaultTaskPolls is the number of times a task is reported in progress before it completes.
+	defaultTaskPolls = 1
+)
+
+// Kind is a kind of object stored by the fake Clumio API.
+type Kind string
+
+// Kinds of objects stored by the fake Clumio API.
+const (
+	Policies                Kind = "policies"
+	PolicyRules             Kind = "policy_rules"
+	ProtectionGroups        Kind = "protection_groups"
+	ProtectionGroupS3Assets Kind = "protection_group_s3_assets"
+	S3Buckets               Kind = "s3_buckets"
+	OrganizationalUnits     Kind = "organizational_units"
+	Users                   Kind = "users"
+	AwsConnections          Kind = "aws_connections"
+	AwsEnvironments         Kind = "aws_environments"
+)
+
+// Option configures the fake Clumio API.
+type Option func(*Server)
+
+// WithTaskPolls sets the number of times a task is reported in progress before it completes.
+func WithTaskPolls(polls int) Option {
+	return func(s *Server) {
+		s.taskPolls = polls
+	}
+}
+
+// Server is the fake Clumio API. It must be closed once it is no longer used.
+type Server struct {
+	// URL is the base URL of the fake Clumio API, to be used as clumio_api_base_url.
+	URL string
+
+	server      *httptest.Server
+	mu          sync.Mutex
+	collections map[Kind]*collection
+	tasks       map[string]*task
+	failures    []taskFailure
+	taskPolls   int
+	lastId      int
+}
+
+// NewServer starts and returns a fake Clumio API holding the root organizational unit and no other
+// objects.
+func NewServer(options ...Option) *Server {
+	s := &Server{
+		collections: map[Kind]*collection{},
+		tasks:       map[string]*task{},
+		taskPolls:   defaultTaskPolls,
+	}
+	for _, kind := range []Kind{Policies, PolicyRules, ProtectionGroups, ProtectionGroupS3Assets,
+		S3Buckets, OrganizationalUnits, Users, AwsConnections, AwsEnvironments} {
+		s.collections[kind] = newCollection()
+	}
+	for _, option := range options {
+		option(s)
+	}
+	s.collections[OrganizationalUnits].put(RootOrganizationalUnitId, object{
+		"id":                          RootOrganizationalUnitId,
+		"name":                        "Global Organizational Unit",
+		"description":                 "",
+		"children_count":              0,
+		"user_count":                  0,
+		"users":                       []any{},
+		"configured_datasource_types": []any{},
+		"descendant_ids":              []any{},
+	})
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+	s.server = httptest.NewServer(s.authenticate(mux))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the fake Clumio API.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Object returns a copy of the object of the given kind with the given ID.
+func (s *Server) Object(kind Kind, id string) (map[string]any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.collections[kind].get(id)
+	if !ok {
+		return nil, false
+	}
+	return clone(obj), true
+}
+
+// Objects returns a copy of every object of the given kind in the order they were created in.
+func (s *Server) Objects(kind Kind) []map[string]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	objects := make([]map[string]any, 0)
+	for _, obj := range s.collections[kind].list(filter{}) {
+		objects = append(objects, clone(obj))
+	}
+	return objects
+}
+
+// UpdateObject sets the given fields on the object of the given kind with the given ID, as if it
+// was changed outside of Terraform, and returns whether the object exists. Fields set to nil are
+// removed.
+func (s *Server) UpdateObject(kind Kind, id string, fields map[string]any) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.collections[kind].get(id)
+	if !ok {
+		return false
+	}
+	merge(obj, fields)
+	return true
+}
+
+// DeleteObject removes the object of the given kind with the given ID, as if it was deleted outside
+// of Terraform, and returns whether the object existed.
+func (s *Server) DeleteObject(kind Kind, id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.collections[kind].remove(id)
+}
+
+// AddS3Bucket adds an S3 bucket discovered in the given AWS account and region and returns its ID.
+// S3 buckets cannot be created through the Clumio API, so the tests must add the buckets they
+// protect.
+func (s *Server) AddS3Bucket(accountNativeId string, awsRegion string, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := s.newId()
+	s.collections[S3Buckets].put(id, object{
+		"id":                     id,
+		"name":                   name,
+		"account_native_id":      accountNativeId,
+		"aws_region":             awsRegion,
+		"organizational_unit_id": RootOrganizationalUnitId,
+		"protection_group_count": 0,
+		"protection_status":      "unprotected",
+	})
+	return id
+}
+
+// FailNextTask makes the next task created by the fake Clumio API fail with the given error
+// message instead of completing. Several calls fail as many tasks, in order.
+func (s *Server) FailNextTask(errorMessage string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, taskFailure{errorMessage: errorMessage})
+}
+
+// newId returns a new unique ID in the format of the Clumio IDs. The caller must hold the lock.
+func (s *Server) newId() string {
+	s.lastId++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.lastId)
+}
+
+// newNumericId returns a new unique numeric ID, in the format of the IDs of the Clumio users. The
+// caller must hold the lock.
+func (s *Server) newNumericId() string {
+	s.lastId++
+	return fmt.Sprintf("%d", s.lastId)
+}
+
+// authenticate returns the handler which rejects the requests not holding the API token of the
+// fake Clumio API before passing them to the given handler.
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+Token {
+			writeError(w, http.StatusUnauthorized, "The API token is missing or invalid.")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// organizationalUnit returns the organizational unit the given request is made in, which is the
+// root organizational unit unless the request holds an organizational unit context.
+func organizationalUnit(r *http.Request) string {
+	if ou := strings.TrimSpace(r.Header.Get(ouContextHeader)); ou != "" {
+		return ou
+	}
+	return RootOrganizationalUnitId
+}
+
+// handle returns the handler which serves the request with the given function while holding the
+// lock of the server.
+func (s *Server) handle(serve func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		serve(w, r)
+	}
+}
+
+// list writes the page of the objects of the given kind matching the filter of the given request
+// and the given extra filter.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, kind Kind, extra filter) {
+	requestFilter, err := parseFilter(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for field, conditions := range extra {
+		requestFilter[field] = conditions
+	}
+	items := s.collections[kind].list(requestFilter)
+	res, err := page(r, items)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+// read writes the object of the given kind with the ID given by the path value with the given
+// name.
+func (s *Server) read(w http.ResponseWriter, r *http.Request, kind Kind, idName string) {
+	id := r.PathValue(idName)
+	obj, ok := s.collections[kind].get(id)
+	if !ok {
+		writeNotFound(w, kind, id)
+		return
+	}
+	writeJSON(w, http.StatusOK, withLinks(r.URL.Path, obj))
+}
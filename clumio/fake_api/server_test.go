@@ -0,0 +1,347 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the fake Clumio API.
+
+//go:build unit
+
+package fakeapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// call makes the request with the given method, path and body to the given fake Clumio API and
+// returns the status code and decoded body of the response.
+func call(t *testing.T, s *Server, method string, path string, body any) (int, map[string]any) {
+	t.Helper()
+
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		assert.Nil(t, err)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req, err := http.NewRequest(method, s.URL+path, reader)
+	assert.Nil(t, err)
+	req.Header.Set("Authorization", "Bearer "+Token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer res.Body.Close()
+
+	decoded := map[string]any{}
+	assert.Nil(t, json.NewDecoder(res.Body).Decode(&decoded))
+	return res.StatusCode, decoded
+}
+
+// waitForTask polls the task with the given ID until it is no longer queued or in progress and
+// returns its final state.
+func waitForTask(t *testing.T, s *Server, taskId any) map[string]any {
+	t.Helper()
+
+	for range 10 {
+		status, task := call(t, s, http.MethodGet, "/tasks/"+taskId.(string), nil)
+		assert.Equal(t, http.StatusOK, status)
+		if task["status"] != taskQueued && task["status"] != taskInProgress {
+			return task
+		}
+	}
+	t.Fatalf("task %v did not complete", taskId)
+	return nil
+}
+
+// Unit test for the authentication of the fake Clumio API for the following cases:
+//   - Requests without the API token are rejected with the error envelope of the Clumio API.
+//   - Endpoints which are not implemented return an error.
+func TestServerAuthentication(t *testing.T) {
+
+	s := NewServer()
+	defer s.Close()
+
+	t.Run("Requests without the token are rejected", func(t *testing.T) {
+		res, err := http.Get(s.URL + "/policies/definitions")
+		assert.Nil(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+		body := map[string]any{}
+		assert.Nil(t, json.NewDecoder(res.Body).Decode(&body))
+		assert.Len(t, body["errors"], 1)
+	})
+
+	t.Run("Unknown endpoints return an error", func(t *testing.T) {
+		status, _ := call(t, s, http.MethodGet, "/unknown", nil)
+		assert.Equal(t, http.StatusNotImplemented, status)
+	})
+}
+
+// Unit test for the policy endpoints of the fake Clumio API for the following cases:
+//   - Policies are created right away in the organizational unit of the request.
+//   - Updates are applied when their task completes and lock the policy until then.
+//   - Deletions are applied when their task completes.
+//   - Tasks fail with the error message given to FailNextTask.
+//   - Policies which are assigned to a protection group cannot be deleted.
+func TestServerPolicies(t *testing.T) {
+
+	s := NewServer()
+	defer s.Close()
+	operations := []any{map[string]any{"type": "protection_group_backup"}}
+
+	status, created := call(t, s, http.MethodPost, "/policies/definitions", map[string]any{
+		"name":       "policy",
+		"operations": operations,
+	})
+	assert.Equal(t, http.StatusAccepted, status)
+	id := created["id"].(string)
+	assert.NotEmpty(t, created["task_id"])
+	assert.Equal(t, "activated", created["activation_status"])
+	assert.Equal(t, RootOrganizationalUnitId, created["organizational_unit_id"])
+
+	t.Run("Missing fields are reported as field errors", func(t *testing.T) {
+		status, res := call(t, s, http.MethodPost, "/policies/definitions", map[string]any{})
+		assert.Equal(t, http.StatusBadRequest, status)
+		apiErr := res["errors"].([]any)[0].(map[string]any)
+		assert.Len(t, apiErr["field_errors"], 2)
+	})
+
+	t.Run("Updates are applied when the task completes", func(t *testing.T) {
+		status, res := call(t, s, http.MethodPut, "/policies/definitions/"+id, map[string]any{
+			"name":       "renamed",
+			"operations": operations,
+		})
+		assert.Equal(t, http.StatusAccepted, status)
+
+		_, policy := call(t, s, http.MethodGet, "/policies/definitions/"+id, nil)
+		assert.Equal(t, "policy", policy["name"])
+		assert.Equal(t, lockStatusUpdating, policy["lock_status"])
+		status, _ = call(t, s, http.MethodPut, "/policies/definitions/"+id, map[string]any{
+			"name":       "renamed",
+			"operations": operations,
+		})
+		assert.Equal(t, http.StatusConflict, status)
+
+		task := waitForTask(t, s, res["task_id"])
+		assert.Equal(t, taskCompleted, task["status"])
+		_, policy = call(t, s, http.MethodGet, "/policies/definitions/"+id, nil)
+		assert.Equal(t, "renamed", policy["name"])
+		assert.Equal(t, lockStatusUnlocked, policy["lock_status"])
+	})
+
+	t.Run("Failed tasks report their error", func(t *testing.T) {
+		s.FailNextTask("Injected failure.")
+		_, res := call(t, s, http.MethodPut, "/policies/definitions/"+id, map[string]any{
+			"name":       "failed",
+			"operations": operations,
+		})
+		task := waitForTask(t, s, res["task_id"])
+		assert.Equal(t, taskFailed, task["status"])
+		assert.Equal(t, "Injected failure.", task["error_message"])
+		_, policy := call(t, s, http.MethodGet, "/policies/definitions/"+id, nil)
+		assert.Equal(t, "renamed", policy["name"])
+	})
+
+	t.Run("Assigned policies cannot be deleted", func(t *testing.T) {
+		_, group := call(t, s, http.MethodPost, "/datasources/protection-groups",
+			map[string]any{"name": "group"})
+		groupId := group["id"].(string)
+		_, res := call(t, s, http.MethodPost, "/policies/assignments", map[string]any{
+			"items": []any{map[string]any{
+				"action":    "assign",
+				"policy_id": id,
+				"entity":    map[string]any{"id": groupId, "type": "protection_group"},
+			}},
+		})
+		waitForTask(t, s, res["task_id"])
+		_, group = call(t, s, http.MethodGet, "/datasources/protection-groups/"+groupId, nil)
+		assert.Equal(t, id, group["protection_info"].(map[string]any)["policy_id"])
+
+		status, _ := call(t, s, http.MethodDelete, "/policies/definitions/"+id, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		_, res = call(t, s, http.MethodPost, "/policies/assignments", map[string]any{
+			"items": []any{map[string]any{
+				"action":    "unassign",
+				"policy_id": "",
+				"entity":    map[string]any{"id": groupId, "type": "protection_group"},
+			}},
+		})
+		waitForTask(t, s, res["task_id"])
+		_, group = call(t, s, http.MethodGet, "/datasources/protection-groups/"+groupId, nil)
+		assert.Nil(t, group["protection_info"])
+	})
+
+	t.Run("Deletions are applied when the task completes", func(t *testing.T) {
+		status, res := call(t, s, http.MethodDelete, "/policies/definitions/"+id, nil)
+		assert.Equal(t, http.StatusAccepted, status)
+		status, _ = call(t, s, http.MethodGet, "/policies/definitions/"+id, nil)
+		assert.Equal(t, http.StatusOK, status)
+
+		waitForTask(t, s, res["task_id"])
+		status, _ = call(t, s, http.MethodGet, "/policies/definitions/"+id, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+// Unit test for the list endpoints of the fake Clumio API for the following cases:
+//   - Items are filtered by the filter query parameter.
+//   - Items are paginated with the limit and start query parameters and the link to the next page.
+func TestServerList(t *testing.T) {
+
+	s := NewServer()
+	defer s.Close()
+	for _, name := range []string{"bucket-1", "bucket-2", "bucket-3", "other"} {
+		s.AddS3Bucket("123456789012", "us-west-2", name)
+	}
+
+	t.Run("Items are filtered", func(t *testing.T) {
+		query := url.Values{"filter": {`{"name":{"$begins_with":"bucket"}}`}}
+		_, res := call(t, s, http.MethodGet, "/datasources/aws/s3-buckets?"+query.Encode(), nil)
+		assert.Equal(t, float64(3), res["total_count"])
+
+		query = url.Values{"filter": {`{"name":{"$in":["bucket-2","other"]}}`}}
+		_, res = call(t, s, http.MethodGet, "/datasources/aws/s3-buckets?"+query.Encode(), nil)
+		assert.Equal(t, float64(2), res["total_count"])
+	})
+
+	t.Run("Items are paginated", func(t *testing.T) {
+		var names []any
+		path := "/datasources/aws/s3-buckets?limit=3"
+		for path != "" {
+			status, res := call(t, s, http.MethodGet, path, nil)
+			assert.Equal(t, http.StatusOK, status)
+			for _, item := range res["_embedded"].(map[string]any)["items"].([]any) {
+				names = append(names, item.(map[string]any)["name"])
+			}
+			path = ""
+			if next, ok := res["_links"].(map[string]any)["_next"]; ok {
+				path = next.(map[string]any)["href"].(string)
+			}
+		}
+		assert.Equal(t, []any{"bucket-1", "bucket-2", "bucket-3", "other"}, names)
+	})
+}
+
+// Unit test for the protection group endpoints of the fake Clumio API for the following cases:
+//   - Buckets added to a protection group are returned as its S3 assets.
+//   - Deleted protection groups and S3 assets are returned with is_deleted set.
+//   - Changes made with UpdateObject and DeleteObject are returned by the API.
+func TestServerProtectionGroups(t *testing.T) {
+
+	s := NewServer()
+	defer s.Close()
+	bucketId := s.AddS3Bucket("123456789012", "us-west-2", "bucket")
+
+	_, group := call(t, s, http.MethodPost, "/datasources/protection-groups",
+		map[string]any{"name": "group", "description": "description"})
+	groupId := group["id"].(string)
+	assert.Equal(t, false, group["is_deleted"])
+
+	status, asset := call(t, s, http.MethodPost,
+		"/datasources/protection-groups/"+groupId+"/buckets", map[string]any{"bucket_id": bucketId})
+	assert.Equal(t, http.StatusOK, status)
+	assetId := asset["id"].(string)
+	assert.Equal(t, groupId, asset["group_id"])
+
+	query := url.Values{
+		"filter": {`{"protection_group_id":{"$eq":"` + groupId + `"},"bucket_id":{"$eq":"` +
+			bucketId + `"}}`},
+	}
+	_, res := call(t, s, http.MethodGet,
+		"/datasources/protection-groups/s3-assets?"+query.Encode(), nil)
+	assert.Equal(t, float64(1), res["total_count"])
+
+	t.Run("Changes made outside of Terraform are returned", func(t *testing.T) {
+		assert.True(t, s.UpdateObject(ProtectionGroups, groupId,
+			map[string]any{"description": "changed"}))
+		_, group := call(t, s, http.MethodGet, "/datasources/protection-groups/"+groupId, nil)
+		assert.Equal(t, "changed", group["description"])
+		obj, ok := s.Object(ProtectionGroups, groupId)
+		assert.True(t, ok)
+		assert.Equal(t, float64(1), obj["bucket_count"])
+	})
+
+	t.Run("Deleted objects are marked deleted", func(t *testing.T) {
+		status, _ := call(t, s, http.MethodDelete,
+			"/datasources/protection-groups/"+groupId+"/buckets/"+bucketId, nil)
+		assert.Equal(t, http.StatusOK, status)
+		_, asset := call(t, s, http.MethodGet,
+			"/datasources/protection-groups/s3-assets/"+assetId, nil)
+		assert.Equal(t, true, asset["is_deleted"])
+
+		status, _ = call(t, s, http.MethodDelete, "/datasources/protection-groups/"+groupId, nil)
+		assert.Equal(t, http.StatusOK, status)
+		_, group := call(t, s, http.MethodGet, "/datasources/protection-groups/"+groupId, nil)
+		assert.Equal(t, true, group["is_deleted"])
+
+		assert.True(t, s.DeleteObject(ProtectionGroups, groupId))
+		status, _ = call(t, s, http.MethodGet, "/datasources/protection-groups/"+groupId, nil)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+}
+
+// Unit test for the organizational unit and user endpoints of the fake Clumio API for the
+// following cases:
+//   - Organizational units are created under the organizational unit of the request.
+//   - The roles of users are added to and removed from the users of the organizational units.
+//   - Organizational units which have children cannot be deleted.
+func TestServerOrganizationalUnitsAndUsers(t *testing.T) {
+
+	s := NewServer()
+	defer s.Close()
+
+	status, parent := call(t, s, http.MethodPost, "/organizational-units",
+		map[string]any{"name": "parent"})
+	assert.Equal(t, http.StatusAccepted, status)
+	parentId := parent["id"].(string)
+	assert.Equal(t, RootOrganizationalUnitId, parent["parent_id"])
+	_, child := call(t, s, http.MethodPost, "/organizational-units",
+		map[string]any{"name": "child", "parent_id": parentId})
+	childId := child["id"].(string)
+
+	_, root := call(t, s, http.MethodGet, "/organizational-units/"+RootOrganizationalUnitId, nil)
+	assert.Equal(t, []any{parentId, childId}, root["descendant_ids"])
+
+	_, user := call(t, s, http.MethodPost, "/users", map[string]any{
+		"email":     "user@example.com",
+		"full_name": "User",
+		"access_control_configuration": []any{map[string]any{
+			"role_id": "role", "organizational_unit_ids": []any{parentId},
+		}},
+	})
+	userId := user["id"].(string)
+	assert.Equal(t, float64(1), user["organizational_unit_count"])
+	_, ou := call(t, s, http.MethodGet, "/organizational-units/"+parentId, nil)
+	assert.Equal(t, float64(1), ou["user_count"])
+
+	_, user = call(t, s, http.MethodPatch, "/users/"+userId, map[string]any{
+		"access_control_configuration_updates": map[string]any{
+			"add": []any{map[string]any{
+				"role_id": "role", "organizational_unit_ids": []any{childId},
+			}},
+			"remove": []any{map[string]any{
+				"role_id": "role", "organizational_unit_ids": []any{parentId},
+			}},
+		},
+	})
+	assert.Equal(t, []any{map[string]any{
+		"role_id": "role", "organizational_unit_ids": []any{childId},
+	}}, user["access_control_configuration"])
+	_, ou = call(t, s, http.MethodGet, "/organizational-units/"+parentId, nil)
+	assert.Equal(t, float64(0), ou["user_count"])
+
+	status, _ = call(t, s, http.MethodDelete, "/organizational-units/"+parentId, nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	_, res := call(t, s, http.MethodDelete, "/organizational-units/"+childId, nil)
+	waitForTask(t, s, res["task_id"])
+	_, parent = call(t, s, http.MethodGet, "/organizational-units/"+parentId, nil)
+	assert.Equal(t, float64(0), parent["children_count"])
+}
@@ -0,0 +1,311 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the in-memory store of the fake Clumio API along with the helpers to filter, paginate
+// and write its objects.
+
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultLimit is the number of items returned per page when a list request has no limit.
+	defaultLimit = 100
+)
+
+// object is an object of the fake Clumio API, held as its decoded JSON representation.
+type object = map[string]any
+
+// collection holds the objects of a kind, by ID, in the order they were created in.
+type collection struct {
+	objects map[string]object
+	ids     []string
+}
+
+// newCollection returns an empty collection.
+func newCollection() *collection {
+	return &collection{objects: map[string]object{}}
+}
+
+// get returns the object with the given ID.
+func (c *collection) get(id string) (object, bool) {
+	obj, ok := c.objects[id]
+	return obj, ok
+}
+
+// put stores the given object under the given ID, replacing the object already stored if any.
+func (c *collection) put(id string, obj object) {
+	if _, ok := c.objects[id]; !ok {
+		c.ids = append(c.ids, id)
+	}
+	c.objects[id] = obj
+}
+
+// remove removes the object with the given ID and returns whether it was stored.
+func (c *collection) remove(id string) bool {
+	if _, ok := c.objects[id]; !ok {
+		return false
+	}
+	delete(c.objects, id)
+	for i, storedId := range c.ids {
+		if storedId == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// list returns the objects matching the given filter in the order they were created in.
+func (c *collection) list(filter filter) []object {
+	items := make([]object, 0, len(c.ids))
+	for _, id := range c.ids {
+		if obj := c.objects[id]; filter.matches(obj) {
+			items = append(items, obj)
+		}
+	}
+	return items
+}
+
+// filter is the decoded filter query parameter of a list request, such as
+// {"name":{"$contains":"prod"}}.
+type filter map[string]map[string]any
+
+// parseFilter decodes the filter query parameter of the given request. An empty filter is returned
+// if the request has none.
+func parseFilter(r *http.Request) (filter, error) {
+	raw := r.URL.Query().Get("filter")
+	if raw == "" {
+		return filter{}, nil
+	}
+	parsed := filter{}
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %v", raw, err)
+	}
+	return parsed, nil
+}
+
+// matches returns whether the given object matches every condition of the filter. Fields are
+// looked up by their dotted path. Operators which the fake API does not support are ignored.
+func (f filter) matches(obj object) bool {
+	for field, conditions := range f {
+		value, found := lookup(obj, field)
+		for operator, expected := range conditions {
+			if !matchCondition(operator, value, found, expected) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchCondition returns whether the given value matches the condition with the given operator and
+// expected value.
+func matchCondition(operator string, value any, found bool, expected any) bool {
+	actual := fmt.Sprint(value)
+	switch operator {
+	case "$eq":
+		return found && value != nil && actual == fmt.Sprint(expected)
+	case "$not_eq":
+		return !found || value == nil || actual != fmt.Sprint(expected)
+	case "$contains":
+		return found && value != nil && strings.Contains(actual, fmt.Sprint(expected))
+	case "$not_contains":
+		return !found || value == nil || !strings.Contains(actual, fmt.Sprint(expected))
+	case "$begins_with":
+		return found && value != nil && strings.HasPrefix(actual, fmt.Sprint(expected))
+	case "$in":
+		return found && value != nil && containsValue(expected, actual)
+	case "$not_in":
+		return !found || value == nil || !containsValue(expected, actual)
+	}
+	return true
+}
+
+// containsValue returns whether the given list of expected values holds the given value.
+func containsValue(expected any, actual string) bool {
+	values, ok := expected.([]any)
+	if !ok {
+		return false
+	}
+	for _, value := range values {
+		if fmt.Sprint(value) == actual {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the value of the field of the given object with the given dotted path.
+func lookup(obj object, path string) (any, bool) {
+	var value any = obj
+	for _, key := range strings.Split(path, ".") {
+		nested, ok := value.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = nested[key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// clone returns a deep copy of the given object, so that the objects of the store are never
+// shared with the callers of the Server or with the responses being written.
+func clone(obj object) object {
+	if obj == nil {
+		return nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(fmt.Sprintf("fake Clumio API object cannot be encoded: %v", err))
+	}
+	copied := object{}
+	if err := json.Unmarshal(data, &copied); err != nil {
+		panic(fmt.Sprintf("fake Clumio API object cannot be decoded: %v", err))
+	}
+	return copied
+}
+
+// merge sets the given fields on the given object. Fields set to nil are removed.
+func merge(obj object, fields map[string]any) {
+	for key, value := range clone(fields) {
+		if value == nil {
+			delete(obj, key)
+			continue
+		}
+		obj[key] = value
+	}
+}
+
+// page returns the list response holding the page of the given items requested by the limit and
+// start query parameters of the given request. The start token is the 1-based number of the page
+// and the link to the next page is set unless the page is the last one.
+func page(r *http.Request, items []object) (object, error) {
+	query := r.URL.Query()
+	limit := defaultLimit
+	if raw := query.Get("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			return nil, fmt.Errorf("invalid limit %q", raw)
+		}
+		limit = parsed
+	}
+	start := 1
+	if raw := query.Get("start"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 {
+			return nil, fmt.Errorf("invalid start %q", raw)
+		}
+		start = parsed
+	}
+
+	total := len(items)
+	first := min((start-1)*limit, total)
+	last := min(first+limit, total)
+	pageItems := make([]any, 0, last-first)
+	for _, item := range items[first:last] {
+		selfPath := fmt.Sprintf("%s/%v", strings.TrimSuffix(r.URL.Path, "/"), item["id"])
+		pageItems = append(pageItems, withLinks(selfPath, item))
+	}
+	links := object{
+		"_self":  link(r.URL.Path, limit, start),
+		"_first": link(r.URL.Path, limit, 1),
+	}
+	if last < total {
+		links["_next"] = link(r.URL.Path, limit, start+1)
+	}
+	return object{
+		"_embedded":         object{"items": pageItems},
+		"_links":            links,
+		"current_count":     len(pageItems),
+		"total_count":       total,
+		"total_pages_count": (total + limit - 1) / limit,
+		"limit":             limit,
+		"start":             strconv.Itoa(start),
+	}, nil
+}
+
+// link returns the link to the page with the given limit and start of the given list path.
+func link(path string, limit int, start int) object {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("start", strconv.Itoa(start))
+	return object{"href": path + "?" + query.Encode(), "templated": false, "type": "get"}
+}
+
+// withLinks returns a copy of the given object with the link to itself at the given path set, as
+// it is in the responses of the Clumio API.
+func withLinks(selfPath string, obj object) object {
+	copied := clone(obj)
+	copied["_links"] = object{
+		"_self": object{"href": selfPath, "templated": false, "type": "get"},
+	}
+	return copied
+}
+
+// fieldError is an error of a field of a request body.
+type fieldError struct {
+	Field        string `json:"field"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// writeJSON writes the given body as the JSON response with the given status code.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes the error envelope of the Clumio API with the given status code, message and
+// field errors.
+func writeError(w http.ResponseWriter, status int, message string, fieldErrors ...fieldError) {
+	apiErr := object{"error_code": status, "error_message": message}
+	if len(fieldErrors) > 0 {
+		apiErr["field_errors"] = fieldErrors
+	}
+	writeJSON(w, status, object{"errors": []any{apiErr}})
+}
+
+// writeNotFound writes the error returned when the object with the given ID does not exist.
+func writeNotFound(w http.ResponseWriter, kind Kind, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("The %s with ID %s was not found.",
+		strings.TrimSuffix(strings.ReplaceAll(string(kind), "_", " "), "s"), id))
+}
+
+// decodeBody decodes the JSON body of the given request. An error is written and nil returned if
+// the body is not a JSON object.
+func decodeBody(w http.ResponseWriter, r *http.Request) object {
+	body := object{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %v", err))
+		return nil
+	}
+	return body
+}
+
+// requireFields writes an error and returns false if any of the given fields of the given body is
+// missing or empty.
+func requireFields(w http.ResponseWriter, body object, fields ...string) bool {
+	var fieldErrors []fieldError
+	for _, field := range fields {
+		if value, ok := lookup(body, field); !ok || value == nil || value == "" {
+			fieldErrors = append(fieldErrors, fieldError{
+				Field:        field,
+				ErrorMessage: "This field is required.",
+			})
+		}
+	}
+	if len(fieldErrors) == 0 {
+		return true
+	}
+	writeError(w, http.StatusBadRequest, "The request is invalid.", fieldErrors...)
+	return false
+}
@@ -0,0 +1,102 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the tasks of the fake Clumio API, which run the operations the Clumio API runs
+// asynchronously.
+
+package fakeapi
+
+import (
+	"net/http"
+)
+
+// Statuses of the tasks.
+const (
+	taskQueued     = "queued"
+	taskInProgress = "in_progress"
+	taskCompleted  = "completed"
+	taskFailed     = "failed"
+)
+
+// task is an operation run asynchronously. The task is queued when it is created, is reported in
+// progress the number of times configured by WithTaskPolls and then completes, at which point its
+// changes are applied. Until then, the objects are returned as they were before the operation.
+type task struct {
+	id       string
+	taskType string
+	status   string
+	polls    int
+	apply    func()
+	failure  *taskFailure
+}
+
+// taskFailure is the failure injected with FailNextTask.
+type taskFailure struct {
+	errorMessage string
+}
+
+// newTask creates a task of the given type which runs the given function when it completes and
+// returns its ID. The caller must hold the lock.
+func (s *Server) newTask(taskType string, apply func()) string {
+	t := &task{
+		id:       s.newNumericId(),
+		taskType: taskType,
+		status:   taskQueued,
+		apply:    apply,
+	}
+	if len(s.failures) > 0 {
+		failure := s.failures[0]
+		t.failure = &failure
+		s.failures = s.failures[1:]
+	}
+	s.tasks[t.id] = t
+	return t.id
+}
+
+// poll advances the task by one poll. The caller must hold the lock.
+func (t *task) poll(taskPolls int) {
+	if t.status == taskCompleted || t.status == taskFailed {
+		return
+	}
+	t.polls++
+	switch {
+	case t.polls <= taskPolls:
+		t.status = taskInProgress
+	case t.failure != nil:
+		t.status = taskFailed
+	default:
+		if t.apply != nil {
+			t.apply()
+		}
+		t.status = taskCompleted
+	}
+}
+
+// response returns the task as returned by the Clumio API.
+func (t *task) response(taskPolls int) object {
+	progress := 100
+	if t.status != taskCompleted {
+		progress = 100 * min(t.polls, taskPolls) / (taskPolls + 1)
+	}
+	res := object{
+		"id":       t.id,
+		"type":     t.taskType,
+		"status":   t.status,
+		"progress": progress,
+	}
+	if t.status == taskFailed {
+		res["error_message"] = t.failure.errorMessage
+	}
+	return res
+}
+
+// readTask serves GET /tasks/{task_id}. Every read polls the task once.
+func (s *Server) readTask(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("task_id")
+	t, ok := s.tasks[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "The task with ID "+id+" was not found.")
+		return
+	}
+	t.poll(s.taskPolls)
+	writeJSON(w, http.StatusOK, t.response(s.taskPolls))
+}
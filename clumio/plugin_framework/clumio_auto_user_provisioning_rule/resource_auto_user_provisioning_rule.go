@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &autoUserProvisioningRuleResource{}
 	_ resource.ResourceWithConfigure   = &autoUserProvisioningRuleResource{}
 	_ resource.ResourceWithImportState = &autoUserProvisioningRuleResource{}
+	_ resource.ResourceWithIdentity    = &autoUserProvisioningRuleResource{}
 )
 
 // autoUserProvisioningRuleResource is the struct backing the clumio_auto_user_provisioning_rules
@@ -50,14 +51,16 @@ func (r *autoUserProvisioningRuleResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *autoUserProvisioningRuleResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkAUPRules = sdkclients.NewAutoUserProvisioningRuleClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkAUPRules = sdkclients.NewAutoUserProvisioningRuleClient(
+		r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -81,6 +84,13 @@ func (r *autoUserProvisioningRuleResource) Create(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, autoUserProvisioningRuleResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -108,6 +118,13 @@ func (r *autoUserProvisioningRuleResource) Read(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, autoUserProvisioningRuleResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -131,6 +148,13 @@ func (r *autoUserProvisioningRuleResource) Update(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, autoUserProvisioningRuleResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -150,9 +174,9 @@ func (r *autoUserProvisioningRuleResource) Delete(
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done using the ID of the resource, given either as the import ID or in the resource identity.
 func (r *autoUserProvisioningRuleResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -27,6 +28,13 @@ type autoUserProvisioningRuleResourceModel struct {
 	OrganizationalUnitIDs types.Set    `tfsdk:"organizational_unit_ids"`
 }
 
+// autoUserProvisioningRuleResourceIdentityModel is the identity model for the
+// clumio_auto_user_provisioning_rule Terraform resource. It identifies the auto user provisioning
+// rule when importing it.
+type autoUserProvisioningRuleResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Schema defines the structure and constraints of the clumio_auto_user_provisioning_rule Terraform
 // resource. Schema is a method on the autoUserProvisioningRuleResource struct. It sets the schema
 // for the clumio_auto_user_provisioning_rule Terraform resource, which is used to create and manage
@@ -77,3 +85,18 @@ func (r *autoUserProvisioningRuleResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_auto_user_provisioning_rule Terraform resource,
+// which is the ID of the auto user provisioning rule.
+func (r *autoUserProvisioningRuleResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the auto user provisioning rule.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
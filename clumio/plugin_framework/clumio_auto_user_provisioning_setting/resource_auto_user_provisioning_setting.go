@@ -11,13 +11,16 @@ import (
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &autoUserProvisioningSettingResource{}
-	_ resource.ResourceWithConfigure = &autoUserProvisioningSettingResource{}
+	_ resource.Resource                = &autoUserProvisioningSettingResource{}
+	_ resource.ResourceWithConfigure   = &autoUserProvisioningSettingResource{}
+	_ resource.ResourceWithImportState = &autoUserProvisioningSettingResource{}
+	_ resource.ResourceWithIdentity    = &autoUserProvisioningSettingResource{}
 )
 
 // autoUserProvisioningSettingResource is the struct backing the clumio_auto_user_provisioning_setting
@@ -49,14 +52,16 @@ func (r *autoUserProvisioningSettingResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *autoUserProvisioningSettingResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkAUPSettings = sdkclients.NewAutoUserProvisioningSettingClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkAUPSettings = sdkclients.NewAutoUserProvisioningSettingClient(
+		r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -80,6 +85,13 @@ func (r *autoUserProvisioningSettingResource) Create(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, autoUserProvisioningSettingResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -103,6 +115,13 @@ func (r *autoUserProvisioningSettingResource) Read(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, autoUserProvisioningSettingResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -126,6 +145,13 @@ func (r *autoUserProvisioningSettingResource) Update(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, autoUserProvisioningSettingResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -143,3 +169,13 @@ func (r *autoUserProvisioningSettingResource) Delete(
 	diags = r.deleteAutoUserProvisioningSetting(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// ImportState retrieves the resource via the Clumio API and sets the Terraform state. As the
+// setting is a singleton for the entire organization and the API does not return an ID, the import
+// ID or the ID given in the identity can be any value and is kept as the ID of the resource.
+func (r *autoUserProvisioningSettingResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root(schemaId), path.Root(schemaId), req, resp)
+}
@@ -8,6 +8,7 @@ package clumio_auto_user_provisioning_setting
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,6 +24,13 @@ type autoUserProvisioningSettingResourceModel struct {
 	IsEnabled types.Bool   `tfsdk:"is_enabled"`
 }
 
+// autoUserProvisioningSettingResourceIdentityModel is the identity model for the
+// clumio_auto_user_provisioning_setting Terraform resource. As the setting is a singleton for the
+// entire organization, it is identified by the ID of the resource.
+type autoUserProvisioningSettingResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Schema defines the structure and constraints of the clumio_auto_user_provisioning_setting
 // Terraform resource. Schema is a method on the autoUserProvisioningSettingResource struct. It sets
 // the schema for the clumio_auto_user_provisioning_setting Terraform resource, which is used to
@@ -48,3 +56,19 @@ func (r *autoUserProvisioningSettingResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_auto_user_provisioning_setting Terraform
+// resource, which is the ID of the resource.
+func (r *autoUserProvisioningSettingResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description: "Unique identifier of the auto user provisioning setting. As the" +
+					" setting is a singleton, any value can be used to import it.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -5,17 +5,20 @@ package clumio_aws_connection
 const (
 	// Constants used by the resource model for the clumio_aws_connection Terraform resource. These
 	// values should match the schema tfsdk tags on the resource model struct in schema.go.
-	schemaId                 = "id"
-	schemaAccountNativeId    = "account_native_id"
-	schemaAwsRegion          = "aws_region"
-	schemaDescription        = "description"
-	schemaConnectionStatus   = "connection_status"
-	schemaToken              = "token"
-	schemaNamespace          = "namespace"
-	schemaClumioAwsAccountId = "clumio_aws_account_id"
-	schemaClumioAwsRegion    = "clumio_aws_region"
-	schemaExternalId         = "role_external_id"
-	schemaDataPlaneAccountId = "data_plane_account_id"
+	schemaId                        = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
+	schemaDeletionProtection        = "deletion_protection"
+	schemaAccountNativeId           = "account_native_id"
+	schemaAwsRegion                 = "aws_region"
+	schemaDescription               = "description"
+	schemaConnectionStatus          = "connection_status"
+	schemaToken                     = "token"
+	schemaNamespace                 = "namespace"
+	schemaClumioAwsAccountId        = "clumio_aws_account_id"
+	schemaClumioAwsRegion           = "clumio_aws_region"
+	schemaExternalId                = "role_external_id"
+	schemaDataPlaneAccountId        = "data_plane_account_id"
+	schemaTimeouts                  = "timeouts"
 
 	awsEnvironment            = "aws_environment"
 	statusConnected           = "connected"
@@ -24,8 +24,10 @@ func (r *clumioAWSConnectionDataSource) readAWSConnection(
 	// Prepare the query filter.
 	accountNativeId := model.AccountNativeID.ValueString()
 	region := model.AWSRegion.ValueString()
-	filter := fmt.Sprintf(`{"account_native_id": {"$in":["%s"]}, "aws_region": {"$in":["%s"]}}`,
-		accountNativeId, region)
+	filter := common.NewFilter().
+		In("account_native_id", []string{accountNativeId}).
+		In("aws_region", []string{region}).
+		String()
 
 	// Call the Clumio API to list the aws connections.
 	res, apiErr := r.awsConnectionClient.ListAwsConnections(nil, nil, &filter)
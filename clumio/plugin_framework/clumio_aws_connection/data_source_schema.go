@@ -20,9 +20,10 @@ import (
 // schema is used by customers to configure the datasource and by the Clumio provider to read and
 // write the datasource.
 type clumioAWSConnectionDataSourceModel struct {
-	Id              types.String `tfsdk:"id"`
-	AccountNativeID types.String `tfsdk:"account_native_id"`
-	AWSRegion       types.String `tfsdk:"aws_region"`
+	Id                        types.String `tfsdk:"id"`
+	AccountNativeID           types.String `tfsdk:"account_native_id"`
+	AWSRegion                 types.String `tfsdk:"aws_region"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
 }
 
 // Schema defines the structure and constraints of the clumio_aws_connection Terraform datasource.
@@ -35,6 +36,15 @@ func (r *clumioAWSConnectionDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" data source is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier of the aws connection.",
 				Computed:    true,
@@ -0,0 +1,68 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the logic to invoke the Clumio AWS Connection SDK API to list the AWS connections
+// matching the filters of the list block of the list resource.
+
+package clumio_aws_connection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listAWSConnections invokes the API to list the AWS connections matching the filters of the given
+// list block.
+func (r *clumioAWSConnectionListResource) listAWSConnections(ctx context.Context,
+	model *clumioAWSConnectionListResourceModel, options common.ListOptions) (
+	[]common.ListItem, diag.Diagnostics) {
+
+	// Prepare the query filter.
+	queryFilter := common.NewFilter()
+	accountNativeId := model.AccountNativeID.ValueString()
+	if accountNativeId != "" {
+		queryFilter.In("account_native_id", []string{accountNativeId})
+	}
+	awsRegion := model.AWSRegion.ValueString()
+	if awsRegion != "" {
+		queryFilter.In("aws_region", []string{awsRegion})
+	}
+	filter := queryFilter.OptionalString()
+
+	// Call the Clumio API to list the AWS connections, reading every page.
+	return common.ListAll(ctx, r.resource.name, options,
+		func(limit *int64, start *string) (*common.Page[common.ListItem], *apiutils.APIError) {
+			res, apiErr := r.resource.sdkConnections.ListAwsConnections(limit, start, filter)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[common.ListItem]{}
+			if res.Embedded != nil {
+				for _, item := range res.Embedded.Items {
+					if item.Id == nil {
+						continue
+					}
+					identity := awsConnectionResourceIdentityModel{
+						AccountNativeID: types.StringPointerValue(item.AccountNativeId),
+						AWSRegion:       types.StringPointerValue(item.AwsRegion),
+					}
+					displayName := fmt.Sprintf("%s/%s",
+						identity.AccountNativeID.ValueString(), identity.AWSRegion.ValueString())
+					page.Items = append(page.Items, common.ListItem{
+						Id:          *item.Id,
+						DisplayName: displayName,
+						Identity:    identity,
+					})
+				}
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+}
@@ -0,0 +1,81 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the list resource implementation for the clumio_aws_connection Terraform list
+// resource. This list resource is used by `terraform query` to list the existing AWS connections
+// within Clumio.
+
+package clumio_aws_connection
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &clumioAWSConnectionListResource{}
+	_ list.ListResourceWithConfigure = &clumioAWSConnectionListResource{}
+)
+
+// clumioAWSConnectionListResource is the struct backing the clumio_aws_connection Terraform list
+// resource. It wraps the clumio_aws_connection resource, whose Clumio API clients are used to list
+// the AWS connections and whose read logic is used to populate the listed resources.
+type clumioAWSConnectionListResource struct {
+	resource *clumioAWSConnectionResource
+}
+
+// NewClumioAWSConnectionListResource creates a new instance of clumioAWSConnectionListResource. Its
+// attributes are initialized later by Terraform via Metadata and Configure once the Provider is
+// initialized.
+func NewClumioAWSConnectionListResource() list.ListResource {
+	return &clumioAWSConnectionListResource{
+		resource: &clumioAWSConnectionResource{},
+	}
+}
+
+// Metadata returns the name of the list resource type, which is the name of the resource it lists.
+func (r *clumioAWSConnectionListResource) Metadata(
+	ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	r.resource.Metadata(ctx, req, resp)
+}
+
+// Configure sets up the list resource with the Clumio API client and any other required state. It
+// is called by Terraform once the Provider is initialized.
+func (r *clumioAWSConnectionListResource) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.resource.Configure(ctx, req, resp)
+}
+
+// List streams the AWS connections matching the filters of the list block to Terraform.
+func (r *clumioAWSConnectionListResource) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+
+	// Retrieve the filters from the list block.
+	var config clumioAWSConnectionListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Make the calls in the context of the organizational unit of the list block, if set.
+	r.resource.configureForOU(ctx, config.OrganizationalUnitContext)
+
+	items, diags := r.listAWSConnections(ctx, &config, common.ListLimit(req))
+	stream.Results = common.ListResults(ctx, req, items, diags,
+		func(ctx context.Context, id string, model *clumioAWSConnectionResourceModel) (
+			bool, diag.Diagnostics) {
+
+			model.ID = types.StringValue(id)
+			model.OrganizationalUnitContext = config.OrganizationalUnitContext
+			return r.resource.readAWSConnection(ctx, model)
+		})
+}
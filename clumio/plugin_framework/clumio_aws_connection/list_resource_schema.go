@@ -0,0 +1,62 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_aws_connection Terraform list resource.
+
+package clumio_aws_connection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clumioAWSConnectionListResourceModel is the list resource model for the clumio_aws_connection
+// Terraform list resource. It represents the filters of the list block used by `terraform query`
+// to select the AWS connections to list.
+type clumioAWSConnectionListResourceModel struct {
+	AccountNativeID           types.String `tfsdk:"account_native_id"`
+	AWSRegion                 types.String `tfsdk:"aws_region"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_aws_connection Terraform list resource. Its filters are the same as the ones of the
+// clumio_aws_connection data source, and every AWS connection is listed if none is set.
+func (r *clumioAWSConnectionListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_aws_connection list resource is used to list the existing AWS" +
+			" connections with `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" AWS connections are listed. If not set, the" +
+					" clumio_organizational_unit_context of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaAccountNativeId: schema.StringAttribute{
+				Description: "Lists the connections of the AWS account with the given identifier.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaAwsRegion: schema.StringAttribute{
+				Description: "Lists the connections of the given AWS region.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
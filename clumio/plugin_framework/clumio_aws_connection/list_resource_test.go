@@ -0,0 +1,111 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in list_resource.go
+
+//go:build unit
+
+package clumio_aws_connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Unit test for the following cases:
+//   - List AWS connections success scenario.
+//   - SDK API for list AWS connections returns an error.
+//   - SDK API for list AWS connections returns an empty response.
+func TestListAWSConnections(t *testing.T) {
+
+	ctx := context.Background()
+	mockClient := sdkclients.NewMockAWSConnectionClient(t)
+	id := "test-id"
+	accountNativeId := "test-account-id"
+	awsRegion := "test-region"
+	testError := "Test Error"
+
+	lr := &clumioAWSConnectionListResource{
+		resource: &clumioAWSConnectionResource{
+			name:           "clumio_aws_connection",
+			sdkConnections: mockClient,
+		},
+	}
+	model := &clumioAWSConnectionListResourceModel{
+		AccountNativeID: basetypes.NewStringValue(accountNativeId),
+		AWSRegion:       basetypes.NewStringValue(awsRegion),
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests the success scenario for listing the AWS connections. It should not return Diagnostics.
+	t.Run("Basic success scenario for list AWS connections", func(t *testing.T) {
+
+		listResponse := &models.ListAWSConnectionsResponse{
+			Embedded: &models.AWSConnectionListEmbedded{
+				Items: []*models.AWSConnection{
+					{
+						Id:              &id,
+						AccountNativeId: &accountNativeId,
+						AwsRegion:       &awsRegion,
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listAWSConnections(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Equal(t, []common.ListItem{
+			{
+				Id:          id,
+				DisplayName: "test-account-id/test-region",
+				Identity: awsConnectionResourceIdentityModel{
+					AccountNativeID: basetypes.NewStringValue(accountNativeId),
+					AWSRegion:       basetypes.NewStringValue(awsRegion),
+				},
+			},
+		}, items)
+	})
+
+	// Tests that Diagnostics is returned in case the list AWS connections API call returns an
+	// error.
+	t.Run("list AWS connections returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := lr.listAWSConnections(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list AWS connections API call returns an empty
+	// response.
+	t.Run("list AWS connections returns an empty response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := lr.listAWSConnections(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
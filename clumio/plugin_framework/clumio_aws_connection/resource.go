@@ -164,7 +164,12 @@ func (r *clumioAWSConnectionResource) updateAWSConnection(
 func (r *clumioAWSConnectionResource) deleteAWSConnection(
 	_ context.Context, state *clumioAWSConnectionResourceModel) diag.Diagnostics {
 
-	var diags diag.Diagnostics
+	// Refuse to delete the AWS connection if its deletion protection is enabled.
+	diags := common.CheckDeletionProtection(
+		r.client, state.DeletionProtection, r.name, state.ID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
 
 	// Call the Clumio API to delete the AWS connection.
 	_, apiErr := r.sdkConnections.DeleteAwsConnection(state.ID.ValueString())
@@ -175,3 +180,40 @@ func (r *clumioAWSConnectionResource) deleteAWSConnection(
 	}
 	return diags
 }
+
+// findAWSConnectionId invokes the API to list the connection of the AWS account and region of the
+// given identity and returns its ID.
+func (r *clumioAWSConnectionResource) findAWSConnectionId(
+	_ context.Context, identity awsConnectionResourceIdentityModel) (string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+
+	// Call the Clumio API to list the connection of the AWS account and region.
+	accountNativeId := identity.AccountNativeID.ValueString()
+	awsRegion := identity.AWSRegion.ValueString()
+	filter := common.NewFilter().
+		In("account_native_id", []string{accountNativeId}).
+		In("aws_region", []string{awsRegion}).
+		String()
+	res, apiErr := r.sdkConnections.ListAwsConnections(nil, nil, &filter)
+	if apiErr != nil {
+		summary := fmt.Sprintf("Unable to import %s", r.name)
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return "", diags
+	}
+	if res == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return "", diags
+	}
+	if res.Embedded == nil || len(res.Embedded.Items) == 0 || res.Embedded.Items[0].Id == nil {
+		summary := "AWS connection not found"
+		detail := fmt.Sprintf("No AWS connection found for the AWS account %s and region %s.",
+			accountNativeId, awsRegion)
+		diags.AddError(summary, detail)
+		return "", diags
+	}
+	return *res.Embedded.Items[0].Id, diags
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the following Resource interfaces.
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &clumioAWSConnectionResource{}
 	_ resource.ResourceWithConfigure   = &clumioAWSConnectionResource{}
 	_ resource.ResourceWithImportState = &clumioAWSConnectionResource{}
+	_ resource.ResourceWithIdentity    = &clumioAWSConnectionResource{}
 )
 
 // clumioAWSConnectionResource is the struct backing the clumio_aws_connection Terraform resource.
@@ -56,21 +58,38 @@ func (r *clumioAWSConnectionResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioAWSConnectionResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkConnections = sdkclients.NewAWSConnectionClient(r.client.ClumioConfig)
-	r.sdkEnvironments = sdkclients.NewAWSEnvironmentClient(r.client.ClumioConfig)
-	r.sdkOrgUnits = sdkclients.NewOrganizationalUnitClient(r.client.ClumioConfig)
-	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkConnections = sdkclients.NewAWSConnectionClient(r.client.ClumioConfig, middlewares...)
+	r.sdkEnvironments = sdkclients.NewAWSEnvironmentClient(r.client.ClumioConfig, middlewares...)
+	r.sdkOrgUnits = sdkclients.NewOrganizationalUnitClient(r.client.ClumioConfig, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig, middlewares...)
 	r.pollTimeout = 3600 * time.Second
 	r.pollInterval = 5 * time.Second
 }
 
+// configureForOU creates the SDK clients of the resource, making the calls in the context of the
+// organizational unit given by its organizational_unit_context attribute, if set. The clients are
+// created for every operation after its timeout is applied to the given context, to which the
+// middlewares are bound, so that the retries, the limiter and the polls honor the timeout.
+func (r *clumioAWSConnectionResource) configureForOU(ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		config = r.client.ClumioConfig
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkConnections = sdkclients.NewAWSConnectionClient(config, middlewares...)
+	r.sdkEnvironments = sdkclients.NewAWSEnvironmentClient(config, middlewares...)
+	r.sdkOrgUnits = sdkclients.NewOrganizationalUnitClient(config, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(config, middlewares...)
+}
+
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
 func (r *clumioAWSConnectionResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -83,6 +102,19 @@ func (r *clumioAWSConnectionResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	// Call the Clumio API to create the AWS connection.
 	diags = r.createAWSConnection(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -96,6 +128,10 @@ func (r *clumioAWSConnectionResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, plan.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -110,6 +146,19 @@ func (r *clumioAWSConnectionResource) Read(
 		return
 	}
 
+	// Bound the operation by the read timeout given in the timeouts block, if any.
+	readTimeout, diags := state.Timeouts.Read(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	// Call the Clumio API to read the AWS connection.
 	remove, diags := r.readAWSConnection(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -126,6 +175,10 @@ func (r *clumioAWSConnectionResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, state.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state. NOTE that the
@@ -144,6 +197,19 @@ func (r *clumioAWSConnectionResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	// Retrieve the schema from the current Terraform state.
 	var state clumioAWSConnectionResourceModel
 	diags = req.State.Get(ctx, &state)
@@ -164,6 +230,10 @@ func (r *clumioAWSConnectionResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, plan.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -178,15 +248,49 @@ func (r *clumioAWSConnectionResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	// Call the Clumio API to delete the AWS connection.
 	diags = r.deleteAWSConnection(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done either by the ID of the resource or by its identity, in which case the ID is looked up
+// from the AWS account and region of the identity.
 func (r *clumioAWSConnectionResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root(schemaId), req, resp)
+		return
+	}
+
+	// Retrieve the identity given in the import block.
+	var identity awsConnectionResourceIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the ID of the connection of the AWS account and region of the identity.
+	id, diags := r.findAWSConnectionId(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.SetAttribute(ctx, path.Root(schemaId), id)
+	resp.Diagnostics.Append(diags...)
 }
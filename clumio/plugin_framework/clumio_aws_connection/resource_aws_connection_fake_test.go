@@ -0,0 +1,131 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the acceptance tests of the clumio_aws_connection Terraform resource which run
+// against the fake Clumio API. Please view the README.md file for more information on how to run
+// these tests.
+
+//go:build fake
+
+package clumio_aws_connection_test
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	clumiopf "github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test of the clumio_aws_connection resource against the fake Clumio API. It tests the following
+// scenarios:
+//   - Creates a connection and verifies that the plan was applied properly.
+//   - Updates the description of the connection and verifies that the update is applied.
+//   - Imports the connection by ID.
+//   - Changes the connection outside of Terraform and verifies that the drift is planned to be
+//     undone.
+//   - Deletes the connection outside of Terraform and verifies that it is planned to be recreated.
+func TestAccFakeResourceClumioAwsConnection(t *testing.T) {
+
+	server := clumiopf.UtilTestAccStartFakeApi(t)
+	resourceName := "clumio_aws_connection.test_conn"
+	var id string
+	updatedConfig := getTestAccFakeResourceClumioAwsConnection("test-connection-updated")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: clumiopf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccFakeResourceClumioAwsConnection("test-connection"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "test-connection"),
+					resource.TestCheckResourceAttr(
+						resourceName, "clumio_aws_account_id", fakeapi.ClumioAwsAccountId),
+					resource.TestCheckResourceAttr(
+						resourceName, "connection_status", "connecting"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: getTestAccFakeResourceClumioAwsConnection("test-connection-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "description", "test-connection-updated"),
+					checkFakeAwsConnectionDescription(server, &id, "test-connection-updated"),
+				),
+			},
+			clumiopf.UtilTestAccFakeImportStep(resourceName, updatedConfig, &id),
+			clumiopf.UtilTestAccFakeDriftStep(server, fakeapi.AwsConnections, &id,
+				map[string]any{"description": "changed-outside-of-terraform"}, resourceName,
+				updatedConfig,
+				checkFakeAwsConnectionDescription(server, &id, "test-connection-updated")),
+			clumiopf.UtilTestAccFakeDeleteStep(
+				server, fakeapi.AwsConnections, &id, resourceName, updatedConfig),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if connections := server.Objects(fakeapi.AwsConnections); len(connections) > 0 {
+				return fmt.Errorf(
+					"expected no connections after destroy, found %d", len(connections))
+			}
+			return nil
+		},
+	})
+}
+
+// checkFakeAwsConnectionDescription returns the check verifying that the connection with the given
+// ID has the given description in the fake Clumio API.
+func checkFakeAwsConnectionDescription(
+	server *fakeapi.Server, id *string, description string) resource.TestCheckFunc {
+
+	return func(_ *terraform.State) error {
+		connection, ok := server.Object(fakeapi.AwsConnections, *id)
+		if !ok {
+			return fmt.Errorf("connection %s not found in the fake Clumio API", *id)
+		}
+		if connection["description"] != description {
+			return fmt.Errorf("expected connection description %q, found %q",
+				description, connection["description"])
+		}
+		return nil
+	}
+}
+
+// getTestAccFakeResourceClumioAwsConnection returns the Terraform configuration of a connection
+// with the given description for the acceptance tests which run against the fake Clumio API.
+func getTestAccFakeResourceClumioAwsConnection(description string) string {
+	return fmt.Sprintf(testAccFakeResourceClumioAwsConnection, description)
+}
+
+// testAccFakeResourceClumioAwsConnection is the Terraform configuration of a connection. The
+// provider reads the base URL and token of the fake Clumio API from the environment.
+const testAccFakeResourceClumioAwsConnection = `
+resource "clumio_aws_connection" "test_conn" {
+  account_native_id = "123456789012"
+  aws_region = "us-west-2"
+  description = "%s"
+}
+`
@@ -332,6 +332,7 @@ func TestUpdateAWSConnection(t *testing.T) {
 //   - Delete AWS connection success scenario.
 //   - Delete AWS connection should not return error if AWS connection is not found.
 //   - SDK API for delete AWS connection returns an error.
+//   - Deletion protection prevents the deletion of the AWS connection.
 func TestDeleteAWSConnection(t *testing.T) {
 
 	mockAwsConnClient := sdkclients.NewMockAWSConnectionClient(t)
@@ -392,4 +393,111 @@ func TestDeleteAWSConnection(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 
+	// Tests that Diagnostics is returned without calling the API when deletion protection is
+	// enabled by the resource or by the provider.
+	t.Run("Deletion protection prevents the deletion", func(t *testing.T) {
+		protected := *crm
+		protected.DeletionProtection = basetypes.NewBoolValue(true)
+		diags := cr.deleteAWSConnection(ctx, &protected)
+		assert.True(t, diags.HasError())
+
+		providerProtected := cr
+		providerProtected.client = &common.ApiClient{DeletionProtection: true}
+		diags = providerProtected.deleteAWSConnection(ctx, crm)
+		assert.True(t, diags.HasError())
+	})
+}
+
+// Unit test for the following cases:
+//   - Find AWS connection ID success scenario.
+//   - SDK API for list AWS connections returns no connection.
+//   - SDK API for list AWS connections returns error.
+//   - SDK API for list AWS connections returns nil response.
+func TestFindAWSConnectionId(t *testing.T) {
+
+	mockAwsConnClient := sdkclients.NewMockAWSConnectionClient(t)
+	ctx := context.Background()
+	cr := clumioAWSConnectionResource{
+		name:           resourceName,
+		sdkConnections: mockAwsConnClient,
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	identity := awsConnectionResourceIdentityModel{
+		AccountNativeID: basetypes.NewStringValue(accountId),
+		AWSRegion:       basetypes.NewStringValue(region),
+	}
+
+	// Tests the success scenario for finding the ID of the connection. It should not return
+	// Diagnostics.
+	t.Run("Basic success scenario for find aws connection id", func(t *testing.T) {
+
+		listResponse := &models.ListAWSConnectionsResponse{
+			Embedded: &models.AWSConnectionListEmbedded{
+				Items: []*models.AWSConnection{
+					{
+						Id: &id,
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockAwsConnClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		connId, diags := cr.findAWSConnectionId(ctx, identity)
+		assert.Nil(t, diags)
+		assert.Equal(t, id, connId)
+	})
+
+	// Tests that Diagnostics is returned in case no connection exists for the AWS account and
+	// region of the identity.
+	t.Run("list aws connections returns no connection", func(t *testing.T) {
+
+		listResponse := &models.ListAWSConnectionsResponse{
+			Embedded: &models.AWSConnectionListEmbedded{
+				Items: []*models.AWSConnection{},
+			},
+		}
+
+		// Setup expectations.
+		mockAwsConnClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		_, diags := cr.findAWSConnectionId(ctx, identity)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list AWS connections API call returns an
+	// error.
+	t.Run("list aws connections returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockAwsConnClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := cr.findAWSConnectionId(ctx, identity)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list AWS connections API call returns a nil
+	// response.
+	t.Run("list aws connections returns nil response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockAwsConnClient.EXPECT().ListAwsConnections(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := cr.findAWSConnectionId(ctx, identity)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
 }
@@ -8,10 +8,14 @@ package clumio_aws_connection
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,17 +23,28 @@ import (
 // resource. It represents the schema of the resource and the data it holds. This schema is used by
 // customers to configure the resource and by the Clumio provider to read and write the resource.
 type clumioAWSConnectionResourceModel struct {
-	ID                 types.String `tfsdk:"id"`
-	AccountNativeID    types.String `tfsdk:"account_native_id"`
-	AWSRegion          types.String `tfsdk:"aws_region"`
-	Description        types.String `tfsdk:"description"`
-	ConnectionStatus   types.String `tfsdk:"connection_status"`
-	Token              types.String `tfsdk:"token"`
-	Namespace          types.String `tfsdk:"namespace"`
-	ClumioAWSAccountID types.String `tfsdk:"clumio_aws_account_id"`
-	ClumioAWSRegion    types.String `tfsdk:"clumio_aws_region"`
-	ExternalID         types.String `tfsdk:"role_external_id"`
-	DataPlaneAccountID types.String `tfsdk:"data_plane_account_id"`
+	ID                        types.String   `tfsdk:"id"`
+	AccountNativeID           types.String   `tfsdk:"account_native_id"`
+	AWSRegion                 types.String   `tfsdk:"aws_region"`
+	Description               types.String   `tfsdk:"description"`
+	ConnectionStatus          types.String   `tfsdk:"connection_status"`
+	Token                     types.String   `tfsdk:"token"`
+	Namespace                 types.String   `tfsdk:"namespace"`
+	ClumioAWSAccountID        types.String   `tfsdk:"clumio_aws_account_id"`
+	ClumioAWSRegion           types.String   `tfsdk:"clumio_aws_region"`
+	ExternalID                types.String   `tfsdk:"role_external_id"`
+	DataPlaneAccountID        types.String   `tfsdk:"data_plane_account_id"`
+	OrganizationalUnitContext types.String   `tfsdk:"organizational_unit_context"`
+	DeletionProtection        types.Bool     `tfsdk:"deletion_protection"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// awsConnectionResourceIdentityModel is the identity model for the clumio_aws_connection Terraform
+// resource. A connection is identified by the AWS account and region it connects, as only one
+// connection can exist for a given account and region.
+type awsConnectionResourceIdentityModel struct {
+	AccountNativeID types.String `tfsdk:"account_native_id"`
+	AWSRegion       types.String `tfsdk:"aws_region"`
 }
 
 // Schema defines the structure and constraints of the clumio_aws_connection Terraform resource.
@@ -39,10 +54,28 @@ type clumioAWSConnectionResourceModel struct {
 // description, etc. Some of these attributes are computed, meaning they are determined by Clumio at
 // runtime, while others are required or optional inputs from the user.
 func (r *clumioAWSConnectionResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource for establishing a connection between AWS accounts and Clumio.",
 		Attributes: map[string]schema.Attribute{
+			schemaDeletionProtection: schema.BoolAttribute{
+				Description: "Whether to prevent the deletion of the AWS connection. If not set," +
+					" the deletion_protection of the provider is used. To delete the AWS" +
+					" connection, set it to false and apply the change first.",
+				Optional: true,
+			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier for the Clumio AWS connection.",
 				Computed:    true,
@@ -79,7 +112,8 @@ func (r *clumioAWSConnectionResource) Schema(
 			schemaToken: schema.StringAttribute{
 				Description: "Distinct 36-character token used to identify resources set up by " +
 					"the Clumio AWS template installation on the account being connected.",
-				Computed: true,
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -124,5 +158,29 @@ func (r *clumioAWSConnectionResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_aws_connection Terraform resource, which is the
+// AWS account and region of the connection.
+func (r *clumioAWSConnectionResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaAccountNativeId: identityschema.StringAttribute{
+				Description:       "Identifier of the AWS account of the connection.",
+				RequiredForImport: true,
+			},
+			schemaAwsRegion: identityschema.StringAttribute{
+				Description:       "AWS region of the connection.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
@@ -25,9 +25,10 @@ func getEnvironmentForConnection(_ context.Context, r *clumioAWSConnectionResour
 	// connection.
 	accountNativeId := state.AccountNativeID.ValueString()
 	awsRegion := state.AWSRegion.ValueString()
-	filterStr := fmt.Sprintf(
-		"{\"account_native_id\":{\"$eq\":\"%v\"}, \"aws_region\":{\"$eq\":\"%v\"}}",
-		accountNativeId, awsRegion)
+	filterStr := common.NewFilter().
+		Eq("account_native_id", accountNativeId).
+		Eq("aws_region", awsRegion).
+		String()
 
 	// Call the Clumio API to retrieve the associated environment.
 	limit := int64(1)
@@ -69,3 +70,12 @@ func setDataPlaneAccountId(state *clumioAWSConnectionResourceModel, dataPlaneAcc
 		state.DataPlaneAccountID = types.StringValue(defaultDataPlaneAccountId)
 	}
 }
+
+// identity returns the identity model of the given connection, which is set into the Terraform
+// state along with the connection.
+func (m *clumioAWSConnectionResourceModel) identity() awsConnectionResourceIdentityModel {
+	return awsConnectionResourceIdentityModel{
+		AccountNativeID: m.AccountNativeID,
+		AWSRegion:       m.AWSRegion,
+	}
+}
@@ -6,6 +6,7 @@ const (
 	// Constants used by the resource model for the clumio_aws_manual_connection Terraform resource.
 	// These values should match the schema tfsdk tags on the resource model struct in schema.go.
 	schemaId = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
 	schemaAccountId = "account_id"
 	schemaAwsRegion = "aws_region"
 	schemaAssetsEnabled = "assets_enabled"
@@ -8,8 +8,12 @@ package clumio_aws_manual_connection
 import (
 	"context"
 	"fmt"
+	"net/http"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // createAWSManualConnection invokes the API to create the manual connection and from the response
@@ -33,6 +37,51 @@ func (r *clumioAWSManualConnectionResource) createAWSManualConnection(
 	return diags
 }
 
+// readAWSManualConnection invokes the API to read the AWS connection of the manual connection. If
+// the connection has been removed externally, the function returns "true" to indicate to the
+// caller that the resource no longer exists. The assets enabled and the resources of the
+// connection are only populated from the response when they are not set in the state, which is the
+// case after an import, so that refreshing the state does not override the configured values.
+func (r *clumioAWSManualConnectionResource) readAWSManualConnection(
+	ctx context.Context, state *clumioAWSManualConnectionResourceModel) (bool, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+
+	// Call the Clumio API to read the AWS connection.
+	accountId := state.AccountId.ValueString()
+	awsRegion := state.AwsRegion.ValueString()
+	connectionId := fmt.Sprintf("%v_%v", accountId, awsRegion)
+	res, apiErr := r.sdkConnections.ReadAwsConnection(connectionId, nil)
+	if apiErr != nil {
+		if apiErr.ResponseCode == http.StatusNotFound {
+			summary := fmt.Sprintf("%s (ID: %v) not found. Removing from state", r.name,
+				connectionId)
+			tflog.Warn(ctx, summary)
+			return true, diags
+		}
+		summary := fmt.Sprintf("Unable to read %s (ID: %v)", r.name, connectionId)
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return false, diags
+	}
+	if res == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return false, diags
+	}
+
+	// Convert the Clumio API response back to a schema and update the state.
+	state.ID = types.StringValue(connectionId)
+	if state.AssetsEnabled == nil {
+		state.AssetsEnabled = mapClumioAssetTypesToSchema(res.AssetTypesEnabled)
+	}
+	if state.Resources == nil {
+		state.Resources = mapClumioResourcesToSchema(res.Resources)
+	}
+	return false, diags
+}
+
 // updateAWSManualConnection invokes the API to update the manual connection and from the response
 // populates the computed attributes of the connection.
 func (r *clumioAWSManualConnectionResource) updateAWSManualConnection(
@@ -17,7 +17,17 @@ import (
 
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clumioAWSManualConnectionResource{}
+	_ resource.ResourceWithConfigure   = &clumioAWSManualConnectionResource{}
+	_ resource.ResourceWithImportState = &clumioAWSManualConnectionResource{}
+	_ resource.ResourceWithIdentity    = &clumioAWSManualConnectionResource{}
 )
 
 // clumioAWSConnectionResource is the struct backing the clumio_aws_connection Terraform resource.
@@ -44,12 +54,24 @@ func (r *clumioAWSManualConnectionResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioAWSManualConnectionResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkConnections = sdkclients.NewAWSConnectionClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkConnections = sdkclients.NewAWSConnectionClient(r.client.ClumioConfig, middlewares...)
+}
+
+// configureForOU makes the calls of the resource in the context of the organizational unit
+// given by its organizational_unit_context attribute, if set.
+func (r *clumioAWSManualConnectionResource) configureForOU(
+	ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		return
+	}
+	r.sdkConnections = sdkclients.NewAWSConnectionClient(config, r.client.CallMiddlewares(ctx)...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -64,6 +86,9 @@ func (r *clumioAWSManualConnectionResource) Create(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.createAWSManualConnection(ctx, &plan)
 	res.Diagnostics.Append(diags...)
 	if res.Diagnostics.HasError() {
@@ -73,6 +98,16 @@ func (r *clumioAWSManualConnectionResource) Create(
 	// Set the schema into the Terraform state.
 	diags = res.State.Set(ctx, &plan)
 	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = res.Identity.Set(ctx, awsManualConnectionResourceIdentityModel{
+		AccountId: plan.AccountId,
+		AwsRegion: plan.AwsRegion,
+	})
+	res.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state. Update only
@@ -96,6 +131,9 @@ func (r *clumioAWSManualConnectionResource) Update(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.updateAWSManualConnection(ctx, &plan, &state)
 	res.Diagnostics.Append(diags...)
 	if res.Diagnostics.HasError() {
@@ -105,12 +143,57 @@ func (r *clumioAWSManualConnectionResource) Update(
 	// Set the schema into the Terraform state.
 	diags = res.State.Set(ctx, &plan)
 	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = res.Identity.Set(ctx, awsManualConnectionResourceIdentityModel{
+		AccountId: plan.AccountId,
+		AwsRegion: plan.AwsRegion,
+	})
+	res.Diagnostics.Append(diags...)
 }
 
-// Read does not have an implementation as there is no API to read for clumio_aws_manual_connection.
-func (*clumioAWSManualConnectionResource) Read(
-	context.Context, resource.ReadRequest, *resource.ReadResponse) {
-		// No implementation needed.
+// Read retrieves the AWS connection of the resource from the Clumio API and sets the Terraform
+// state.
+func (r *clumioAWSManualConnectionResource) Read(
+	ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+
+	// Retrieve the schema from the current Terraform state.
+	var state clumioAWSManualConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
+	remove, diags := r.readAWSManualConnection(ctx, &state)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if remove {
+		res.State.RemoveResource(ctx)
+		return
+	}
+
+	// Set the schema into the Terraform state.
+	diags = res.State.Set(ctx, &state)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = res.Identity.Set(ctx, awsManualConnectionResourceIdentityModel{
+		AccountId: state.AccountId,
+		AwsRegion: state.AwsRegion,
+	})
+	res.Diagnostics.Append(diags...)
 }
 
 // Delete does not have an implementation as there is no API to delete for
@@ -120,6 +203,40 @@ func (*clumioAWSManualConnectionResource) Delete(
 		// No implementation needed.
 }
 
+// ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
+// is done either using the ID of the AWS connection, in the format <account_id>_<aws_region>, or
+// by the identity of the resource, from which the AWS account and region of the resource are set
+// before the connection is read.
+func (r *clumioAWSManualConnectionResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+
+	var accountId, awsRegion string
+	if req.ID != "" {
+		var diags diag.Diagnostics
+		accountId, awsRegion, diags = parseImportId(req.ID)
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Retrieve the identity given in the import block.
+		var identity awsManualConnectionResourceIdentityModel
+		diags := req.Identity.Get(ctx, &identity)
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		accountId = identity.AccountId.ValueString()
+		awsRegion = identity.AwsRegion.ValueString()
+	}
+
+	// Set the attributes identifying the connection into the Terraform state.
+	id := fmt.Sprintf("%v_%v", accountId, awsRegion)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root(schemaId), id)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root(schemaAccountId), accountId)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root(schemaAwsRegion), awsRegion)...)
+}
+
 // clumioSetManualResourcesCommon contains the logic for updating resources of a manual connection
 // using Clumio API.
 func (r *clumioAWSManualConnectionResource) clumioSetManualResourcesCommon(
@@ -16,6 +16,7 @@ import (
 
 	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -208,3 +209,152 @@ func TestUpdateAWSManualConnection(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 }
+
+// Unit test for the following cases:
+//   - Read AWS manual connection after import populates the assets enabled and resources.
+//   - Read AWS manual connection keeps the configured assets enabled and resources.
+//   - SDK API for read AWS connection returns not found error.
+//   - SDK API for read AWS connection returns an error.
+//   - SDK API for read AWS connection returns an empty response.
+func TestReadAWSManualConnection(t *testing.T) {
+
+	mockAwsConnClient := sdkclients.NewMockAWSConnectionClient(t)
+	ctx := context.Background()
+	cr := clumioAWSManualConnectionResource{
+		name: resourceName,
+		client: &common.ApiClient{
+			ClumioConfig: sdkconfig.Config{},
+		},
+		sdkConnections: mockAwsConnClient,
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+	apiNotFoundError := &apiutils.APIError{
+		ResponseCode: 404,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	ebs := EBS
+	s3 := S3
+	readResponse := &models.ReadAWSConnectionResponse{
+		AssetTypesEnabled: []*string{&ebs, &s3},
+		Resources: &models.Resources{
+			ClumioIamRoleArn: &someArn,
+			EventRules: &models.EventRules{
+				CloudtrailRuleArn: &someArn,
+			},
+		},
+	}
+
+	// Tests that the assets enabled and resources are populated from the response after an import.
+	t.Run("Read aws manual connection after import", func(t *testing.T) {
+
+		state := &clumioAWSManualConnectionResourceModel{
+			AccountId: basetypes.NewStringValue(accountId),
+			AwsRegion: basetypes.NewStringValue(region),
+		}
+
+		// Setup Expectations
+		mockAwsConnClient.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).
+			Return(readResponse, nil)
+
+		remove, diags := cr.readAWSManualConnection(ctx, state)
+		assert.False(t, remove)
+		assert.Nil(t, diags)
+		assert.Equal(t, id, state.ID.ValueString())
+		assert.True(t, state.AssetsEnabled.EBS.ValueBool())
+		assert.True(t, state.AssetsEnabled.S3.ValueBool())
+		assert.False(t, state.AssetsEnabled.RDS.ValueBool())
+		assert.Equal(t, someArn, state.Resources.ClumioIAMRoleArn.ValueString())
+		assert.Equal(t, someArn, state.Resources.EventRules.CloudtrailRuleArn.ValueString())
+		assert.Equal(t, "", state.Resources.EventRules.CloudwatchRuleArn.ValueString())
+		assert.Equal(t, "",
+			state.Resources.ServiceRoles.S3.ContinuousBackupsRoleArn.ValueString())
+	})
+
+	// Tests that the configured assets enabled and resources are not overridden by the response.
+	t.Run("Read aws manual connection keeps the configured values", func(t *testing.T) {
+
+		assetsEnabled := &AssetsEnabledModel{
+			RDS: basetypes.NewBoolValue(true),
+		}
+		resources := &ResourcesModel{
+			ClumioIAMRoleArn: basetypes.NewStringValue("configured-arn"),
+		}
+		state := &clumioAWSManualConnectionResourceModel{
+			ID:            basetypes.NewStringValue(id),
+			AccountId:     basetypes.NewStringValue(accountId),
+			AwsRegion:     basetypes.NewStringValue(region),
+			AssetsEnabled: assetsEnabled,
+			Resources:     resources,
+		}
+
+		// Setup Expectations
+		mockAwsConnClient.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).
+			Return(readResponse, nil)
+
+		remove, diags := cr.readAWSManualConnection(ctx, state)
+		assert.False(t, remove)
+		assert.Nil(t, diags)
+		assert.Equal(t, assetsEnabled, state.AssetsEnabled)
+		assert.Equal(t, resources, state.Resources)
+	})
+
+	// Tests that the resource is removed from the state if the AWS connection is not found.
+	t.Run("read aws connection returns not found error", func(t *testing.T) {
+
+		state := &clumioAWSManualConnectionResourceModel{
+			AccountId: basetypes.NewStringValue(accountId),
+			AwsRegion: basetypes.NewStringValue(region),
+		}
+
+		// Setup Expectations
+		mockAwsConnClient.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).
+			Return(nil, apiNotFoundError)
+
+		remove, diags := cr.readAWSManualConnection(ctx, state)
+		assert.True(t, remove)
+		assert.Nil(t, diags)
+	})
+
+	// Tests that Diagnostics is returned in case the read AWS connection API call returns an
+	// error.
+	t.Run("read aws connection returns an error", func(t *testing.T) {
+
+		state := &clumioAWSManualConnectionResourceModel{
+			AccountId: basetypes.NewStringValue(accountId),
+			AwsRegion: basetypes.NewStringValue(region),
+		}
+
+		// Setup Expectations
+		mockAwsConnClient.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).
+			Return(nil, apiError)
+
+		remove, diags := cr.readAWSManualConnection(ctx, state)
+		assert.False(t, remove)
+		assert.NotNil(t, diags)
+	})
+
+	// Tests that Diagnostics is returned in case the read AWS connection API call returns an
+	// empty response.
+	t.Run("read aws connection returns an empty response", func(t *testing.T) {
+
+		state := &clumioAWSManualConnectionResourceModel{
+			AccountId: basetypes.NewStringValue(accountId),
+			AwsRegion: basetypes.NewStringValue(region),
+		}
+
+		// Setup Expectations
+		mockAwsConnClient.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).
+			Return(nil, nil)
+
+		remove, diags := cr.readAWSManualConnection(ctx, state)
+		assert.False(t, remove)
+		assert.NotNil(t, diags)
+	})
+}
@@ -8,11 +8,14 @@ package clumio_aws_manual_connection
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,11 +24,20 @@ import (
 // is used by ustomers to configure the resource and by the Clumio provider to read and write the
 // resource.
 type clumioAWSManualConnectionResourceModel struct {
-	ID            types.String        `tfsdk:"id"`
-	AccountId     types.String        `tfsdk:"account_id"`
-	AwsRegion     types.String        `tfsdk:"aws_region"`
-	AssetsEnabled *AssetsEnabledModel `tfsdk:"assets_enabled"`
-	Resources     *ResourcesModel     `tfsdk:"resources"`
+	ID                        types.String        `tfsdk:"id"`
+	OrganizationalUnitContext types.String        `tfsdk:"organizational_unit_context"`
+	AccountId                 types.String        `tfsdk:"account_id"`
+	AwsRegion                 types.String        `tfsdk:"aws_region"`
+	AssetsEnabled             *AssetsEnabledModel `tfsdk:"assets_enabled"`
+	Resources                 *ResourcesModel     `tfsdk:"resources"`
+}
+
+// awsManualConnectionResourceIdentityModel is the identity model for the
+// clumio_aws_manual_connection Terraform resource. A manual connection is identified by the AWS
+// account and region of its connection.
+type awsManualConnectionResourceIdentityModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	AwsRegion types.String `tfsdk:"aws_region"`
 }
 
 // AssetsEnabledModel maps to the 'assets_enabled' field in clumioAWSManualConnectionResourceModel
@@ -103,6 +115,18 @@ func (r *clumioAWSManualConnectionResource) Schema(
 				Description: "Unique identifier for the Clumio AWS manual connection.",
 				Computed:    true,
 			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaAccountId: schema.StringAttribute{
 				Description: "Identifier of the AWS account to be linked with Clumio.",
 				Required:    true,
@@ -166,3 +190,22 @@ func (r *clumioAWSManualConnectionResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_aws_manual_connection Terraform resource,
+// which is the AWS account and region of its connection.
+func (r *clumioAWSManualConnectionResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaAccountId: identityschema.StringAttribute{
+				Description:       "Identifier of the AWS account linked with Clumio.",
+				RequiredForImport: true,
+			},
+			schemaAwsRegion: identityschema.StringAttribute{
+				Description:       "Region of the AWS account linked with Clumio.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -5,6 +5,15 @@
 
 package clumio_aws_manual_connection
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
 // isAssetConfigDowngraded checks if any previously added assets in the config are no being removed,
 // as asset downgrades are not supoorted during update.
 func isAssetConfigDowngraded(
@@ -32,3 +41,87 @@ func isAssetConfigDowngraded(
 	return false
 }
 
+// mapClumioAssetTypesToSchema maps the asset types enabled for an AWS connection, as returned by
+// the Clumio API, to the assets_enabled attribute of the schema.
+func mapClumioAssetTypesToSchema(assetTypes []*string) *AssetsEnabledModel {
+	enabled := make(map[string]bool, len(assetTypes))
+	for _, assetType := range assetTypes {
+		if assetType != nil {
+			enabled[*assetType] = true
+		}
+	}
+	return &AssetsEnabledModel{
+		EBS:      types.BoolValue(enabled[EBS]),
+		RDS:      types.BoolValue(enabled[RDS]),
+		DynamoDB: types.BoolValue(enabled[DynamoDB]),
+		S3:       types.BoolValue(enabled[S3]),
+		EC2MSSQL: types.BoolValue(enabled[EC2MSSQL]),
+	}
+}
+
+// mapClumioResourcesToSchema maps the resources of an AWS connection, as returned by the Clumio
+// API, to the resources attribute of the schema. ARNs missing from the response are set to "", as
+// they are configured when not applicable to the connection.
+func mapClumioResourcesToSchema(resources *models.Resources) *ResourcesModel {
+	if resources == nil {
+		resources = &models.Resources{}
+	}
+	eventRules := resources.EventRules
+	if eventRules == nil {
+		eventRules = &models.EventRules{}
+	}
+	serviceRoles := resources.ServiceRoles
+	if serviceRoles == nil {
+		serviceRoles = &models.ServiceRoles{}
+	}
+	s3Roles := serviceRoles.S3
+	if s3Roles == nil {
+		s3Roles = &models.S3ServiceRoles{}
+	}
+	mssqlRoles := serviceRoles.Mssql
+	if mssqlRoles == nil {
+		mssqlRoles = &models.MssqlServiceRoles{}
+	}
+	return &ResourcesModel{
+		ClumioIAMRoleArn:     stringValueOrEmpty(resources.ClumioIamRoleArn),
+		ClumioSupportRoleArn: stringValueOrEmpty(resources.ClumioSupportRoleArn),
+		ClumioEventPubArn:    stringValueOrEmpty(resources.ClumioEventPubArn),
+		EventRules: &EventRules{
+			CloudtrailRuleArn: stringValueOrEmpty(eventRules.CloudtrailRuleArn),
+			CloudwatchRuleArn: stringValueOrEmpty(eventRules.CloudwatchRuleArn),
+		},
+		ServiceRoles: &ServiceRoles{
+			Mssql: &MssqlServiceRoles{
+				SsmNotificationRoleArn:   stringValueOrEmpty(mssqlRoles.SsmNotificationRoleArn),
+				Ec2SsmInstanceProfileArn: stringValueOrEmpty(mssqlRoles.Ec2SsmInstanceProfileArn),
+			},
+			S3: &S3ServiceRoles{
+				ContinuousBackupsRoleArn: stringValueOrEmpty(s3Roles.ContinuousBackupsRoleArn),
+			},
+		},
+	}
+}
+
+// stringValueOrEmpty returns the given string as a String value, or "" if it is nil.
+func stringValueOrEmpty(value *string) types.String {
+	if value == nil {
+		return types.StringValue("")
+	}
+	return types.StringValue(*value)
+}
+
+// parseImportId parses the import ID of a manual connection, given in the format
+// <account_id>_<aws_region>, into the AWS account ID and region of the connection.
+func parseImportId(importId string) (string, string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	accountId, awsRegion, found := strings.Cut(importId, "_")
+	if !found || accountId == "" || awsRegion == "" {
+		summary := "Invalid import ID"
+		detail := fmt.Sprintf("The import ID %q is not in the format <account_id>_<aws_region>.",
+			importId)
+		diags.AddError(summary, detail)
+		return "", "", diags
+	}
+	return accountId, awsRegion, diags
+}
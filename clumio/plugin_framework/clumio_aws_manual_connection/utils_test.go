@@ -93,3 +93,23 @@ func TestIsAssetConfigDowngraded(t *testing.T) {
 		plan.AssetsEnabled.EC2MSSQL = basetypes.NewBoolValue(true)
 	})
 }
+
+// Unit test for the following import ID cases:
+//   - Import ID in the <account_id>_<aws_region> format.
+//   - Import ID without a region.
+func TestParseImportId(t *testing.T) {
+
+	// Tests that the AWS account ID and region are parsed from a valid import ID.
+	t.Run("valid import id", func(t *testing.T) {
+		parsedAccountId, parsedRegion, diags := parseImportId(id)
+		assert.Nil(t, diags)
+		assert.Equal(t, accountId, parsedAccountId)
+		assert.Equal(t, region, parsedRegion)
+	})
+
+	// Tests that Diagnostics is returned if the import ID has no region.
+	t.Run("import id without a region", func(t *testing.T) {
+		_, _, diags := parseImportId(accountId)
+		assert.True(t, diags.HasError())
+	})
+}
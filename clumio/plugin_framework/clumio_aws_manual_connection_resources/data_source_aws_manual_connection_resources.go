@@ -42,12 +42,13 @@ func (r *clumioAwsManualConnectionResourcesDatasource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioAwsManualConnectionResourcesDatasource) Configure(
-	_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.awsTemplates = sdkclients.NewAWSTemplatesClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.awsTemplates = sdkclients.NewAWSTemplatesClient(r.client.ClumioConfig, middlewares...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
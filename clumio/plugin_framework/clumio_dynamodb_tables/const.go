@@ -5,12 +5,15 @@ package clumio_dynamodb_tables
 const (
 	// Constants used by the resource model for the clumio_dynamo_db_tables Terraform resource.
 	// These values should match the schema tfsdk tags on the resource model struct in schema.go.
-	schemaId              = "id"
-	schemaName            = "name"
-	schemaRegion          = "aws_region"
-	schemaAccountNativeId = "account_native_id"
-	schemaTableNativeId   = "table_native_id"
-	schemaDynamoDBTables  = "dynamodb_tables"
+	schemaId                        = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
+	schemaName                      = "name"
+	schemaRegion                    = "aws_region"
+	schemaAccountNativeId           = "account_native_id"
+	schemaTableNativeId             = "table_native_id"
+	schemaDynamoDBTables            = "dynamodb_tables"
+	schemaMaxResults                = "max_results"
+	schemaPageSize                  = "page_size"
 
 	TableNativeId = "TABLE_NATIVE_ID"
 	TableName     = "TABLE_NAME"
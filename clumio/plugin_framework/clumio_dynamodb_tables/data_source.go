@@ -7,10 +7,10 @@ package clumio_dynamodb_tables
 
 import (
 	"context"
-	"fmt"
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"strings"
 )
 
 // readDynamoDBTables invokes the API to read the dynamoDBTableClient and from the response
@@ -21,55 +21,55 @@ func (r *clumioDynamoDBTablesDataSource) readDynamoDBTables(
 	var diags diag.Diagnostics
 
 	// Prepare the query filter.
-	nameFilter := ""
-	accountNativeIdFilter := ""
-	regionFilter := ""
-	tableNativeIdFilter := ""
-	filters := make([]string, 0)
+	queryFilter := common.NewFilter()
 
 	tableNativeId := model.TableNativeID.ValueString()
 	if tableNativeId != "" {
-		tableNativeIdFilter = fmt.Sprintf(`"table_native_id": {"$eq":"%s"}`, tableNativeId)
-		filters = append(filters, tableNativeIdFilter)
+		queryFilter.Eq("table_native_id", tableNativeId)
 	}
 	name := model.Name.ValueString()
 	if tableNativeId == "" && name != "" {
-		nameFilter = fmt.Sprintf(`"name": {"$contains":"%s"}`, name)
-		filters = append(filters, nameFilter)
+		queryFilter.Contains("name", name)
 	}
 
 	accountNativeId := model.AccountNativeID.ValueString()
 	if accountNativeId != "" {
-		accountNativeIdFilter = fmt.Sprintf(`"account_native_id": {"$eq":"%s"}`, accountNativeId)
-		filters = append(filters, accountNativeIdFilter)
+		queryFilter.Eq("account_native_id", accountNativeId)
 	}
 
 	region := model.Region.ValueString()
 	if region != "" {
-		regionFilter = fmt.Sprintf(`"aws_region": {"$eq":"%s"}`, region)
-		filters = append(filters, regionFilter)
+		queryFilter.Eq("aws_region", region)
 	}
 
-	filter := fmt.Sprintf("{%s}", strings.Join(filters, ","))
-	// Call the Clumio API to list the DynamoDB tables.
-	limit := int64(10000)
-	res, apiErr := r.dynamoDBTableClient.ListAwsDynamodbTables(&limit, nil, &filter, nil, nil)
-	if apiErr != nil {
-		summary := fmt.Sprintf("Unable to read %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
-		return diags
-	}
-	if res == nil {
-		summary := common.NilErrorMessageSummary
-		detail := common.NilErrorMessageDetail
-		diags.AddError(summary, detail)
+	filter := queryFilter.String()
+	// Call the Clumio API to list the DynamoDB tables, reading every page.
+	options := common.DataSourceListOptions(model.MaxResults, model.PageSize)
+	items, listDiags := common.ListAll(ctx, r.name, options,
+		func(limit *int64, start *string) (
+			*common.Page[*models.DynamoDBTable], *apiutils.APIError) {
+
+			res, apiErr := r.dynamoDBTableClient.ListAwsDynamodbTables(limit, start, &filter, nil, nil)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[*models.DynamoDBTable]{}
+			if res.Embedded != nil {
+				page.Items = res.Embedded.Items
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+	diags.Append(listDiags...)
+	if diags.HasError() {
 		return diags
 	}
 
 	// Convert the Clumio API response for the DynamoDB tables into the datasource schema model.
-	if res.Embedded != nil && res.Embedded.Items != nil && len(res.Embedded.Items) > 0 {
-		populateDiag := populateDynamoDBTablesInDataSourceModel(ctx, model, res.Embedded.Items)
+	if len(items) > 0 {
+		populateDiag := populateDynamoDBTablesInDataSourceModel(ctx, model, items)
 		diags.Append(populateDiag...)
 	} else {
 		summary := "DynamoDB table not found."
@@ -8,9 +8,12 @@ package clumio_dynamodb_tables
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,11 +22,14 @@ import (
 // schema is used by customers to configure the datasource and by the Clumio provider to read and
 // write the datasource.
 type clumioDynamoDBTablesDataSourceModel struct {
-	AccountNativeID types.String `tfsdk:"account_native_id"`
-	Region          types.String `tfsdk:"aws_region"`
-	Name            types.String `tfsdk:"name"`
-	TableNativeID   types.String `tfsdk:"table_native_id"`
-	DynamoDBTables  types.List   `tfsdk:"dynamodb_tables"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+	AccountNativeID           types.String `tfsdk:"account_native_id"`
+	Region                    types.String `tfsdk:"aws_region"`
+	Name                      types.String `tfsdk:"name"`
+	TableNativeID             types.String `tfsdk:"table_native_id"`
+	DynamoDBTables            types.List   `tfsdk:"dynamodb_tables"`
+	MaxResults                types.Int64  `tfsdk:"max_results"`
+	PageSize                  types.Int64  `tfsdk:"page_size"`
 }
 
 // Schema defines the structure and constraints of the clumio_dynamo_db_tables Terraform datasource.
@@ -36,6 +42,27 @@ func (r *clumioDynamoDBTablesDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" data source is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaMaxResults: schema.Int64Attribute{
+				Description: "Maximum number of results to read. If not set, every page of" +
+					" results is read.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			schemaPageSize: schema.Int64Attribute{
+				Description: "Number of results requested per page of the list API. If not set," +
+					" 1000 results are requested per page.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
 			schemaAccountNativeId: schema.StringAttribute{
 				Description: "The identifier of the AWS account under which the DynamoDB " +
 					"bucket was created.",
@@ -5,12 +5,13 @@ package clumio_gcp_connection
 const (
 	// Constants used by the resource model for the clumio_gcp_connection Terraform resource. These
 	// values should match the schema tfsdk tags on the resource model struct in schema.go.
-	schemaID                     = "id"
-	schemaClumioControlPlaneId   = "clumio_control_plane_id"
-	schemaClumioControlPlaneRole = "clumio_control_plane_role"
-	schemaProjectId              = "project_id"
-	schemaDeploymentType         = "deployment_type"
-	schemaDescription            = "description"
-	schemaRegions                = "regions"
-	schemaToken                  = "token"
+	schemaID                        = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
+	schemaClumioControlPlaneId      = "clumio_control_plane_id"
+	schemaClumioControlPlaneRole    = "clumio_control_plane_role"
+	schemaProjectId                 = "project_id"
+	schemaDeploymentType            = "deployment_type"
+	schemaDescription               = "description"
+	schemaRegions                   = "regions"
+	schemaToken                     = "token"
 )
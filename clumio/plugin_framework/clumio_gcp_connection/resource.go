@@ -45,7 +45,15 @@ func (r *clumioGCPConnectionResource) readGcpConnection(ctx context.Context, sta
 
 	state.DeploymentType = types.StringPointerValue(res.DeploymentType)
 
-	// Description and ProjectID are not computed values
+	// Description and ProjectID are not computed values, so they are only populated from the
+	// response when the connection has just been imported by its project ID, in which case the ID
+	// is not set yet either.
+	if state.ID.IsNull() {
+		state.Description = types.StringPointerValue(res.Description)
+	}
+	// The ID of the connection is the ID of its project. Connections created by earlier versions
+	// of the provider used the token as ID, which is replaced so that the token is not exposed.
+	state.ID = state.ProjectID
 	return false, diags
 }
 
@@ -82,8 +90,9 @@ func (r *clumioGCPConnectionResource) createGcpConnection(ctx context.Context, p
 	}
 
 	// Convert the Clumio API response back to a schema and populate all computed fields of the plan
-	// ID needs to be a value which is used by our backend to uniquely identify connection
-	plan.ID = types.StringPointerValue(res.Token)
+	// The ID is the project ID, which identifies the connection in the Clumio API. The token is not
+	// used as it is sensitive while the ID is not.
+	plan.ID = plan.ProjectID
 	plan.ClumioControlPlaneId = types.StringPointerValue(res.ControlPlaneId)
 	plan.ClumioControlPlaneRole = types.StringPointerValue(res.ControlPlaneRole)
 	plan.Token = types.StringPointerValue(res.Token)
@@ -10,13 +10,17 @@ import (
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &clumioGCPConnectionResource{}
-	_ resource.ResourceWithConfigure = &clumioGCPConnectionResource{}
+	_ resource.Resource                = &clumioGCPConnectionResource{}
+	_ resource.ResourceWithConfigure   = &clumioGCPConnectionResource{}
+	_ resource.ResourceWithImportState = &clumioGCPConnectionResource{}
+	_ resource.ResourceWithIdentity    = &clumioGCPConnectionResource{}
 )
 
 type clumioGCPConnectionResource struct {
@@ -47,7 +51,18 @@ func (r *clumioGCPConnectionResource) Configure(ctx context.Context, req resourc
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkConnections = sdkclients.NewGcpConnectionClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkConnections = sdkclients.NewGcpConnectionClient(r.client.ClumioConfig, middlewares...)
+}
+
+// configureForOU makes the calls of the resource in the context of the organizational unit
+// given by its organizational_unit_context attribute, if set.
+func (r *clumioGCPConnectionResource) configureForOU(ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		return
+	}
+	r.sdkConnections = sdkclients.NewGcpConnectionClient(config, r.client.CallMiddlewares(ctx)...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -61,6 +76,9 @@ func (r *clumioGCPConnectionResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	// Call the Clumio API to read the GCP connection.
 	remove, diags := r.readGcpConnection(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -78,6 +96,10 @@ func (r *clumioGCPConnectionResource) Read(ctx context.Context, req resource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, gcpConnectionResourceIdentityModel{ProjectID: state.ProjectID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -91,6 +113,9 @@ func (r *clumioGCPConnectionResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	// Call Clumio API to create GCP connection
 	diags = r.createGcpConnection(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -104,6 +129,10 @@ func (r *clumioGCPConnectionResource) Create(ctx context.Context, req resource.C
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, gcpConnectionResourceIdentityModel{ProjectID: plan.ProjectID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and sets the updated Terraform state.
@@ -117,6 +146,9 @@ func (r *clumioGCPConnectionResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	// Call Clumio API to update GCP connection
 	diags = r.updateGcpConnection(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -130,6 +162,10 @@ func (r *clumioGCPConnectionResource) Update(ctx context.Context, req resource.U
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, gcpConnectionResourceIdentityModel{ProjectID: plan.ProjectID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API
@@ -142,6 +178,9 @@ func (r *clumioGCPConnectionResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	// Call Clumio API to delete GCP connection
 	diags = r.deleteGcpConnection(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -149,3 +188,11 @@ func (r *clumioGCPConnectionResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 }
+
+// ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
+// is done either using the ID of the GCP project of the connection or by the identity of the
+// resource, which holds the project ID, from which the connection is read.
+func (r *clumioGCPConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root(schemaProjectId), path.Root(schemaProjectId), req, resp)
+}
@@ -87,6 +87,7 @@ func TestCreateGcpConnection(t *testing.T) {
 
 		diags := r.createGcpConnection(ctx, model)
 
+		assert.Equal(t, model.ProjectID.ValueString(), model.ID.ValueString())
 		assert.Equal(t, model.ClumioControlPlaneId.ValueString(), controlPlaneId)
 		assert.Equal(t, model.ClumioControlPlaneRole.ValueString(), controlPlaneRole)
 		assert.Equal(t, model.Token.ValueString(), token)
@@ -308,6 +309,8 @@ func TestDeleteGcpConnection(t *testing.T) {
 
 // Unit test for the following cases:
 //   - Read GCP connection success scenario.
+//   - Read GCP connection after import populates the ID and description
+//   - Read GCP connection replaces an ID holding the token with the project ID
 //   - SDK API for read GCP connection returns an error
 //   - SDK API not found error return remove bool as true
 func TestReadGcpConnection(t *testing.T) {
@@ -360,6 +363,42 @@ func TestReadGcpConnection(t *testing.T) {
 		assert.Equal(t, region2, *resultRegions[1])
 	})
 
+	t.Run("Read GCP connection after import", func(t *testing.T) {
+		importedModel := &clumioGCPConnectionResourceModel{
+			ID:          types.StringNull(),
+			ProjectID:   types.StringValue("1234"),
+			Description: types.StringNull(),
+		}
+		resWithDescription := &models.ReadGCPConnectionResponse{
+			Token:       types.StringValue("token").ValueStringPointer(),
+			Description: types.StringValue("description").ValueStringPointer(),
+		}
+		mockSdkConnection.EXPECT().ReadGcpConnection(importedModel.ProjectID.ValueString()).
+			Times(1).Return(resWithDescription, nil)
+
+		remove, diags := r.readGcpConnection(ctx, importedModel)
+		assert.False(t, diags.HasError())
+		assert.False(t, remove)
+		assert.Equal(t, importedModel.ProjectID.ValueString(), importedModel.ID.ValueString())
+		assert.Equal(t, *resWithDescription.Description, importedModel.Description.ValueString())
+	})
+
+	t.Run("Read GCP connection replaces the token ID", func(t *testing.T) {
+		tokenIdModel := &clumioGCPConnectionResourceModel{
+			ID:          types.StringValue("token"),
+			ProjectID:   types.StringValue("1234"),
+			Description: types.StringValue("description"),
+		}
+		mockSdkConnection.EXPECT().ReadGcpConnection(tokenIdModel.ProjectID.ValueString()).
+			Times(1).Return(res, nil)
+
+		remove, diags := r.readGcpConnection(ctx, tokenIdModel)
+		assert.False(t, diags.HasError())
+		assert.False(t, remove)
+		assert.Equal(t, tokenIdModel.ProjectID.ValueString(), tokenIdModel.ID.ValueString())
+		assert.Equal(t, "description", tokenIdModel.Description.ValueString())
+	})
+
 	t.Run("SDK API for read GCP connection returns an error", func(t *testing.T) {
 		mockSdkConnection.EXPECT().ReadGcpConnection(mock.Anything).Times(1).
 			Return(nil, apiError)
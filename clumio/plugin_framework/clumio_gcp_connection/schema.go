@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -19,14 +20,22 @@ import (
 // resource. It represents the schema of the resource and the data it holds. This schema is used by
 // customers to configure the resource and by the Clumio provider to read and write the resource.
 type clumioGCPConnectionResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	ClumioControlPlaneId   types.String `tfsdk:"clumio_control_plane_id"`
-	ClumioControlPlaneRole types.String `tfsdk:"clumio_control_plane_role"`
-	ProjectID              types.String `tfsdk:"project_id"`
-	DeploymentType         types.String `tfsdk:"deployment_type"`
-	Description            types.String `tfsdk:"description"`
-	Regions                types.List   `tfsdk:"regions"`
-	Token                  types.String `tfsdk:"token"`
+	ID                        types.String `tfsdk:"id"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+	ClumioControlPlaneId      types.String `tfsdk:"clumio_control_plane_id"`
+	ClumioControlPlaneRole    types.String `tfsdk:"clumio_control_plane_role"`
+	ProjectID                 types.String `tfsdk:"project_id"`
+	DeploymentType            types.String `tfsdk:"deployment_type"`
+	Description               types.String `tfsdk:"description"`
+	Regions                   types.List   `tfsdk:"regions"`
+	Token                     types.String `tfsdk:"token"`
+}
+
+// gcpConnectionResourceIdentityModel is the identity model for the clumio_gcp_connection Terraform
+// resource. A GCP connection is identified by its GCP project, as a project can be connected only
+// once.
+type gcpConnectionResourceIdentityModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
 }
 
 // Schema defines the structure and constraints of the clumio_gcp_connection Terraform resource.
@@ -40,12 +49,25 @@ func (r *clumioGCPConnectionResource) Schema(_ context.Context, _ resource.Schem
 		MarkdownDescription: "> ⚠️ **Beta Resource**\n>\n> This resource establishes a connection between GCP projects and Clumio.\n> It is currently in **beta** and available only to select customers.\n> Behavior, schema, and APIs may change in future releases.\n>",
 		Attributes: map[string]schema.Attribute{
 			schemaID: schema.StringAttribute{
-				Description: "Unique identifier of the connection",
-				Computed:    true,
+				Description: "Unique identifier of the connection, which is the ID of its GCP" +
+					" project.",
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaClumioControlPlaneId: schema.StringAttribute{
 				Description: "Identifier for the Clumio Control Plan. This " +
 					"identifier is provided so that access to the service role for Clumio can be " +
@@ -66,7 +88,8 @@ func (r *clumioGCPConnectionResource) Schema(_ context.Context, _ resource.Schem
 			schemaToken: schema.StringAttribute{
 				Description: "The 36-character Clumio GCP integration token used to identify the " +
 					"installation of the Clumio GCP integration resources in the project.",
-				Computed: true,
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -105,3 +128,18 @@ func (r *clumioGCPConnectionResource) Schema(_ context.Context, _ resource.Schem
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_gcp_connection Terraform resource, which is
+// the GCP project of the connection.
+func (r *clumioGCPConnectionResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaProjectId: identityschema.StringAttribute{
+				Description:       "The GCP project ID of the connection.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
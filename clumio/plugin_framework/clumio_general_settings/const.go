@@ -5,6 +5,7 @@ package clumio_general_settings
 const (
 	// Constants used by the resource model for the clumio_general_settings Terraform resource.
 	// These values should match the schema tfsdk tags on the resource model struct in schema.go.
+	schemaId                 = "id"
 	schemaAutoLogoutDuration = "auto_logout_duration"
 	schemaIPAllowlist        = "ip_allowlist"
 	schemaPasswordExpiration = "password_expiration_duration"
@@ -10,13 +10,17 @@ import (
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource              = &clumioGeneralSettings{}
-	_ resource.ResourceWithConfigure = &clumioGeneralSettings{}
+	_ resource.Resource                = &clumioGeneralSettings{}
+	_ resource.ResourceWithConfigure   = &clumioGeneralSettings{}
+	_ resource.ResourceWithImportState = &clumioGeneralSettings{}
+	_ resource.ResourceWithIdentity    = &clumioGeneralSettings{}
 )
 
 // clumioGeneralSettings is the struct backing the clumio_general_settings Terraform resource.
@@ -45,13 +49,15 @@ func (r *clumioGeneralSettings) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioGeneralSettings) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkGeneralSettings = sdkclients.NewGeneralSettingsClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkGeneralSettings = sdkclients.NewGeneralSettingsClient(
+		r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -73,12 +79,19 @@ func (r *clumioGeneralSettings) Create(
 		return
 	}
 
+	// As the API does not return an ID for the general settings, a random one is generated.
+	plan.ID = types.StringValue(uuid.New().String())
+
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, generalSettingsResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -99,12 +112,22 @@ func (r *clumioGeneralSettings) Read(
 		return
 	}
 
+	// The general settings created by earlier versions of the provider have no ID, so one is
+	// generated for them.
+	if state.ID.IsNull() {
+		state.ID = types.StringValue(uuid.New().String())
+	}
+
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, generalSettingsResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -131,6 +154,10 @@ func (r *clumioGeneralSettings) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, generalSettingsResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete resets the resource via the Clumio API and removes the Terraform state.
@@ -146,3 +173,14 @@ func (r *clumioGeneralSettings) Delete(
 	diags := r.updateGeneralSettings(ctx, &resetReq)
 	resp.Diagnostics.Append(diags...)
 }
+
+// ImportState retrieves the resource via the Clumio API and sets the Terraform state. As the
+// general settings are a singleton for the entire organization and the API does not return an ID,
+// the import ID or the ID given in the identity can be any value and is kept as the ID of the
+// resource. Every other attribute is populated by the Read following the import.
+func (r *clumioGeneralSettings) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root(schemaId), path.Root(schemaId), req, resp)
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
@@ -111,3 +113,35 @@ func TestDeleteError(t *testing.T) {
 	res.Delete(ctx, resource.DeleteRequest{State: state}, deleteRes)
 	assert.True(t, deleteRes.Diagnostics.HasError())
 }
+
+func TestImportState(t *testing.T) {
+	ctx := context.Background()
+	res := NewGeneralSettingsResource().(*clumioGeneralSettings)
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		Schema: schemaResp.Schema,
+	}
+	importRes := &resource.ImportStateResponse{State: state}
+	res.ImportState(ctx, resource.ImportStateRequest{ID: "general_settings"}, importRes)
+	assert.False(t, importRes.Diagnostics.HasError())
+	assert.False(t, importRes.State.Raw.IsNull())
+	var id types.String
+	importRes.State.GetAttribute(ctx, path.Root(schemaId), &id)
+	assert.Equal(t, "general_settings", id.ValueString())
+
+	// Import using the identity of the resource.
+	identitySchemaResp := &resource.IdentitySchemaResponse{}
+	res.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, identitySchemaResp)
+	identity := &tfsdk.ResourceIdentity{
+		Raw:    tftypes.NewValue(identitySchemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		Schema: identitySchemaResp.IdentitySchema,
+	}
+	identity.Set(ctx, generalSettingsResourceIdentityModel{ID: types.StringValue("settings")})
+	importRes = &resource.ImportStateResponse{State: state}
+	res.ImportState(ctx, resource.ImportStateRequest{Identity: identity}, importRes)
+	assert.False(t, importRes.Diagnostics.HasError())
+	importRes.State.GetAttribute(ctx, path.Root(schemaId), &id)
+	assert.Equal(t, "settings", id.ValueString())
+}
@@ -10,8 +10,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -21,11 +24,19 @@ import (
 // is used by customers to configure the resource and by the Clumio provider to read and write the
 // resource.
 type generalSettingsResourceModel struct {
+	ID                         types.String   `tfsdk:"id"`
 	AutoLogoutDuration         types.Int64    `tfsdk:"auto_logout_duration"`
 	IpAllowlist                []types.String `tfsdk:"ip_allowlist"`
 	PasswordExpirationDuration types.Int64    `tfsdk:"password_expiration_duration"`
 }
 
+// generalSettingsResourceIdentityModel is the identity model for the clumio_general_settings
+// Terraform resource. As the general settings are a singleton for the entire organization, they
+// are identified by the ID of the resource.
+type generalSettingsResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Schema defines the structure and constraints of the clumio_general_settings Terraform
 // resource. Schema is a method on the clumioGeneralSettings struct. It sets the schema
 // for the clumio_general_settings Terraform resource, which is used to configure a report.
@@ -37,6 +48,13 @@ func (r *clumioGeneralSettings) Schema(
 		Description: "Clumio general settings Resource used to manage general organizational " +
 			"settings. These settings are persistent and cannot be deleted, only updated or reset.",
 		Attributes: map[string]schema.Attribute{
+			schemaId: schema.StringAttribute{
+				Description: "Unique identifier of the general settings.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			schemaAutoLogoutDuration: schema.Int64Attribute{
 				Description: "The length of time before a user is logged out of the Clumio system" +
 					" due to inactivity. Measured in seconds. The valid range is between 600 " +
@@ -71,3 +89,19 @@ func (r *clumioGeneralSettings) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_general_settings Terraform resource, which is
+// the ID of the resource.
+func (r *clumioGeneralSettings) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description: "Unique identifier of the general settings. As the general settings" +
+					" are a singleton, any value can be used to import them.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -18,4 +18,7 @@ const (
 	schemaUserId                    = "user_id"
 	schemaAssignedRole              = "assigned_role"
 	schemaOrganizationalUnits       = "organizational_units"
+	schemaTimeouts                  = "timeouts"
+	schemaMaxResults                = "max_results"
+	schemaPageSize                  = "page_size"
 )
@@ -8,6 +8,8 @@ package clumio_organizational_unit
 import (
 	"context"
 	"fmt"
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -21,27 +23,35 @@ func (r *clumioOrganizationalUnitDataSource) readOrganizationalUnit(
 
 	// Prepare the query nameFilter.
 	name := model.Name.ValueString()
-	nameFilter := fmt.Sprintf(`{"name": {"$contains":"%s"}}`, name)
-
-	// Call the Clumio API to list the organizational units.
-	limit := int64(10000)
-	res, apiErr := r.organizationalUnitClient.ListOrganizationalUnits(&limit, nil, &nameFilter)
-	if apiErr != nil {
-		summary := fmt.Sprintf("Unable to read %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
-		return diags
-	}
-	if res == nil {
-		summary := common.NilErrorMessageSummary
-		detail := common.NilErrorMessageDetail
-		diags.AddError(summary, detail)
+	nameFilter := common.NewFilter().Contains("name", name).String()
+
+	// Call the Clumio API to list the organizational units, reading every page.
+	options := common.DataSourceListOptions(model.MaxResults, model.PageSize)
+	items, listDiags := common.ListAll(ctx, r.name, options,
+		func(limit *int64, start *string) (
+			*common.Page[*models.OrganizationalUnitWithETag], *apiutils.APIError) {
+
+			res, apiErr := r.organizationalUnitClient.ListOrganizationalUnits(limit, start, &nameFilter)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[*models.OrganizationalUnitWithETag]{}
+			if res.Embedded != nil {
+				page.Items = res.Embedded.Items
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+	diags.Append(listDiags...)
+	if diags.HasError() {
 		return diags
 	}
 
 	// Convert the Clumio API response for the organizational units into the datasource schema model.
-	if res.Embedded != nil && res.Embedded.Items != nil && len(res.Embedded.Items) > 0 {
-		populateDiag := populateOrganizationalUnitsInDataSourceModel(ctx, model, res.Embedded.Items)
+	if len(items) > 0 {
+		populateDiag := populateOrganizationalUnitsInDataSourceModel(ctx, model, items)
 		diags.Append(populateDiag...)
 	} else {
 		summary := "Organizational unit not found."
@@ -48,12 +48,14 @@ func (r *clumioOrganizationalUnitDataSource) Metadata(
 // Configure sets up the datasource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioOrganizationalUnitDataSource) Configure(
-	_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.organizationalUnitClient = sdkclients.NewOrganizationalUnitClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.organizationalUnitClient = sdkclients.NewOrganizationalUnitClient(
+		r.client.ClumioConfig, middlewares...)
 }
 
 // Read retrieves the datasource from the Clumio API and sets the Terraform state.
@@ -56,7 +56,7 @@ func TestAccDataSourceClumioEmptyOUName(t *testing.T) {
 // datasource.
 func getTestDataSourceClumioOU(baseUrl string, invalidName bool) string {
 
-	name := "ds_test_organizational_unit"
+	name := "acceptance-test-ds-organizational-unit"
 
 	dsName := name
 	if invalidName {
@@ -7,6 +7,7 @@ package clumio_organizational_unit
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -21,6 +22,8 @@ import (
 type clumioOrganizationalUnitDataSourceModel struct {
 	Name                types.String `tfsdk:"name"`
 	OrganizationalUnits types.Set    `tfsdk:"organizational_units"`
+	MaxResults          types.Int64  `tfsdk:"max_results"`
+	PageSize            types.Int64  `tfsdk:"page_size"`
 }
 
 // Schema defines the structure and constraints of the clumio_organizational_unit Terraform
@@ -33,6 +36,18 @@ func (r *clumioOrganizationalUnitDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaMaxResults: schema.Int64Attribute{
+				Description: "Maximum number of results to read. If not set, every page of" +
+					" results is read.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			schemaPageSize: schema.Int64Attribute{
+				Description: "Number of results requested per page of the list API. If not set," +
+					" 1000 results are requested per page.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
 			schemaName: schema.StringAttribute{
 				Description: "The name of the organizational unit.",
 				Required:    true,
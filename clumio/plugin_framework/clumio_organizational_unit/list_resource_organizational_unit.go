@@ -0,0 +1,78 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the list resource implementation for the clumio_organizational_unit Terraform
+// list resource. This list resource is used by `terraform query` to list the existing
+// organizational units within Clumio.
+
+package clumio_organizational_unit
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &clumioOrganizationalUnitListResource{}
+	_ list.ListResourceWithConfigure = &clumioOrganizationalUnitListResource{}
+)
+
+// clumioOrganizationalUnitListResource is the struct backing the clumio_organizational_unit
+// Terraform list resource. It wraps the clumio_organizational_unit resource, whose Clumio API
+// clients are used to list the organizational units and whose read logic is used to populate the
+// listed resources.
+type clumioOrganizationalUnitListResource struct {
+	resource *clumioOrganizationalUnitResource
+}
+
+// NewClumioOrganizationalUnitListResource creates a new instance of
+// clumioOrganizationalUnitListResource. Its attributes are initialized later by Terraform via
+// Metadata and Configure once the Provider is initialized.
+func NewClumioOrganizationalUnitListResource() list.ListResource {
+	return &clumioOrganizationalUnitListResource{
+		resource: &clumioOrganizationalUnitResource{},
+	}
+}
+
+// Metadata returns the name of the list resource type, which is the name of the resource it lists.
+func (r *clumioOrganizationalUnitListResource) Metadata(
+	ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	r.resource.Metadata(ctx, req, resp)
+}
+
+// Configure sets up the list resource with the Clumio API client and any other required state. It
+// is called by Terraform once the Provider is initialized.
+func (r *clumioOrganizationalUnitListResource) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.resource.Configure(ctx, req, resp)
+}
+
+// List streams the organizational units matching the filters of the list block to Terraform.
+func (r *clumioOrganizationalUnitListResource) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+
+	// Retrieve the filters from the list block.
+	var config clumioOrganizationalUnitListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := r.listOrganizationalUnits(ctx, &config, common.ListLimit(req))
+	stream.Results = common.ListResults(ctx, req, items, diags,
+		func(ctx context.Context, id string, model *clumioOrganizationalUnitResourceModel) (
+			bool, diag.Diagnostics) {
+
+			model.Id = types.StringValue(id)
+			return r.resource.readOrganizationalUnit(ctx, model)
+		})
+}
@@ -0,0 +1,44 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_organizational_unit Terraform list resource.
+
+package clumio_organizational_unit
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clumioOrganizationalUnitListResourceModel is the list resource model for the
+// clumio_organizational_unit Terraform list resource. It represents the filters of the list block
+// used by `terraform query` to select the organizational units to list.
+type clumioOrganizationalUnitListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_organizational_unit Terraform list resource. Its filters are the same as the ones of the
+// clumio_organizational_unit data source, and every organizational unit is listed if none is set.
+func (r *clumioOrganizationalUnitListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_organizational_unit list resource is used to list the existing" +
+			" organizational units with `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaName: schema.StringAttribute{
+				Description: "Lists the organizational units whose name contains the given value.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in list_resource.go
+
+//go:build unit
+
+package clumio_organizational_unit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Unit test for the following cases:
+//   - List organizational units success scenario.
+//   - SDK API for list organizational units returns an error.
+//   - SDK API for list organizational units returns an empty response.
+func TestListOrganizationalUnits(t *testing.T) {
+
+	ctx := context.Background()
+	mockClient := sdkclients.NewMockOrganizationalUnitClient(t)
+	id := "test-id"
+	name := "test-name"
+	testError := "Test Error"
+
+	lr := &clumioOrganizationalUnitListResource{
+		resource: &clumioOrganizationalUnitResource{
+			name:        "clumio_organizational_unit",
+			sdkOrgUnits: mockClient,
+		},
+	}
+	model := &clumioOrganizationalUnitListResourceModel{
+		Name: basetypes.NewStringValue(name),
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests the success scenario for listing the organizational units. It should not return
+	// Diagnostics.
+	t.Run("Basic success scenario for list organizational units", func(t *testing.T) {
+
+		listResponse := &models.ListOrganizationalUnitsResponse{
+			Embedded: &models.OrganizationalUnitListEmbedded{
+				Items: []*models.OrganizationalUnitWithETag{
+					{
+						Id:   &id,
+						Name: &name,
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListOrganizationalUnits(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listOrganizationalUnits(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Equal(t, []common.ListItem{
+			{
+				Id:          id,
+				DisplayName: name,
+				Identity: organizationalUnitResourceIdentityModel{
+					ID: basetypes.NewStringValue(id),
+				},
+			},
+		}, items)
+	})
+
+	// Tests that Diagnostics is returned in case the list organizational units API call returns an
+	// error.
+	t.Run("list organizational units returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListOrganizationalUnits(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := lr.listOrganizationalUnits(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list organizational units API call returns an
+	// empty response.
+	t.Run("list organizational units returns an empty response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListOrganizationalUnits(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := lr.listOrganizationalUnits(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
@@ -15,10 +15,19 @@ import (
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// apiFieldPaths maps the fields of the organizational unit requests to the attributes they are set
+// from, so that the field errors returned by the Clumio API are reported on those attributes.
+var apiFieldPaths = common.ApiFieldPaths{
+	schemaName:        path.MatchRoot(schemaName),
+	schemaDescription: path.MatchRoot(schemaDescription),
+	schemaParentId:    path.MatchRoot(schemaParentId),
+}
+
 // createOrganizationalUnit invokes the API to create the organizational unit and from the response
 // populates the computed attributes of the organizational unit.
 func (r *clumioOrganizationalUnitResource) createOrganizationalUnit(
@@ -37,8 +46,7 @@ func (r *clumioOrganizationalUnitResource) createOrganizationalUnit(
 	res, apiErr := r.sdkOrgUnits.CreateOrganizationalUnit(nil, request)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to create %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -202,8 +210,7 @@ func (r *clumioOrganizationalUnitResource) updateOrganizationalUnit(
 	res, apiErr := r.sdkOrgUnits.PatchOrganizationalUnit(plan.Id.ValueString(), nil, createReq)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to update %s (ID: %v)", r.name, plan.Id.ValueString())
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -298,12 +305,12 @@ func (r *clumioOrganizationalUnitResource) deleteOrganizationalUnit(
 		diags.AddError(summary, detail)
 		return diags
 	}
-	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf(
 			"Unable to poll %s (ID: %v) for deletion", r.name, state.Id.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 	}
 	return diags
 }
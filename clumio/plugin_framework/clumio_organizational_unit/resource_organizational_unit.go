@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &clumioOrganizationalUnitResource{}
 	_ resource.ResourceWithConfigure   = &clumioOrganizationalUnitResource{}
 	_ resource.ResourceWithImportState = &clumioOrganizationalUnitResource{}
+	_ resource.ResourceWithIdentity    = &clumioOrganizationalUnitResource{}
 )
 
 // clumioOrganizationalUnitResource is the struct backing the clumio_organizational_unit Terraform resource.
@@ -53,17 +54,25 @@ func (r *clumioOrganizationalUnitResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioOrganizationalUnitResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkOrgUnits = sdkclients.NewOrganizationalUnitClient(r.client.ClumioConfig)
-	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig)
+	r.configureClients(ctx)
 	r.pollTimeout = 3600 * time.Second
 	r.pollInterval = 5 * time.Second
 }
 
+// configureClients creates the SDK clients of the resource. The clients are created for every
+// operation after its timeout is applied to the given context, to which the middlewares are bound,
+// so that the retries, the limiter and the polls honor the timeout.
+func (r *clumioOrganizationalUnitResource) configureClients(ctx context.Context) {
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkOrgUnits = sdkclients.NewOrganizationalUnitClient(r.client.ClumioConfig, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig, middlewares...)
+}
+
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
 func (r *clumioOrganizationalUnitResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -76,6 +85,18 @@ func (r *clumioOrganizationalUnitResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
 	diags = r.createOrganizationalUnit(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -85,6 +106,13 @@ func (r *clumioOrganizationalUnitResource) Create(
 	// Set the schema into the Terraform state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, organizationalUnitResourceIdentityModel{ID: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -99,6 +127,18 @@ func (r *clumioOrganizationalUnitResource) Read(
 		return
 	}
 
+	// Bound the operation by the read timeout given in the timeouts block, if any.
+	readTimeout, diags := state.Timeouts.Read(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
 	remove, diags := r.readOrganizationalUnit(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -112,6 +152,13 @@ func (r *clumioOrganizationalUnitResource) Read(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, organizationalUnitResourceIdentityModel{ID: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -126,6 +173,18 @@ func (r *clumioOrganizationalUnitResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
 	diags = r.updateOrganizationalUnit(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -135,6 +194,13 @@ func (r *clumioOrganizationalUnitResource) Update(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, organizationalUnitResourceIdentityModel{ID: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -149,14 +215,26 @@ func (r *clumioOrganizationalUnitResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
 	diags = r.deleteOrganizationalUnit(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done by the ID of the resource, given either as the import ID or in the resource identity.
 func (r *clumioOrganizationalUnitResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
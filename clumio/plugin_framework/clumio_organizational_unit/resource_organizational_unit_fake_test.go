@@ -0,0 +1,135 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the acceptance tests of the clumio_organizational_unit Terraform resource which
+// run against the fake Clumio API. Please view the README.md file for more information on how to
+// run these tests.
+
+//go:build fake
+
+package clumio_organizational_unit_test
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	clumiopf "github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test of the clumio_organizational_unit resource against the fake Clumio API. It tests the
+// following scenarios:
+//   - Creates an organizational unit and verifies that the plan was applied properly.
+//   - Updates the organizational unit and verifies that the update is applied.
+//   - Imports the organizational unit by ID.
+//   - Changes the organizational unit outside of Terraform and verifies that the drift is planned
+//     to be undone.
+//   - Deletes the organizational unit outside of Terraform and verifies that it is planned to be
+//     recreated.
+func TestAccFakeResourceClumioOrganizationalUnit(t *testing.T) {
+
+	server := clumiopf.UtilTestAccStartFakeApi(t)
+	resourceName := "clumio_organizational_unit.test_ou"
+	var id string
+	updatedConfig := getTestAccFakeResourceClumioOrganizationalUnit(
+		"acceptance-test-ou-updated", "test-ou-description-updated")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: clumiopf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccFakeResourceClumioOrganizationalUnit(
+					"acceptance-test-ou", "test-ou-description"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "acceptance-test-ou"),
+					resource.TestCheckResourceAttr(
+						resourceName, "parent_id", fakeapi.RootOrganizationalUnitId),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: getTestAccFakeResourceClumioOrganizationalUnit(
+					"acceptance-test-ou-updated", "test-ou-description-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", "acceptance-test-ou-updated"),
+					resource.TestCheckResourceAttr(
+						resourceName, "description", "test-ou-description-updated"),
+					checkFakeOrganizationalUnitName(server, &id, "acceptance-test-ou-updated"),
+				),
+			},
+			clumiopf.UtilTestAccFakeImportStep(resourceName, updatedConfig, &id),
+			clumiopf.UtilTestAccFakeDriftStep(server, fakeapi.OrganizationalUnits, &id,
+				map[string]any{"name": "changed-outside-of-terraform"}, resourceName,
+				updatedConfig,
+				checkFakeOrganizationalUnitName(server, &id, "acceptance-test-ou-updated")),
+			clumiopf.UtilTestAccFakeDeleteStep(
+				server, fakeapi.OrganizationalUnits, &id, resourceName, updatedConfig),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			// Only the root organizational unit, which cannot be deleted, must be left.
+			if ous := server.Objects(fakeapi.OrganizationalUnits); len(ous) > 1 {
+				return fmt.Errorf("expected no organizational units after destroy, found %d",
+					len(ous)-1)
+			}
+			return nil
+		},
+	})
+}
+
+// checkFakeOrganizationalUnitName returns the check verifying that the organizational unit with
+// the given ID is named as given in the fake Clumio API.
+func checkFakeOrganizationalUnitName(
+	server *fakeapi.Server, id *string, name string) resource.TestCheckFunc {
+
+	return func(_ *terraform.State) error {
+		ou, ok := server.Object(fakeapi.OrganizationalUnits, *id)
+		if !ok {
+			return fmt.Errorf("organizational unit %s not found in the fake Clumio API", *id)
+		}
+		if ou["name"] != name {
+			return fmt.Errorf("expected organizational unit name %q, found %q", name, ou["name"])
+		}
+		return nil
+	}
+}
+
+// getTestAccFakeResourceClumioOrganizationalUnit returns the Terraform configuration of an
+// organizational unit with the given name and description for the acceptance tests which run
+// against the fake Clumio API.
+func getTestAccFakeResourceClumioOrganizationalUnit(name string, description string) string {
+	return fmt.Sprintf(testAccFakeResourceClumioOrganizationalUnit, name, description)
+}
+
+// testAccFakeResourceClumioOrganizationalUnit is the Terraform configuration of an organizational
+// unit. The provider reads the base URL and token of the fake Clumio API from the environment.
+const testAccFakeResourceClumioOrganizationalUnit = `
+resource "clumio_organizational_unit" "test_ou" {
+	name = "%s"
+	description = "%s"
+}
+`
@@ -17,6 +17,7 @@ import (
 	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
@@ -592,3 +593,16 @@ func TestDeleteOrganizationalUnit(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 }
+
+// Unit test for apiFieldPaths for the following cases:
+//   - Field errors for the fields of the organizational unit requests are reported on the
+//     attributes they are set from.
+//   - Field errors for unknown fields are reported without an attribute.
+func TestApiFieldPaths(t *testing.T) {
+
+	common.RunApiFieldPathsTests(t, apiFieldPaths, map[string]path.Path{
+		"name":          path.Root(schemaName),
+		"parent_id":     path.Root(schemaParentId),
+		"unknown_field": path.Empty(),
+	})
+}
@@ -8,8 +8,10 @@ package clumio_organizational_unit
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -28,16 +30,24 @@ type userWithRole struct {
 // is used by customers to configure the resource and by the Clumio provider to read and write the
 // resource.
 type clumioOrganizationalUnitResourceModel struct {
-	Id                        types.String `tfsdk:"id"`
-	Name                      types.String `tfsdk:"name"`
-	Description               types.String `tfsdk:"description"`
-	ParentId                  types.String `tfsdk:"parent_id"`
-	ChildrenCount             types.Int64  `tfsdk:"children_count"`
-	ConfiguredDatasourceTypes types.List   `tfsdk:"configured_datasource_types"`
-	DescendantIds             types.List   `tfsdk:"descendant_ids"`
-	UserCount                 types.Int64  `tfsdk:"user_count"`
-	Users                     types.List   `tfsdk:"users"`
-	UsersWithRole             types.List   `tfsdk:"users_with_role"`
+	Id                        types.String   `tfsdk:"id"`
+	Name                      types.String   `tfsdk:"name"`
+	Description               types.String   `tfsdk:"description"`
+	ParentId                  types.String   `tfsdk:"parent_id"`
+	ChildrenCount             types.Int64    `tfsdk:"children_count"`
+	ConfiguredDatasourceTypes types.List     `tfsdk:"configured_datasource_types"`
+	DescendantIds             types.List     `tfsdk:"descendant_ids"`
+	UserCount                 types.Int64    `tfsdk:"user_count"`
+	Users                     types.List     `tfsdk:"users"`
+	UsersWithRole             types.List     `tfsdk:"users_with_role"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// organizationalUnitResourceIdentityModel is the identity model for the clumio_organizational_unit
+// Terraform resource. It identifies the organizational unit when importing it and when listing
+// organizational units with `terraform query`.
+type organizationalUnitResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 // Schema defines the structure and constraints of the clumio_organizational_unit Terraform resource.
@@ -47,7 +57,7 @@ type clumioOrganizationalUnitResourceModel struct {
 // name, description, etc. Some of these attributes are computed, meaning they are determined by
 // Clumio at runtime, while others are required or optional inputs from the user.
 func (r *clumioOrganizationalUnitResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Resource for creating and managing Organizational Unit in Clumio.",
 		Attributes: map[string]schema.Attribute{
@@ -128,5 +138,25 @@ func (r *clumioOrganizationalUnitResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_organizational_unit Terraform resource, which
+// is the ID of the organizational unit.
+func (r *clumioOrganizationalUnitResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the organizational unit.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
@@ -22,6 +22,8 @@ const (
 	schemaValue                          = "value"
 	schemaOffsets                        = "offsets"
 	schemaId                             = "id"
+	schemaOrganizationalUnitContext      = "organizational_unit_context"
+	schemaDeletionProtection             = "deletion_protection"
 	schemaLockStatus                     = "lock_status"
 	schemaAdvancedSettings               = "advanced_settings"
 	schemaAlternativeReplica             = "alternative_replica"
@@ -41,9 +43,21 @@ const (
 	schemaApply                          = "apply"
 	schemaRdsLogicalBackup               = "aws_rds_resource_granular_backup"
 	schemaIcebergTableBackup             = "aws_iceberg_table_backup"
+	schemaDynamoDBTableBackup            = "aws_dynamodb_table_backup"
+	schemaS3Backtrack                    = "aws_s3_backtrack"
 	schemaNameBeginsWith                 = "name_begins_with"
 	schemaOperationTypes                 = "operation_types"
 	schemaPolicies                       = "policies"
+	schemaTimeouts                       = "timeouts"
+	schemaPolicyId                       = "policy_id"
+	schemaHorizonDays                    = "horizon_days"
+	schemaSchedules                      = "schedules"
+	schemaSlaIndex                       = "sla_index"
+	schemaMaxIntervalMinutes             = "max_interval_minutes"
+	schemaBackups                        = "backups"
+	schemaWindowStart                    = "window_start"
+	schemaWindowEnd                      = "window_end"
+	schemaExpiresAt                      = "expires_at"
 
 	alternativeReplicaDescFmt = "The alternative replica for MSSQL %s backups. This" +
 		" setting only applies to Availability Group databases. Possible" +
@@ -100,9 +114,40 @@ const (
 		"existing bucket notification configuration in the customer's account. This takes effect " +
 		"only when event_bridge_enabled is set to false."
 
+	dynamoDBBackupDesc = "Optional configuration settings for the aws_dynamodb_table_backup" +
+		" operation."
+
+	dynamoDBBackupTierDesc = "Backup tier to store the DynamoDB backup in. Valid values are:" +
+		" `standard` and `warm`. If not provided, the default is `standard`.\n" +
+		"\t- `standard` = Clumio SecureVault Standard\n\t- `warm` = Clumio SecureVault Warm tier," +
+		" which requires the warm tier DynamoDB protection of the AWS connection"
+
+	s3BacktrackDesc = "Optional configuration settings for the aws_s3_backtrack operation."
+
+	s3BacktrackDisableEventbridgeNotificationDesc = "If true, tries to disable EventBridge" +
+		" notification for the given bucket, when backtrack no longer conducts. It may override" +
+		" the existing bucket notification configuration in the customer's account. This takes" +
+		" effect only when event_bridge_enabled is set to false."
+
 	errorPolicyReadMsg = "Unable to read %s (ID: %v)"
 
 	// Constants for activation status allowed values
 	activationStatusActivated  = "activated"
 	activationStatusDectivated = "deactivated"
+
+	// Constants for action setting allowed values
+	actionSettingImmediate = "immediate"
+	actionSettingWindow    = "window"
+
+	// RPO frequency unit of the SLAs of on demand backups.
+	rpoOnDemand = "on_demand"
+
+	// Default and maximum number of days previewed by the clumio_policy_schedule_preview
+	// datasource.
+	defaultHorizonDays = 30
+	maxHorizonDays     = 366
+
+	// Maximum number of backups previewed per SLA, so that minutely RPOs over long horizons do not
+	// produce an unbounded state.
+	maxPreviewBackups = 10000
 )
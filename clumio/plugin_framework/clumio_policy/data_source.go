@@ -8,47 +8,42 @@ package clumio_policy
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"strings"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // readPolicy invokes the API to read the policyDefinitionClient and from the response populates the
-// attributes of the policy.
+// attributes of the policy. If the id of the policy is set, only that policy is read.
 func (r *clumioPolicyDataSource) readPolicy(
 	ctx context.Context, model *clumioPolicyDataSourceModel) diag.Diagnostics {
 
+	if !model.Id.IsNull() {
+		return r.readPolicyById(ctx, model)
+	}
+
 	var diags diag.Diagnostics
-	nameFilter := ""
-	operationTypesFilter := ""
-	activationStatusFilter := ""
-	filters := make([]string, 0)
+	queryFilter := common.NewFilter()
 
 	// Prepare the query filter.
 	name := model.Name.ValueString()
 	if name != "" {
-		nameFilter = fmt.Sprintf(`"name": {"$begins_with":"%s"}`, name)
-		filters = append(filters, nameFilter)
+		queryFilter.BeginsWith("name", name)
 	}
 	if !model.OperationTypes.IsUnknown() && !model.OperationTypes.IsNull() {
 		operationTypes := make([]string, 0)
 		conversionDiags := model.OperationTypes.ElementsAs(ctx, &operationTypes, false)
 		diags.Append(conversionDiags...)
-		operationTypesFilter = fmt.Sprintf(
-			`"operations.type": {"$in":["%s"]}`, strings.Join(operationTypes, `","`))
-		filters = append(filters, operationTypesFilter)
+		queryFilter.In("operations.type", operationTypes)
 	}
 	activationStatus := model.ActivationStatus.ValueString()
 	if activationStatus != "" {
-		activationStatusFilter = fmt.Sprintf(`"activation_status": {"$eq":"%s"}`, activationStatus)
-		filters = append(filters, activationStatusFilter)
+		queryFilter.Eq("activation_status", activationStatus)
 	}
-	filter := fmt.Sprintf("{%s}", strings.Join(filters, ","))
+	filter := queryFilter.String()
 
 	// Call the Clumio API to list the policy definitions.
 	res, apiErr := r.policyDefinitionClient.ListPolicyDefinitions(&filter, nil)
@@ -66,56 +61,88 @@ func (r *clumioPolicyDataSource) readPolicy(
 	}
 
 	// Convert the Clumio API response for the policies into the datasource schema model.
-	if res.Embedded != nil && res.Embedded.Items != nil && len(res.Embedded.Items) > 0 {
-		objtype := types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				schemaId:                   types.StringType,
-				schemaName:                 types.StringType,
-				schemaActivationStatus:     types.StringType,
-				schemaTimezone:             types.StringType,
-				schemaOrganizationalUnitId: types.StringType,
-				schemaOperationTypes:       types.SetType{ElemType: types.StringType},
-			},
-		}
-		attrVals := make([]attr.Value, 0)
+	if res.Embedded != nil && len(res.Embedded.Items) > 0 {
+		policies := make([]*clumioPolicyDataSourcePolicy, 0, len(res.Embedded.Items))
 		for _, item := range res.Embedded.Items {
-			resOperationTypes := make([]string, 0)
-			for _, operation := range item.Operations {
-				resOperationTypes = append(resOperationTypes, *operation.ClumioType)
-			}
-			opTypes, conversionDiags := types.SetValueFrom(ctx, types.StringType, resOperationTypes)
-			diags.Append(conversionDiags...)
-			if diags.HasError() {
-				return diags
-			}
-			attrTypes := make(map[string]attr.Type)
-			attrTypes[schemaId] = types.StringType
-			attrTypes[schemaName] = types.StringType
-			attrTypes[schemaActivationStatus] = types.StringType
-			attrTypes[schemaTimezone] = types.StringType
-			attrTypes[schemaOrganizationalUnitId] = types.StringType
-			attrTypes[schemaOperationTypes] = types.SetType{ElemType: types.StringType}
-
-			attrValues := make(map[string]attr.Value)
-			attrValues[schemaId] = basetypes.NewStringPointerValue(item.Id)
-			attrValues[schemaName] = basetypes.NewStringPointerValue(item.Name)
-			attrValues[schemaActivationStatus] = basetypes.NewStringPointerValue(
-				item.ActivationStatus)
-			attrValues[schemaTimezone] = basetypes.NewStringPointerValue(item.Timezone)
-			attrValues[schemaOrganizationalUnitId] = basetypes.NewStringPointerValue(
-				item.OrganizationalUnitId)
-			attrValues[schemaOperationTypes] = opTypes
-			obj, conversionDiags := types.ObjectValue(attrTypes, attrValues)
+			policy, conversionDiags := mapClumioPolicyToDataSourcePolicy(ctx, item)
 			diags.Append(conversionDiags...)
 			if diags.HasError() {
 				return diags
 			}
-			diags.Append(conversionDiags...)
-			attrVals = append(attrVals, obj)
+			policies = append(policies, policy)
 		}
-		setObj, listdiag := types.SetValue(objtype, attrVals)
-		diags.Append(listdiag...)
-		model.Policies = setObj
+		model.Policies = policies
 	}
 	return diags
 }
+
+// readPolicyById invokes the API to read the policy with the id of the given model and from the
+// response populates the policies of the model with the policy.
+func (r *clumioPolicyDataSource) readPolicyById(
+	ctx context.Context, model *clumioPolicyDataSourceModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	id := model.Id.ValueString()
+
+	// Call the Clumio API to read the policy definition.
+	res, apiErr := r.policyDefinitionClient.ReadPolicyDefinition(id, nil)
+	if apiErr != nil {
+		summary := fmt.Sprintf(errorPolicyReadMsg, r.name, id)
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return diags
+	}
+	if res == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return diags
+	}
+
+	// Convert the Clumio API response for the policy into the datasource schema model.
+	policy, conversionDiags := mapClumioPolicyToDataSourcePolicy(ctx, &models.Policy{
+		ActivationStatus:     res.ActivationStatus,
+		Id:                   res.Id,
+		LockStatus:           res.LockStatus,
+		Name:                 res.Name,
+		Operations:           res.Operations,
+		OrganizationalUnitId: res.OrganizationalUnitId,
+		Timezone:             res.Timezone,
+	})
+	diags.Append(conversionDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	model.Policies = []*clumioPolicyDataSourcePolicy{policy}
+	return diags
+}
+
+// mapClumioPolicyToDataSourcePolicy maps the given policy from the API response to the datasource
+// schema format, including its complete operations.
+func mapClumioPolicyToDataSourcePolicy(ctx context.Context, policy *models.Policy) (
+	*clumioPolicyDataSourcePolicy, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	operationTypes := make([]string, 0, len(policy.Operations))
+	for _, operation := range policy.Operations {
+		operationTypes = append(operationTypes, *operation.ClumioType)
+	}
+	opTypes, conversionDiags := types.SetValueFrom(ctx, types.StringType, operationTypes)
+	diags.Append(conversionDiags...)
+	operations, conversionDiags := mapClumioOperationsToSchemaOperations(ctx, policy.Operations)
+	diags.Append(conversionDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &clumioPolicyDataSourcePolicy{
+		Id:                   types.StringPointerValue(policy.Id),
+		Name:                 types.StringPointerValue(policy.Name),
+		OperationTypes:       opTypes,
+		ActivationStatus:     types.StringPointerValue(policy.ActivationStatus),
+		Timezone:             types.StringPointerValue(policy.Timezone),
+		OrganizationalUnitId: types.StringPointerValue(policy.OrganizationalUnitId),
+		LockStatus:           types.StringPointerValue(policy.LockStatus),
+		Operations:           operations,
+	}, diags
+}
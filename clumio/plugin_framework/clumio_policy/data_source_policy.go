@@ -12,6 +12,7 @@ import (
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -45,12 +46,25 @@ func (r *clumioPolicyDataSource) Metadata(
 // Configure sets up the datasource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioPolicyDataSource) Configure(
-	_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.policyDefinitionClient = sdkclients.NewPolicyDefinitionClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.policyDefinitionClient = sdkclients.NewPolicyDefinitionClient(
+		r.client.ClumioConfig, middlewares...)
+}
+
+// configureForOU makes the calls of the data source in the context of the organizational unit
+// given by its organizational_unit_context attribute, if set.
+func (r *clumioPolicyDataSource) configureForOU(ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		return
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.policyDefinitionClient = sdkclients.NewPolicyDefinitionClient(config, middlewares...)
 }
 
 // Read retrieves the datasource from the Clumio API and sets the Terraform state.
@@ -65,6 +79,9 @@ func (r *clumioPolicyDataSource) Read(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the data source, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	diags = r.readPolicy(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
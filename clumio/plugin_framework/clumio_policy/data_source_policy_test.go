@@ -67,6 +67,34 @@ func TestAccDataSourceClumioPolicy(t *testing.T) {
 	})
 }
 
+// Test of the clumio_policy datasource when the policy is looked up by its id. It tests that the
+// policy is set in state with its complete operations.
+func TestAccDataSourceClumioPolicyById(t *testing.T) {
+	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { clumioPf.UtilTestAccPreCheckClumio(t) },
+		ProtoV6ProviderFactories: clumioPf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDataSourceClumioPolicyById, baseUrl,
+					"acceptance-test-ds-policy-by-id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.clumio_policy.policy",
+						"policies.#", "1"),
+					resource.TestCheckResourceAttrPair("data.clumio_policy.policy",
+						"policies.0.id", "clumio_policy.datasource-test-policy", "id"),
+					resource.TestCheckResourceAttr("data.clumio_policy.policy",
+						"policies.0.operations.0.type", "aws_ebs_volume_backup"),
+					resource.TestCheckResourceAttr("data.clumio_policy.policy",
+						"policies.0.operations.0.slas.0.retention_duration.value", "5"),
+					resource.TestCheckResourceAttr("data.clumio_policy.policy",
+						"policies.0.operations.0.backup_window_tz.start_time", "05:00"),
+				),
+			},
+		},
+	})
+}
+
 // Test to validate that an error is returned if none of name, operation_types and activation_status
 // are specified in the config.
 func TestEmptyPolicyDataSource(t *testing.T) {
@@ -89,7 +117,7 @@ func TestEmptyPolicyDataSource(t *testing.T) {
 func getTestDataSourceClumioPolicy(baseUrl string,
 	includeName, includeOperationTypes, includeActivationStatus bool) string {
 
-	name := "datasource-test-policy"
+	name := "acceptance-test-ds-policy"
 	name1 := fmt.Sprintf(`%s1`, name)
 	name2 := fmt.Sprintf(`%s2`, name)
 	name3 := fmt.Sprintf(`%s3`, name)
@@ -117,83 +145,97 @@ provider clumio{
 resource "clumio_policy" "datasource-test-policy1" {
 	name = "%s"
 	timezone = "UTC"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+	]
 }
 
 resource "clumio_policy" "datasource-test-policy2" {
 	name = "%s"
 	timezone = "UTC"
 	activation_status = "deactivated"
-	operations {
-		action_setting = "immediate"
-		type = "protection_group_backup"
-		slas {
-			retention_duration {
-				unit = "months"
-				value = 3
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 2
-			}
-		}
-		advanced_settings {
-			protection_group_backup {
-				backup_tier = "cold"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "protection_group_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "months"
+						value = 3
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 2
+					}
+				},
+			]
+			advanced_settings = {
+				protection_group_backup = {
+					backup_tier = "cold"
+				}
 			}
-		}
-	}
+		},
+	]
 }
 
 resource "clumio_policy" "datasource-test-policy3" {
 	name = "%s"
 	timezone = "UTC"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-	}
-	operations {
-		action_setting = "immediate"
-		type = "protection_group_backup"
-		slas {
-			retention_duration {
-				unit = "months"
-				value = 3
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 2
-			}
-		}
-		advanced_settings {
-			protection_group_backup {
-				backup_tier = "cold"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+		{
+			action_setting = "immediate"
+			type = "protection_group_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "months"
+						value = 3
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 2
+					}
+				},
+			]
+			advanced_settings = {
+				protection_group_backup = {
+					backup_tier = "cold"
+				}
 			}
-		}
-	}
+		},
+	]
 }
 
 data "clumio_policy" "policies" {
@@ -214,3 +256,41 @@ provider clumio{
 
 data "clumio_policy" "policies" {}
 `
+
+// testAccDataSourceClumioPolicyById is the Terraform configuration for a clumio_policy datasource
+// looking up a policy by its id.
+const testAccDataSourceClumioPolicyById = `
+provider clumio{
+   clumio_api_base_url = "%s"
+}
+
+resource "clumio_policy" "datasource-test-policy" {
+	name = "%s"
+	timezone = "UTC"
+	operations = [
+		{
+			action_setting = "window"
+			type = "aws_ebs_volume_backup"
+			backup_window_tz = {
+				start_time = "05:00"
+			}
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+	]
+}
+
+data "clumio_policy" "policy" {
+	id = clumio_policy.datasource-test-policy.id
+}
+`
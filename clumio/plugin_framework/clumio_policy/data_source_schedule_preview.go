@@ -0,0 +1,108 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the datasource implementation for the clumio_policy_schedule_preview Terraform
+// datasource. This datasource is used to preview when the backups of policy operations run and
+// expire.
+
+package clumio_policy
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &policySchedulePreviewDataSource{}
+	_ datasource.DataSourceWithConfigure        = &policySchedulePreviewDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &policySchedulePreviewDataSource{}
+)
+
+// policySchedulePreviewDataSource is the struct backing the clumio_policy_schedule_preview
+// Terraform datasource. It holds the Clumio API client and any other required state needed to
+// read the policy whose operations are previewed.
+type policySchedulePreviewDataSource struct {
+	name                   string
+	client                 *common.ApiClient
+	policyDefinitionClient sdkclients.PolicyDefinitionClient
+}
+
+// NewPolicySchedulePreviewDataSource creates a new instance of policySchedulePreviewDataSource. Its
+// attributes are initialized later by Terraform via Metadata and Configure once the Provider is
+// initialized.
+func NewPolicySchedulePreviewDataSource() datasource.DataSource {
+	return &policySchedulePreviewDataSource{}
+}
+
+// Metadata returns the name of the datasource type. This is used by Terraform configurations to
+// instantiate the datasource.
+func (r *policySchedulePreviewDataSource) Metadata(
+	_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	r.name = req.ProviderTypeName + "_policy_schedule_preview"
+	resp.TypeName = r.name
+}
+
+// Configure sets up the datasource with the Clumio API client and any other required state. It is
+// called by Terraform once the Provider is initialized.
+func (r *policySchedulePreviewDataSource) Configure(
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*common.ApiClient)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.policyDefinitionClient = sdkclients.NewPolicyDefinitionClient(
+		r.client.ClumioConfig, middlewares...)
+}
+
+// configureForOU makes the calls of the data source in the context of the organizational unit
+// given by its organizational_unit_context attribute, if set.
+func (r *policySchedulePreviewDataSource) configureForOU(
+	ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		return
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.policyDefinitionClient = sdkclients.NewPolicyDefinitionClient(config, middlewares...)
+}
+
+// Read reads the policy with the given policy_id, if set, computes the projected backups of the
+// operations and sets the Terraform state.
+func (r *policySchedulePreviewDataSource) Read(
+	ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	// Retrieve the schema from the current Terraform state.
+	var state policySchedulePreviewDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.PolicyId.IsNull() {
+		// Make the calls in the context of the organizational unit of the data source, if set.
+		r.configureForOU(ctx, state.OrganizationalUnitContext)
+
+		diags = r.readPolicyOperations(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = previewSchedules(&state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the schema into the Terraform state.
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
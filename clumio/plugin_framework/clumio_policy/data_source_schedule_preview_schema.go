@@ -0,0 +1,274 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema datasource function used by the datasource model
+// for the clumio_policy_schedule_preview Terraform datasource.
+
+package clumio_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policySchedulePreviewDataSourceModel is the datasource model for the
+// clumio_policy_schedule_preview Terraform datasource. It represents the schema of the datasource
+// and the data it holds.
+type policySchedulePreviewDataSourceModel struct {
+	PolicyId                  types.String                     `tfsdk:"policy_id"`
+	Operations                []*schedulePreviewOperationModel `tfsdk:"operations"`
+	Timezone                  types.String                     `tfsdk:"timezone"`
+	StartTime                 types.String                     `tfsdk:"start_time"`
+	HorizonDays               types.Int64                      `tfsdk:"horizon_days"`
+	OrganizationalUnitContext types.String                     `tfsdk:"organizational_unit_context"`
+	Schedules                 []*schedulePreviewScheduleModel  `tfsdk:"schedules"`
+}
+
+// schedulePreviewOperationModel maps to the Operations attribute in
+// policySchedulePreviewDataSourceModel. It holds the attributes of a policy operation which
+// determine when its backups run and expire.
+type schedulePreviewOperationModel struct {
+	OperationType   types.String       `tfsdk:"type"`
+	BackupAwsRegion types.String       `tfsdk:"backup_aws_region"`
+	Timezone        types.String       `tfsdk:"timezone"`
+	BackupWindowTz  *backupWindowModel `tfsdk:"backup_window_tz"`
+	Slas            []*slaModel        `tfsdk:"slas"`
+}
+
+// schedulePreviewScheduleModel maps to the Schedules attribute in
+// policySchedulePreviewDataSourceModel and holds the projected backups of one SLA of an operation.
+type schedulePreviewScheduleModel struct {
+	OperationType      types.String                  `tfsdk:"type"`
+	BackupAwsRegion    types.String                  `tfsdk:"backup_aws_region"`
+	SlaIndex           types.Int64                   `tfsdk:"sla_index"`
+	Timezone           types.String                  `tfsdk:"timezone"`
+	MaxIntervalMinutes types.Int64                   `tfsdk:"max_interval_minutes"`
+	Backups            []*schedulePreviewBackupModel `tfsdk:"backups"`
+}
+
+// schedulePreviewBackupModel maps to the Backups attribute in schedulePreviewScheduleModel and
+// holds the window in which a backup is projected to run and when it expires.
+type schedulePreviewBackupModel struct {
+	WindowStart types.String `tfsdk:"window_start"`
+	WindowEnd   types.String `tfsdk:"window_end"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+// Schema defines the structure and constraints of the clumio_policy_schedule_preview Terraform
+// datasource. The operations to preview are either given in the config or read from the policy
+// with the given policy_id, and the projected backups of each of their SLAs are computed by the
+// provider without changing anything in Clumio.
+func (r *policySchedulePreviewDataSource) Schema(
+	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+
+	unitValueAttributes := map[string]schema.Attribute{
+		schemaUnit: schema.StringAttribute{
+			Description: "The measurement unit of the SLA parameter.",
+			Required:    true,
+		},
+		schemaValue: schema.Int64Attribute{
+			Description: "The measurement value of the SLA parameter.",
+			Required:    true,
+		},
+	}
+	rpoAttributes := map[string]schema.Attribute{
+		schemaUnit: unitValueAttributes[schemaUnit],
+		schemaValue: schema.Int64Attribute{
+			Description: "The measurement value of the SLA parameter. Leave it unset for" +
+				" on_demand backups.",
+			Optional: true,
+			Computed: true,
+		},
+		schemaOffsets: schema.ListAttribute{
+			Description: "The offset values of the SLA parameter. For weekly RPOs, the days" +
+				" of the week on which the backups run, where 0 is Sunday.",
+			ElementType: types.Int64Type,
+			Optional:    true,
+			Computed:    true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			schemaPolicyId: schema.StringAttribute{
+				Description: "Identifier of the policy whose operations are previewed.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" policy is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaOperations: schema.ListNestedAttribute{
+				Description: "The policy operations to preview. If policy_id is set, the" +
+					" operations of the policy.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						schemaOperationType: schema.StringAttribute{
+							Description: "The type of operation to be performed.",
+							Required:    true,
+						},
+						schemaBackupAwsRegion: schema.StringAttribute{
+							Description: "The region in which the backups are stored.",
+							Optional:    true,
+							Computed:    true,
+						},
+						schemaTimezone: schema.StringAttribute{
+							Description: "The time zone of the operation, in IANA format. If" +
+								" not set, the timezone of the data source is used.",
+							Optional: true,
+							Computed: true,
+						},
+						schemaBackupWindowTz: schema.SingleNestedAttribute{
+							Description: "The start and end times of the backup window," +
+								" in the hh:mm format of the 24 hour clock.",
+							Optional: true,
+							Computed: true,
+							Attributes: map[string]schema.Attribute{
+								schemaStartTime: schema.StringAttribute{
+									Description: "The time when the backup window opens.",
+									Optional:    true,
+									Computed:    true,
+								},
+								schemaEndTime: schema.StringAttribute{
+									Description: "The time when the backup window closes.",
+									Optional:    true,
+									Computed:    true,
+								},
+							},
+						},
+						schemaSlas: schema.ListNestedAttribute{
+							Description: "The service level agreements (SLAs) of the operation.",
+							Required:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									schemaRetentionDuration: schema.SingleNestedAttribute{
+										Description: "The retention time of the backups.",
+										Required:    true,
+										Attributes:  unitValueAttributes,
+									},
+									schemaRpoFrequency: schema.SingleNestedAttribute{
+										Description: "The minimum frequency between backups.",
+										Required:    true,
+										Attributes:  rpoAttributes,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			schemaTimezone: schema.StringAttribute{
+				Description: "The time zone of the operations which do not set one, in IANA" +
+					" format. If not set, the time zone of the policy is used, or UTC.",
+				Optional: true,
+				Computed: true,
+			},
+			schemaStartTime: schema.StringAttribute{
+				Description: "The time from which the backups are previewed, in RFC 3339" +
+					" format. If not set, the current time is used.",
+				Optional: true,
+				Computed: true,
+			},
+			schemaHorizonDays: schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of days for which the backups are"+
+					" previewed. Defaults to %d days.", defaultHorizonDays),
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxHorizonDays),
+				},
+			},
+			schemaSchedules: schema.ListNestedAttribute{
+				Description: "The projected backups of every SLA of the operations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						schemaOperationType: schema.StringAttribute{
+							Description: "The type of the operation of the SLA.",
+							Computed:    true,
+						},
+						schemaBackupAwsRegion: schema.StringAttribute{
+							Description: "The backup region of the operation of the SLA.",
+							Computed:    true,
+						},
+						schemaSlaIndex: schema.Int64Attribute{
+							Description: "The index of the SLA in the slas of the operation.",
+							Computed:    true,
+						},
+						schemaTimezone: schema.StringAttribute{
+							Description: "The time zone in which the backups are scheduled.",
+							Computed:    true,
+						},
+						schemaMaxIntervalMinutes: schema.Int64Attribute{
+							Description: "The longest possible time between two consecutive" +
+								" backups in minutes, from the start of a backup window to the" +
+								" end of the next one. 0 if fewer than two backups are projected.",
+							Computed: true,
+						},
+						schemaBackups: schema.ListNestedAttribute{
+							Description: "The projected backups in chronological order.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									schemaWindowStart: schema.StringAttribute{
+										Description: "The time when the backup can start," +
+											" in RFC 3339 format.",
+										Computed: true,
+									},
+									schemaWindowEnd: schema.StringAttribute{
+										Description: "The time by which the backup must" +
+											" start, in RFC 3339 format.",
+										Computed: true,
+									},
+									schemaExpiresAt: schema.StringAttribute{
+										Description: "The earliest time when the backup" +
+											" expires, in RFC 3339 format.",
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Description: "clumio_policy_schedule_preview data source is used to preview when the" +
+			" backups of policy operations run and expire. The backups are computed by the" +
+			" provider from the operations, or from the policy with the given policy_id, so" +
+			" that RPO and retention compliance can be checked before the policy is applied." +
+			" Exactly one of 'policy_id' or 'operations' must be specified in the config.",
+	}
+}
+
+// ConfigValidators to check that exactly one of policy_id or operations is specified.
+func (r *policySchedulePreviewDataSource) ConfigValidators(
+	_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(schemaPolicyId),
+			path.MatchRoot(schemaOperations),
+		),
+	}
+}
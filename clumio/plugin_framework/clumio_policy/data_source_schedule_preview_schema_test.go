@@ -0,0 +1,38 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit test for the Schema function in
+// data_source_schedule_preview_schema.go.
+
+//go:build unit
+
+package clumio_policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSchedulePreviewDatasourceSchema checks the schema returned for a given resource.
+func TestSchedulePreviewDatasourceSchema(t *testing.T) {
+
+	ds := &policySchedulePreviewDataSource{}
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	assert.NotNil(t, resp.Schema)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	// Ensure that all attributes have a description set.
+	for _, attr := range resp.Schema.Attributes {
+		assert.NotEmpty(t, attr.GetDescription())
+	}
+}
+
+// TestSchedulePreviewDatasourceConfigValidators checks if a config validator is returned.
+func TestSchedulePreviewDatasourceConfigValidators(t *testing.T) {
+	ds := &policySchedulePreviewDataSource{}
+	validators := ds.ConfigValidators(context.Background())
+	assert.Equal(t, 1, len(validators))
+}
@@ -7,6 +7,7 @@ package clumio_policy
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -21,22 +22,59 @@ import (
 // represents the schema of the datasource and the data it holds. This schema is used by customers
 // to configure the datasource and by the Clumio provider to read and write the datasource.
 type clumioPolicyDataSourceModel struct {
-	Name             types.String `tfsdk:"name"`
-	ActivationStatus types.String `tfsdk:"activation_status"`
-	OperationTypes   types.Set    `tfsdk:"operation_types"`
-	Policies         types.Set    `tfsdk:"policies"`
+	Id                        types.String                    `tfsdk:"id"`
+	Name                      types.String                    `tfsdk:"name"`
+	ActivationStatus          types.String                    `tfsdk:"activation_status"`
+	OperationTypes            types.Set                       `tfsdk:"operation_types"`
+	Policies                  []*clumioPolicyDataSourcePolicy `tfsdk:"policies"`
+	OrganizationalUnitContext types.String                    `tfsdk:"organizational_unit_context"`
+}
+
+// clumioPolicyDataSourcePolicy maps to the Policies attribute in clumioPolicyDataSourceModel and
+// holds the details of a policy read by the datasource, including its complete operations.
+type clumioPolicyDataSourcePolicy struct {
+	Id                   types.String            `tfsdk:"id"`
+	Name                 types.String            `tfsdk:"name"`
+	OperationTypes       types.Set               `tfsdk:"operation_types"`
+	ActivationStatus     types.String            `tfsdk:"activation_status"`
+	Timezone             types.String            `tfsdk:"timezone"`
+	OrganizationalUnitId types.String            `tfsdk:"organizational_unit_id"`
+	LockStatus           types.String            `tfsdk:"lock_status"`
+	Operations           []*policyOperationModel `tfsdk:"operations"`
 }
 
 // Schema defines the structure and constraints of the clumio_policy Terraform datasource. Schema is
 // a method on the clumioPolicyDataSource struct. It sets the schema for the clumio_policy Terraform
 // datasource. The schema defines various attributes such as the policy name, operation_types,
 // activation_status, etc, some of which are computed, meaning they are determined by Clumio at
-// runtime, whereas 'name', 'operation_types' and 'activation_status' attributes are used to
+// runtime, whereas 'id', 'name', 'operation_types' and 'activation_status' attributes are used to
 // determine the Clumio policies to retrieve.
 func (r *clumioPolicyDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" data source is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaId: schema.StringAttribute{
+				Description: "Unique identifier of the policy to retrieve. Cannot be combined" +
+					" with 'name', 'operation_types' or 'activation_status'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot(schemaName),
+						path.MatchRoot(schemaOperationTypes),
+						path.MatchRoot(schemaActivationStatus),
+					),
+				},
+			},
 			schemaName: schema.StringAttribute{
 				Description: "The name of the policy to be included in the read policies query.",
 				Optional:    true,
@@ -83,6 +121,11 @@ func (r *clumioPolicyDataSource) Schema(
 								"with the policy.",
 							Computed: true,
 						},
+						schemaLockStatus: schema.StringAttribute{
+							Description: "Policy Lock Status.",
+							Computed:    true,
+						},
+						schemaOperations: dataSourceOperationsAttribute(),
 					},
 				},
 				Computed:    true,
@@ -90,18 +133,170 @@ func (r *clumioPolicyDataSource) Schema(
 			},
 		},
 		Description: "clumio_policy data source is used to retrieve details of the policies for use" +
-			" in other resources. At least one of 'name', 'activation_status' or 'operation_types'" +
-			" must be specified in the config.",
+			" in other resources. At least one of 'id', 'name', 'activation_status' or" +
+			" 'operation_types' must be specified in the config.",
 	}
 }
 
-// ConfigValidators to check if at least one of name, operation_types or activation_status is specified.
+// ConfigValidators to check if at least one of id, name, operation_types or activation_status is
+// specified.
 func (r *clumioPolicyDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{
 		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot(schemaId),
 			path.MatchRoot(schemaName),
 			path.MatchRoot(schemaOperationTypes),
 			path.MatchRoot(schemaActivationStatus),
 		),
 	}
 }
+
+// dataSourceOperationsAttribute returns the computed attribute holding the complete operations of
+// a policy read by the datasource. Its structure matches the operations of the clumio_policy
+// resource so that the operations can be inspected or copied into other policies.
+func dataSourceOperationsAttribute() schema.ListNestedAttribute {
+
+	unitValueAttributes := map[string]schema.Attribute{
+		schemaUnit: schema.StringAttribute{
+			Description: "The measurement unit of the SLA parameter.",
+			Computed:    true,
+		},
+		schemaValue: schema.Int64Attribute{
+			Description: "The measurement value of the SLA parameter.",
+			Computed:    true,
+		},
+	}
+	rpoAttributes := map[string]schema.Attribute{
+		schemaUnit:  unitValueAttributes[schemaUnit],
+		schemaValue: unitValueAttributes[schemaValue],
+		schemaOffsets: schema.ListAttribute{
+			Description: "The offset values of the SLA parameter.",
+			Computed:    true,
+			ElementType: types.Int64Type,
+		},
+	}
+	replicaAttributes := func(backupType string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			schemaAlternativeReplica: schema.StringAttribute{
+				Description: fmt.Sprintf(alternativeReplicaDescFmt, backupType),
+				Computed:    true,
+			},
+			schemaPreferredReplica: schema.StringAttribute{
+				Description: fmt.Sprintf(preferredReplicaDescFmt, backupType),
+				Computed:    true,
+			},
+		}
+	}
+	nestedAttribute := func(
+		description string, attributes map[string]schema.Attribute) schema.Attribute {
+		return schema.SingleNestedAttribute{
+			Description: description,
+			Computed:    true,
+			Attributes:  attributes,
+		}
+	}
+	backupTierAttributes := func(description string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			schemaBackupTier: schema.StringAttribute{
+				Description: description,
+				Computed:    true,
+			},
+		}
+	}
+
+	advancedSettingsAttributes := map[string]schema.Attribute{
+		schemaEc2MssqlDatabaseBackup: nestedAttribute(mssqlDatabaseBackupDesc,
+			replicaAttributes("database")),
+		schemaEc2MssqlLogBackup: nestedAttribute(mssqlLogBackupDesc, replicaAttributes("log")),
+		schemaMssqlDatabaseBackup: nestedAttribute(mssqlDatabaseBackupDesc,
+			replicaAttributes("database")),
+		schemaMssqlLogBackup: nestedAttribute(mssqlLogBackupDesc, replicaAttributes("log")),
+		schemaProtectionGroupBackup: nestedAttribute("Additional policy configuration settings"+
+			" for the protection_group_backup operation.",
+			backupTierAttributes("Backup tier to store the backup in.")),
+		schemaS3ContinuousBackup: nestedAttribute(S3ContinuousBackupDesc,
+			map[string]schema.Attribute{
+				schemaDisableEventbridgeNotification: schema.BoolAttribute{
+					Description: DisableEventbridgeNotificationDesc,
+					Computed:    true,
+				},
+			}),
+		schemaEBSVolumeBackup: nestedAttribute(ebsBackupDesc,
+			backupTierAttributes(ebsEc2BackupTierDesc)),
+		schemaEC2InstanceBackup: nestedAttribute(ec2BackupDesc,
+			backupTierAttributes(ebsEc2BackupTierDesc)),
+		schemaRDSPitrConfigSync: nestedAttribute(rdsPitrConfigSyncDesc,
+			map[string]schema.Attribute{
+				schemaApply: schema.StringAttribute{
+					Description: pitrConfigDesc,
+					Computed:    true,
+				},
+			}),
+		schemaRdsLogicalBackup: nestedAttribute(rdsLogicalBackupDesc,
+			backupTierAttributes(rdsLogicalBackupAdvancedSettingDesc)),
+		schemaIcebergTableBackup: nestedAttribute(
+			"The advanced settings for Iceberg backup operations.",
+			backupTierAttributes("Backup tier to store the backup in.")),
+		schemaDynamoDBTableBackup: nestedAttribute(dynamoDBBackupDesc,
+			backupTierAttributes(dynamoDBBackupTierDesc)),
+		schemaS3Backtrack: nestedAttribute(s3BacktrackDesc,
+			map[string]schema.Attribute{
+				schemaDisableEventbridgeNotification: schema.BoolAttribute{
+					Description: s3BacktrackDisableEventbridgeNotificationDesc,
+					Computed:    true,
+				},
+			}),
+	}
+
+	return schema.ListNestedAttribute{
+		Description: "The operations of the policy.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				schemaActionSetting: schema.StringAttribute{
+					Description: "Determines whether the policy takes action now or during the" +
+						" backup window.",
+					Computed: true,
+				},
+				schemaOperationType: schema.StringAttribute{
+					Description: "The type of operation to be performed.",
+					Computed:    true,
+				},
+				schemaBackupAwsRegion: schema.StringAttribute{
+					Description: "The region in which the backups are stored.",
+					Computed:    true,
+				},
+				schemaTimezone: schema.StringAttribute{
+					Description: "The time zone of the operation, in IANA format.",
+					Computed:    true,
+				},
+				schemaBackupWindowTz: nestedAttribute("The start and end times of the backup"+
+					" window, in the hh:mm format of the 24 hour clock.",
+					map[string]schema.Attribute{
+						schemaStartTime: schema.StringAttribute{
+							Description: "The time when the backup window opens.",
+							Computed:    true,
+						},
+						schemaEndTime: schema.StringAttribute{
+							Description: "The time when the backup window closes.",
+							Computed:    true,
+						},
+					}),
+				schemaSlas: schema.ListNestedAttribute{
+					Description: "The service level agreements (SLAs) of the operation.",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							schemaRetentionDuration: nestedAttribute(
+								"The retention time of the backups.", unitValueAttributes),
+							schemaRpoFrequency: nestedAttribute(
+								"The minimum frequency between backups.", rpoAttributes),
+						},
+					},
+				},
+				schemaAdvancedSettings: nestedAttribute(
+					"Additional operation-specific policy settings.", advancedSettingsAttributes),
+			},
+		},
+	}
+}
@@ -90,6 +90,11 @@ func TestDatasourceReadPolicy(t *testing.T) {
 
 		diags := rds.readPolicy(ctx, rdsm)
 		assert.Nil(t, diags)
+		assert.Len(t, rdsm.Policies, 1)
+		assert.Equal(t, id, rdsm.Policies[0].Id.ValueString())
+		assert.Len(t, rdsm.Policies[0].Operations, 1)
+		assert.Equal(t, operationType,
+			rdsm.Policies[0].Operations[0].OperationType.ValueString())
 	})
 
 	// Tests that Diagnostics is returned in case the list policy definitions API call returns an
@@ -116,3 +121,137 @@ func TestDatasourceReadPolicy(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 }
+
+// Unit test for the following cases:
+//   - Read policy by ID success scenario.
+//   - SDK API for read policy definition returns an error.
+//   - SDK API for read policy definition returns an empty response.
+func TestDatasourceReadPolicyById(t *testing.T) {
+
+	ctx := context.Background()
+	policyClient := sdkclients.NewMockPolicyDefinitionClient(t)
+	name := "test-policy"
+	resourceName := "test_policy"
+	id := "test-policy-id"
+	activationStatus := "activated"
+	lockStatus := "unlocked"
+	operationType := "aws_ebs_volume_backup"
+	actionSetting := "window"
+	startTime := "01:00"
+	unit := "days"
+	retentionValue := int64(7)
+	rpoValue := int64(1)
+	backupTier := "lite"
+	timezone := "UTC"
+	ou := "test-ou"
+	testError := "Test Error"
+
+	rds := clumioPolicyDataSource{
+		name: resourceName,
+		client: &common.ApiClient{
+			ClumioConfig: sdkconfig.Config{},
+		},
+		policyDefinitionClient: policyClient,
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 404,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests that the policy with the given ID is read with its complete operations.
+	t.Run("Basic success scenario for read policy by ID", func(t *testing.T) {
+
+		rdsm := &clumioPolicyDataSourceModel{
+			Id: basetypes.NewStringValue(id),
+		}
+		readResponse := &models.ReadPolicyResponse{
+			ActivationStatus: &activationStatus,
+			Id:               &id,
+			LockStatus:       &lockStatus,
+			Name:             &name,
+			Operations: []*models.PolicyOperation{
+				{
+					ActionSetting: &actionSetting,
+					ClumioType:    &operationType,
+					BackupWindowTz: &models.BackupWindow{
+						StartTime: &startTime,
+					},
+					Slas: []*models.BackupSLA{
+						{
+							RetentionDuration: &models.RetentionBackupSLAParam{
+								Unit:  &unit,
+								Value: &retentionValue,
+							},
+							RpoFrequency: &models.RPOBackupSLAParam{
+								Unit:  &unit,
+								Value: &rpoValue,
+							},
+						},
+					},
+					AdvancedSettings: &models.PolicyAdvancedSettings{
+						AwsEbsVolumeBackup: &models.EBSBackupAdvancedSetting{
+							BackupTier: &backupTier,
+						},
+					},
+				},
+			},
+			OrganizationalUnitId: &ou,
+			Timezone:             &timezone,
+		}
+
+		// Setup expectations.
+		policyClient.EXPECT().ReadPolicyDefinition(id, mock.Anything).Times(1).
+			Return(readResponse, nil)
+
+		diags := rds.readPolicy(ctx, rdsm)
+		assert.Nil(t, diags)
+		assert.Len(t, rdsm.Policies, 1)
+		policy := rdsm.Policies[0]
+		assert.Equal(t, id, policy.Id.ValueString())
+		assert.Equal(t, lockStatus, policy.LockStatus.ValueString())
+		assert.Equal(t, ou, policy.OrganizationalUnitId.ValueString())
+		assert.Len(t, policy.Operations, 1)
+		operation := policy.Operations[0]
+		assert.Equal(t, actionSetting, operation.ActionSetting.ValueString())
+		assert.Equal(t, startTime, operation.BackupWindowTz.StartTime.ValueString())
+		assert.Equal(t, retentionValue, operation.Slas[0].RetentionDuration.Value.ValueInt64())
+		assert.Equal(t, backupTier,
+			operation.AdvancedSettings.EBSVolumeBackup.BackupTier.ValueString())
+	})
+
+	// Tests that Diagnostics is returned in case the read policy definition API call returns an
+	// error.
+	t.Run("read policy definition returns an error", func(t *testing.T) {
+
+		rdsm := &clumioPolicyDataSourceModel{
+			Id: basetypes.NewStringValue(id),
+		}
+
+		// Setup expectations.
+		policyClient.EXPECT().ReadPolicyDefinition(id, mock.Anything).Times(1).
+			Return(nil, apiError)
+
+		diags := rds.readPolicy(ctx, rdsm)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the read policy definition API call returns an
+	// empty response.
+	t.Run("read policy definition returns an empty response", func(t *testing.T) {
+
+		rdsm := &clumioPolicyDataSourceModel{
+			Id: basetypes.NewStringValue(id),
+		}
+
+		// Setup expectations.
+		policyClient.EXPECT().ReadPolicyDefinition(id, mock.Anything).Times(1).
+			Return(nil, nil)
+
+		diags := rds.readPolicy(ctx, rdsm)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
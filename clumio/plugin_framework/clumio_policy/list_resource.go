@@ -0,0 +1,72 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the logic to invoke the Clumio Policy SDK API to list the policies matching the
+// filters of the list block of the list resource.
+
+package clumio_policy
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listPolicies invokes the API to list the policies matching the filters of the given list block.
+func (r *policyListResource) listPolicies(ctx context.Context, model *policyListResourceModel,
+	options common.ListOptions) ([]common.ListItem, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	queryFilter := common.NewFilter()
+
+	// Prepare the query filter.
+	name := model.Name.ValueString()
+	if name != "" {
+		queryFilter.BeginsWith("name", name)
+	}
+	if !model.OperationTypes.IsUnknown() && !model.OperationTypes.IsNull() {
+		operationTypes := make([]string, 0)
+		conversionDiags := model.OperationTypes.ElementsAs(ctx, &operationTypes, false)
+		diags.Append(conversionDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		queryFilter.In("operations.type", operationTypes)
+	}
+	activationStatus := model.ActivationStatus.ValueString()
+	if activationStatus != "" {
+		queryFilter.Eq("activation_status", activationStatus)
+	}
+	filter := queryFilter.OptionalString()
+
+	// Call the Clumio API to list the policy definitions. The API returns every policy in a single
+	// page.
+	items, listDiags := common.ListAll(ctx, r.resource.name, options,
+		func(_ *int64, _ *string) (*common.Page[common.ListItem], *apiutils.APIError) {
+			res, apiErr := r.resource.sdkPolicyDefinitions.ListPolicyDefinitions(filter, nil)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[common.ListItem]{}
+			if res.Embedded != nil {
+				for _, item := range res.Embedded.Items {
+					if item.Id == nil {
+						continue
+					}
+					page.Items = append(page.Items, common.ListItem{
+						Id:          *item.Id,
+						DisplayName: types.StringPointerValue(item.Name).ValueString(),
+						Identity: policyResourceIdentityModel{
+							ID: types.StringPointerValue(item.Id),
+						},
+					})
+				}
+			}
+			return page, nil
+		})
+	diags.Append(listDiags...)
+	return items, diags
+}
@@ -0,0 +1,79 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the list resource implementation for the clumio_policy Terraform list resource.
+// This list resource is used by `terraform query` to list the existing policies within Clumio.
+
+package clumio_policy
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &policyListResource{}
+	_ list.ListResourceWithConfigure = &policyListResource{}
+)
+
+// policyListResource is the struct backing the clumio_policy Terraform list resource. It wraps the
+// clumio_policy resource, whose Clumio API clients are used to list the policies and whose read
+// logic is used to populate the listed resources.
+type policyListResource struct {
+	resource *policyResource
+}
+
+// NewPolicyListResource creates a new instance of policyListResource. Its attributes are
+// initialized later by Terraform via Metadata and Configure once the Provider is initialized.
+func NewPolicyListResource() list.ListResource {
+	return &policyListResource{
+		resource: &policyResource{},
+	}
+}
+
+// Metadata returns the name of the list resource type, which is the name of the resource it lists.
+func (r *policyListResource) Metadata(
+	ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	r.resource.Metadata(ctx, req, resp)
+}
+
+// Configure sets up the list resource with the Clumio API client and any other required state. It
+// is called by Terraform once the Provider is initialized.
+func (r *policyListResource) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.resource.Configure(ctx, req, resp)
+}
+
+// List streams the policies matching the filters of the list block to Terraform.
+func (r *policyListResource) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+
+	// Retrieve the filters from the list block.
+	var config policyListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// Make the calls in the context of the organizational unit of the list block, if set.
+	r.resource.configureForOU(ctx, config.OrganizationalUnitContext)
+
+	items, diags := r.listPolicies(ctx, &config, common.ListLimit(req))
+	stream.Results = common.ListResults(ctx, req, items, diags,
+		func(ctx context.Context, id string, model *policyResourceModel) (
+			bool, diag.Diagnostics) {
+
+			model.ID = types.StringValue(id)
+			model.OrganizationalUnitContext = config.OrganizationalUnitContext
+			return r.resource.readPolicy(ctx, model)
+		})
+}
@@ -0,0 +1,66 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_policy Terraform list resource.
+
+package clumio_policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyListResourceModel is the list resource model for the clumio_policy Terraform list
+// resource. It represents the filters of the list block used by `terraform query` to select the
+// policies to list.
+type policyListResourceModel struct {
+	Name                      types.String `tfsdk:"name"`
+	ActivationStatus          types.String `tfsdk:"activation_status"`
+	OperationTypes            types.List   `tfsdk:"operation_types"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_policy Terraform list resource. Its filters are the same as the ones of the clumio_policy
+// data source, and every policy is listed if none is set.
+func (r *policyListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_policy list resource is used to list the existing policies with" +
+			" `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" policies are listed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaName: schema.StringAttribute{
+				Description: "Lists the policies whose name begins with the given value.",
+				Optional:    true,
+			},
+			schemaOperationTypes: schema.ListAttribute{
+				Description: "Lists the policies with an operation of one of the given types.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			schemaActivationStatus: schema.StringAttribute{
+				Description: "Lists the policies with the given activation status. Valid values" +
+					" are activated/deactivated.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(activationStatusActivated, activationStatusDectivated),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in list_resource.go
+
+//go:build unit
+
+package clumio_policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Unit test for the following cases:
+//   - List policies success scenario.
+//   - SDK API for list policies returns an error.
+//   - SDK API for list policies returns an empty response.
+func TestListPolicies(t *testing.T) {
+
+	ctx := context.Background()
+	mockClient := sdkclients.NewMockPolicyDefinitionClient(t)
+	id := "test-id"
+	name := "test-name"
+	testError := "Test Error"
+
+	lr := &policyListResource{
+		resource: &policyResource{
+			name:                 "clumio_policy",
+			sdkPolicyDefinitions: mockClient,
+		},
+	}
+	model := &policyListResourceModel{
+		Name: basetypes.NewStringValue(name),
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests the success scenario for listing the policies. It should not return Diagnostics.
+	t.Run("Basic success scenario for list policies", func(t *testing.T) {
+
+		listResponse := &models.ListPoliciesResponse{
+			Embedded: &models.PolicyListEmbedded{
+				Items: []*models.Policy{
+					{
+						Id:   &id,
+						Name: &name,
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyDefinitions(mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listPolicies(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Equal(t, []common.ListItem{
+			{
+				Id:          id,
+				DisplayName: name,
+				Identity: policyResourceIdentityModel{
+					ID: basetypes.NewStringValue(id),
+				},
+			},
+		}, items)
+	})
+
+	// Tests that Diagnostics is returned in case the list policies API call returns an error.
+	t.Run("list policies returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyDefinitions(mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := lr.listPolicies(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list policies API call returns an empty
+	// response.
+	t.Run("list policies returns an empty response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyDefinitions(mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := lr.listPolicies(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
@@ -14,10 +14,36 @@ import (
 
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+var (
+	// operationsPath and slasPath match the attributes of an operation of the policy and of an SLA
+	// of an operation, taking the list indices of the fields of the requests they are set in.
+	operationsPath = path.MatchRoot(schemaOperations).AtAnyListIndex()
+	slasPath       = operationsPath.AtName(schemaSlas).AtAnyListIndex()
+
+	// apiFieldPaths maps the fields of the policy definition requests to the attributes they are
+	// set from, so that the field errors returned by the Clumio API are reported on those
+	// attributes.
+	apiFieldPaths = common.ApiFieldPaths{
+		schemaActivationStatus: path.MatchRoot(schemaActivationStatus),
+		schemaName:             path.MatchRoot(schemaName),
+		schemaTimezone:         path.MatchRoot(schemaTimezone),
+		schemaOperations:       operationsPath,
+		schemaOperations + "." + schemaBackupWindowTz: operationsPath.AtName(
+			schemaBackupWindowTz),
+		schemaOperations + "." + schemaAdvancedSettings: operationsPath.AtName(
+			schemaAdvancedSettings),
+		schemaOperations + "." + schemaSlas + "." + schemaRetentionDuration: slasPath.AtName(
+			schemaRetentionDuration),
+		schemaOperations + "." + schemaSlas + "." + schemaRpoFrequency: slasPath.AtName(
+			schemaRpoFrequency),
+	}
+)
+
 // createPolicy invokes the API to create the policy definition and from the response populates the
 // computed attributes of the policy.
 func (r *policyResource) createPolicy(
@@ -40,8 +66,7 @@ func (r *policyResource) createPolicy(
 	res, apiErr := r.sdkPolicyDefinitions.CreatePolicyDefinition(pdRequest)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to create %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -120,8 +145,7 @@ func (r *policyResource) updatePolicy(
 		plan.ID.ValueString(), nil, pdRequest)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to update %s (ID: %v)", r.name, plan.ID.ValueString())
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -132,11 +156,11 @@ func (r *policyResource) updatePolicy(
 	}
 
 	// Since updating a policy is an asynchronous operation, poll till the update is completed.
-	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf("Unable to update %s (ID: %v)", r.name, plan.ID.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 		return diags
 	}
 
@@ -159,7 +183,13 @@ func (r *policyResource) updatePolicy(
 func (r *policyResource) deletePolicy(
 	ctx context.Context, state *policyResourceModel) diag.Diagnostics {
 
-	var diags diag.Diagnostics
+	// Refuse to delete the policy if its deletion protection is enabled.
+	diags := common.CheckDeletionProtection(
+		r.client, state.DeletionProtection, r.name, state.ID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
 	// Call the Clumio API to delete the policy.
 	res, apiErr := r.sdkPolicyDefinitions.DeletePolicyDefinition(state.ID.ValueString())
 	if apiErr != nil {
@@ -179,11 +209,11 @@ func (r *policyResource) deletePolicy(
 	}
 
 	// Since deleting a policy is an asynchronous operation, poll till the deletion is completed.
-	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf("Unable to delete %s (ID: %v)", r.name, state.ID.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 		return diags
 	}
 	return diags
@@ -14,12 +14,17 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
-	_ resource.Resource                = &policyResource{}
-	_ resource.ResourceWithConfigure   = &policyResource{}
-	_ resource.ResourceWithImportState = &policyResource{}
+	_ resource.Resource                   = &policyResource{}
+	_ resource.ResourceWithConfigure      = &policyResource{}
+	_ resource.ResourceWithImportState    = &policyResource{}
+	_ resource.ResourceWithIdentity       = &policyResource{}
+	_ resource.ResourceWithModifyPlan     = &policyResource{}
+	_ resource.ResourceWithValidateConfig = &policyResource{}
+	_ resource.ResourceWithUpgradeState   = &policyResource{}
 )
 
 // policyResource is the struct backing the clumio_policy Terraform resource. It holds the Clumio
@@ -50,18 +55,65 @@ func (r *policyResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *policyResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkPolicyDefinitions = sdkclients.NewPolicyDefinitionClient(r.client.ClumioConfig)
-	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyDefinitions = sdkclients.NewPolicyDefinitionClient(
+		r.client.ClumioConfig, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig, middlewares...)
 	r.pollTimeout = 3600 * time.Second
 	r.pollInterval = 5 * time.Second
 }
 
+// configureForOU creates the SDK clients of the resource, making the calls in the context of the
+// organizational unit given by its organizational_unit_context attribute, if set. The clients are
+// created for every operation after its timeout is applied to the given context, to which the
+// middlewares are bound, so that the retries, the limiter and the polls honor the timeout.
+func (r *policyResource) configureForOU(ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		config = r.client.ClumioConfig
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyDefinitions = sdkclients.NewPolicyDefinitionClient(config, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(config, middlewares...)
+}
+
+// ValidateConfig validates the operations of the policy against the capabilities of their
+// operation types, so that mistakes are reported before any resource is changed.
+func (r *policyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+
+	// A configuration whose blocks are not known yet, such as dynamic blocks iterating over the
+	// attributes of other resources, cannot be read and is validated by ModifyPlan once known.
+	var config policyResourceModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validatePolicy(&config)...)
+}
+
+// ModifyPlan validates the planned operations of the policy. Values which are unknown when the
+// configuration is validated, such as those computed from other resources, are validated once
+// they are known, before the policy is created or updated.
+func (r *policyResource) ModifyPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+
+	// There is nothing to validate if the policy is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan policyResourceModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validatePolicy(&plan)...)
+}
+
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
 func (r *policyResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -74,6 +126,19 @@ func (r *policyResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	// Call the Clumio API to create the policy.
 	diags = r.createPolicy(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -86,6 +151,10 @@ func (r *policyResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -100,6 +169,19 @@ func (r *policyResource) Read(
 		return
 	}
 
+	// Bound the operation by the read timeout given in the timeouts block, if any.
+	readTimeout, diags := state.Timeouts.Read(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	// Call the Clumio API to read the policy.
 	remove, diags := r.readPolicy(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -116,6 +198,10 @@ func (r *policyResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -130,6 +216,19 @@ func (r *policyResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	// Call the Clumio API to update the policy.
 	diags = r.updatePolicy(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -142,6 +241,10 @@ func (r *policyResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -156,6 +259,19 @@ func (r *policyResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	// Call the Clumio API to delete the policy.
 	diags = r.deletePolicy(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -165,9 +281,9 @@ func (r *policyResource) Delete(
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done by the ID of the resource, given either as the import ID or in the resource identity.
 func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,147 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the acceptance tests of the clumio_policy Terraform resource which run against
+// the fake Clumio API. Please view the README.md file for more information on how to run these
+// tests.
+
+//go:build fake
+
+package clumio_policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	clumiopf "github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test of the clumio_policy resource against the fake Clumio API. It tests the following
+// scenarios:
+//   - Creates a policy and verifies that the plan was applied properly.
+//   - Updates the policy and verifies that the update is applied once its task completes.
+//   - Imports the policy by ID.
+//   - Changes the policy outside of Terraform and verifies that the drift is planned to be undone.
+//   - Deletes the policy outside of Terraform and verifies that it is planned to be recreated.
+func TestAccFakeResourceClumioPolicy(t *testing.T) {
+
+	server := clumiopf.UtilTestAccStartFakeApi(t)
+	resourceName := "clumio_policy.test_policy"
+	var id string
+	updatedConfig := getTestAccFakeResourceClumioPolicy("acceptance-test-policy-updated")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: clumiopf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccFakeResourceClumioPolicy("acceptance-test-policy"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", "acceptance-test-policy"),
+					resource.TestCheckResourceAttr(resourceName, "lock_status", "unlocked"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: getTestAccFakeResourceClumioPolicy("acceptance-test-policy-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", "acceptance-test-policy-updated"),
+					checkFakePolicyName(server, &id, "acceptance-test-policy-updated"),
+				),
+			},
+			clumiopf.UtilTestAccFakeImportStep(resourceName, updatedConfig, &id),
+			clumiopf.UtilTestAccFakeDriftStep(server, fakeapi.Policies, &id,
+				map[string]any{"name": "changed-outside-of-terraform"}, resourceName,
+				updatedConfig, checkFakePolicyName(server, &id, "acceptance-test-policy-updated")),
+			clumiopf.UtilTestAccFakeDeleteStep(
+				server, fakeapi.Policies, &id, resourceName, updatedConfig),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if policies := server.Objects(fakeapi.Policies); len(policies) > 0 {
+				return fmt.Errorf("expected no policies after destroy, found %d", len(policies))
+			}
+			return nil
+		},
+	})
+}
+
+// checkFakePolicyName returns the check verifying that the policy with the given ID is named as
+// given in the fake Clumio API.
+func checkFakePolicyName(
+	server *fakeapi.Server, id *string, name string) resource.TestCheckFunc {
+
+	return func(_ *terraform.State) error {
+		policy, ok := server.Object(fakeapi.Policies, *id)
+		if !ok {
+			return fmt.Errorf("policy %s not found in the fake Clumio API", *id)
+		}
+		if policy["name"] != name {
+			return fmt.Errorf("expected policy name %q, found %q", name, policy["name"])
+		}
+		return nil
+	}
+}
+
+// getTestAccFakeResourceClumioPolicy returns the Terraform configuration of a policy with the
+// given name for the acceptance tests which run against the fake Clumio API.
+func getTestAccFakeResourceClumioPolicy(name string) string {
+	return fmt.Sprintf(testAccFakeResourceClumioPolicy, name)
+}
+
+// testAccFakeResourceClumioPolicy is the Terraform configuration of a policy. The provider reads
+// the base URL and token of the fake Clumio API from the environment.
+const testAccFakeResourceClumioPolicy = `
+resource "clumio_policy" "test_policy" {
+	name = "%s"
+	timezone = "UTC"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "protection_group_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "months"
+						value = 3
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 2
+					}
+				},
+			]
+			advanced_settings = {
+				protection_group_backup = {
+					backup_tier = "cold"
+				}
+			}
+		},
+	]
+}
+`
@@ -104,7 +104,7 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr(
-						"clumio_policy.test_policy", "operations.1.backup_window_tz.0.start_time",
+						"clumio_policy.test_policy", "operations.1.backup_window_tz.start_time",
 						regexp.MustCompile("05:00")),
 				),
 			},
@@ -121,10 +121,10 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr(
-						"clumio_policy.test_policy", "operations.1.backup_window_tz.0.start_time",
+						"clumio_policy.test_policy", "operations.1.backup_window_tz.start_time",
 						regexp.MustCompile("01:00")),
 					resource.TestMatchResourceAttr(
-						"clumio_policy.test_policy", "operations.1.backup_window_tz.0.end_time",
+						"clumio_policy.test_policy", "operations.1.backup_window_tz.end_time",
 						regexp.MustCompile("05:00")),
 				),
 			},
@@ -141,10 +141,10 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr(
-						"clumio_policy.test_policy", "operations.1.backup_window_tz.0.start_time",
+						"clumio_policy.test_policy", "operations.1.backup_window_tz.start_time",
 						regexp.MustCompile("03:00")),
 					resource.TestMatchResourceAttr(
-						"clumio_policy.test_policy", "operations.1.backup_window_tz.0.end_time",
+						"clumio_policy.test_policy", "operations.1.backup_window_tz.end_time",
 						regexp.MustCompile("07:00")),
 				),
 			},
@@ -162,7 +162,7 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr(
 						"clumio_policy.secure_vault_lite_success",
-						"operations.0.advanced_settings.0.aws_ebs_volume_backup.0.backup_tier",
+						"operations.0.advanced_settings.aws_ebs_volume_backup.backup_tier",
 						regexp.MustCompile("lite")),
 					resource.TestMatchResourceAttr(
 						"clumio_policy.secure_vault_lite_success", "operations.0.slas.#",
@@ -182,7 +182,7 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr(
 						"clumio_policy.secure_vault_lite_success",
-						"operations.0.advanced_settings.0.aws_ebs_volume_backup.0.backup_tier",
+						"operations.0.advanced_settings.aws_ebs_volume_backup.backup_tier",
 						regexp.MustCompile("lite")),
 					resource.TestMatchResourceAttr(
 						"clumio_policy.secure_vault_lite_success", "operations.0.slas.#",
@@ -201,16 +201,16 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.0.slas.0.rpo_frequency.0.unit",
+						"operations.0.slas.0.rpo_frequency.unit",
 						regexp.MustCompile("hours")),
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.0.slas.0.rpo_frequency.0.value",
+						"operations.0.slas.0.rpo_frequency.value",
 						regexp.MustCompile("4")),
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.1.slas.0.rpo_frequency.0.unit",
+						"operations.1.slas.0.rpo_frequency.unit",
 						regexp.MustCompile("minutes")),
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.1.slas.0.rpo_frequency.0.value",
+						"operations.1.slas.0.rpo_frequency.value",
 						regexp.MustCompile("15")),
 				),
 			},
@@ -226,16 +226,16 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.0.slas.0.rpo_frequency.0.unit",
+						"operations.0.slas.0.rpo_frequency.unit",
 						regexp.MustCompile("hours")),
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.0.slas.0.rpo_frequency.0.value",
+						"operations.0.slas.0.rpo_frequency.value",
 						regexp.MustCompile("12")),
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.1.slas.0.rpo_frequency.0.unit",
+						"operations.1.slas.0.rpo_frequency.unit",
 						regexp.MustCompile("minutes")),
 					resource.TestMatchResourceAttr("clumio_policy.hourly_minutely_policy",
-						"operations.1.slas.0.rpo_frequency.0.value",
+						"operations.1.slas.0.rpo_frequency.value",
 						regexp.MustCompile("30")),
 				),
 			},
@@ -251,10 +251,10 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.weekly_policy",
-						"operations.0.slas.0.rpo_frequency.0.unit",
+						"operations.0.slas.0.rpo_frequency.unit",
 						regexp.MustCompile("weeks")),
 					resource.TestMatchResourceAttr("clumio_policy.weekly_policy",
-						"operations.0.slas.0.rpo_frequency.0.offsets.0",
+						"operations.0.slas.0.rpo_frequency.offsets.0",
 						regexp.MustCompile("1")),
 				),
 			},
@@ -270,10 +270,10 @@ func TestAccResourceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.weekly_policy",
-						"operations.0.slas.0.rpo_frequency.0.unit",
+						"operations.0.slas.0.rpo_frequency.unit",
 						regexp.MustCompile("weeks")),
 					resource.TestMatchResourceAttr("clumio_policy.weekly_policy",
-						"operations.0.slas.0.rpo_frequency.0.offsets.0",
+						"operations.0.slas.0.rpo_frequency.offsets.0",
 						regexp.MustCompile("3")),
 				),
 			},
@@ -336,10 +336,10 @@ func TestRdsComplianceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.test_policy",
-						"operations.0.advanced_settings.0.aws_rds_resource_granular_backup.0.backup_tier",
+						"operations.0.advanced_settings.aws_rds_resource_granular_backup.backup_tier",
 						regexp.MustCompile("frozen")),
 					resource.TestMatchResourceAttr("clumio_policy.test_policy",
-						"operations.0.slas.0.retention_duration.0.value",
+						"operations.0.slas.0.retention_duration.value",
 						regexp.MustCompile("31")),
 				),
 			},
@@ -354,10 +354,10 @@ func TestRdsComplianceClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.test_policy",
-						"operations.0.advanced_settings.0.aws_rds_resource_granular_backup.0.backup_tier",
+						"operations.0.advanced_settings.aws_rds_resource_granular_backup.backup_tier",
 						regexp.MustCompile("frozen")),
 					resource.TestMatchResourceAttr("clumio_policy.test_policy",
-						"operations.0.slas.0.retention_duration.0.value",
+						"operations.0.slas.0.retention_duration.value",
 						regexp.MustCompile("28")),
 				),
 			},
@@ -391,7 +391,7 @@ func TestRdsPitrClumioPolicy(t *testing.T) {
 					resource.TestMatchResourceAttr("clumio_policy.tf_rds_policy",
 						"operations.0.type", regexp.MustCompile("aws_rds_resource_aws_snapshot")),
 					resource.TestMatchResourceAttr("clumio_policy.tf_rds_policy",
-						"operations.0.slas.0.retention_duration.0.value", regexp.MustCompile("7")),
+						"operations.0.slas.0.retention_duration.value", regexp.MustCompile("7")),
 				),
 			},
 			{
@@ -409,7 +409,7 @@ func TestRdsPitrClumioPolicy(t *testing.T) {
 						"operations.0.type",
 						regexp.MustCompile("aws_rds_resource_rolling_backup")),
 					resource.TestMatchResourceAttr("clumio_policy.tf_rds_policy",
-						"operations.0.slas.0.retention_duration.0.value",
+						"operations.0.slas.0.retention_duration.value",
 						regexp.MustCompile("31")),
 				),
 			},
@@ -425,7 +425,7 @@ func TestRdsPitrClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.tf_rds_policy",
-						"operations.0.advanced_settings.0.aws_rds_config_sync.0.apply",
+						"operations.0.advanced_settings.aws_rds_config_sync.apply",
 						regexp.MustCompile("immediate")),
 				),
 			},
@@ -441,7 +441,7 @@ func TestRdsPitrClumioPolicy(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("clumio_policy.tf_rds_policy",
-						"operations.0.advanced_settings.0.aws_rds_config_sync.0.apply",
+						"operations.0.advanced_settings.aws_rds_config_sync.apply",
 						regexp.MustCompile("maintenance_window")),
 				),
 			},
@@ -449,6 +449,54 @@ func TestRdsPitrClumioPolicy(t *testing.T) {
 	})
 }
 
+// Tests the advanced settings of the aws_dynamodb_table_backup and aws_s3_backtrack operations.
+//   - Creates a policy with DynamoDB and S3 backtrack advanced settings and verifies them.
+//   - Updates the advanced settings and verifies that the resource will be updated.
+func TestAccResourceClumioPolicyDynamoDBAndBacktrack(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { clumiopf.UtilTestAccPreCheckClumio(t) },
+		ProtoV6ProviderFactories: clumiopf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccResourceClumioPolicyDynamoDBAndBacktrack(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectResourceAction("clumio_policy.test_policy",
+							plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("clumio_policy.test_policy",
+						"operations.0.advanced_settings.aws_dynamodb_table_backup.backup_tier",
+						"standard"),
+					resource.TestCheckResourceAttr("clumio_policy.test_policy",
+						"operations.1.advanced_settings.aws_s3_backtrack."+
+							"disable_eventbridge_notification", "true"),
+				),
+			},
+			{
+				Config: getTestAccResourceClumioPolicyDynamoDBAndBacktrack(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectNonEmptyPlan(),
+						plancheck.ExpectResourceAction("clumio_policy.test_policy",
+							plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("clumio_policy.test_policy",
+						"operations.0.advanced_settings.aws_dynamodb_table_backup.backup_tier",
+						"warm"),
+					resource.TestCheckResourceAttr("clumio_policy.test_policy",
+						"operations.1.advanced_settings.aws_s3_backtrack."+
+							"disable_eventbridge_notification", "false"),
+				),
+			},
+		},
+	})
+}
+
 // Tests that an external deletion of a clumio_policy resource leads to the resource needing to be
 // re-created during the next plan. NOTE the Check function below as it is utilized to delete
 // the resource using the Clumio API after the plan is applied.
@@ -536,7 +584,7 @@ func TestAccResourceClumioPolicyErrorMissingOperations(t *testing.T) {
 			{
 				Config: fmt.Sprintf(
 					testAccResourceClumioPolicyEmptyOperations, os.Getenv(common.ClumioApiBaseUrl)),
-				ExpectError: regexp.MustCompile("Invalid Block"),
+				ExpectError: regexp.MustCompile("Missing required argument"),
 			},
 		},
 	})
@@ -724,7 +772,7 @@ func getTestAccResourceClumioPolicyWindow(update bool) string {
 	name := "acceptance-test-policy-1234"
 	timezone := "UTC"
 	window := `
-	backup_window_tz {
+	backup_window_tz = {
 		start_time = "01:00"
 		end_time = "05:00"
 	}`
@@ -732,7 +780,7 @@ func getTestAccResourceClumioPolicyWindow(update bool) string {
 		name = "acceptance-test-policy-4321"
 		timezone = "US/Pacific"
 		window = `
-		backup_window_tz {
+		backup_window_tz = {
 			start_time = "03:00"
 			end_time = "07:00"
 		}`
@@ -747,19 +795,19 @@ func getTestAccResourceClumioPolicyFixedStart(update int) string {
 	name := "acceptance-test-policy-1234"
 	timezone := "UTC"
 	window := `
-	backup_window_tz {
+	backup_window_tz = {
 		start_time = "01:00"
 	}`
 	if update == 1 {
 		name = "acceptance-test-policy-4321"
 		timezone = "US/Pacific"
 		window = `
-		backup_window_tz {
+		backup_window_tz = {
 			start_time = "05:00"
 		}`
 	} else if update == 2 {
 		window = `
-		backup_window_tz {
+		backup_window_tz = {
 			start_time = "05:00"
 			end_time = ""
 		}`
@@ -771,20 +819,20 @@ func getTestAccResourceClumioPolicyFixedStart(update int) string {
 // resource.
 func getTestAccResourceClumioPolicySecureVaultLite(update bool) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "SecureVaultLite Test"
+	name := "acceptance-test-secure-vault-lite"
 	sla := ``
 	if update {
 		sla = `
-		slas {
-			retention_duration {
-				unit = "months"
-				value = 3
-			}
-			rpo_frequency {
-				unit = "months"
-				value = 1
-			}
-		}`
+				{
+					retention_duration = {
+						unit = "months"
+						value = 3
+					}
+					rpo_frequency = {
+						unit = "months"
+						value = 1
+					}
+				},`
 	}
 	return fmt.Sprintf(testAccResourceClumioPolicyVaultLite, baseUrl, name, sla)
 }
@@ -793,56 +841,64 @@ func getTestAccResourceClumioPolicySecureVaultLite(update bool) string {
 // containing hourly and minutely SLA.
 func getTestAccResourceClumioPolicyHourlyMinutely(update bool) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "Hourly & Minutely Policy Create"
+	name := "acceptance-test-hourly-minutely-policy-create"
 	hourlySla := `
-	slas {
-		retention_duration {
-			unit = "days"
-			value = 15
-		}
-		rpo_frequency {
-			unit = "hours"
-			value = 4
-		}
-	}
-	`
-	minutelySla := `
-	slas {
-		retention_duration {
-			unit = "days"
-			value = 5
-		}
-		rpo_frequency {
-			unit = "minutes"
-			value = 15
-		}
-	}
-	`
-	if update {
-		name = "Hourly & Minutely Policy Update"
-		hourlySla = `
-		slas {
-			retention_duration {
+	slas = [
+		{
+			retention_duration = {
 				unit = "days"
 				value = 15
 			}
-			rpo_frequency {
+			rpo_frequency = {
 				unit = "hours"
-				value = 12
+				value = 4
 			}
-		}
-		`
-		minutelySla = `
-		slas {
-			retention_duration {
+		},
+	]
+	`
+	minutelySla := `
+	slas = [
+		{
+			retention_duration = {
 				unit = "days"
 				value = 5
 			}
-			rpo_frequency {
+			rpo_frequency = {
 				unit = "minutes"
-				value = 30
+				value = 15
 			}
-		}
+		},
+	]
+	`
+	if update {
+		name = "acceptance-test-hourly-minutely-policy-update"
+		hourlySla = `
+		slas = [
+			{
+				retention_duration = {
+					unit = "days"
+					value = 15
+				}
+				rpo_frequency = {
+					unit = "hours"
+					value = 12
+				}
+			},
+		]
+		`
+		minutelySla = `
+		slas = [
+			{
+				retention_duration = {
+					unit = "days"
+					value = 5
+				}
+				rpo_frequency = {
+					unit = "minutes"
+					value = 30
+				}
+			},
+		]
 		`
 	}
 	return fmt.Sprintf(testAccResourceClumioPolicyHourlyMinutely, baseUrl, name, hourlySla, minutelySla)
@@ -852,34 +908,38 @@ func getTestAccResourceClumioPolicyHourlyMinutely(update bool) string {
 // containing a weekly SLA.
 func getTestAccResourceClumioPolicyWeekly(update bool) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "Weekly Policy Create"
+	name := "acceptance-test-weekly-policy-create"
 	weeklySla := `
-	slas {
-		retention_duration {
-			unit = "weeks"
-			value = 4
-		}
-		rpo_frequency {
-			unit = "weeks"
-			value = 1
-			offsets = [1]
-		}
-	}
-	`
-	if update {
-		name = "Weekly Policy Update"
-		weeklySla = `
-		slas {
-			retention_duration {
+	slas = [
+		{
+			retention_duration = {
 				unit = "weeks"
-				value = 5
+				value = 4
 			}
-			rpo_frequency {
+			rpo_frequency = {
 				unit = "weeks"
 				value = 1
-				offsets = [3]
+				offsets = [1]
 			}
-		}
+		},
+	]
+	`
+	if update {
+		name = "acceptance-test-weekly-policy-update"
+		weeklySla = `
+		slas = [
+			{
+				retention_duration = {
+					unit = "weeks"
+					value = 5
+				}
+				rpo_frequency = {
+					unit = "weeks"
+					value = 1
+					offsets = [3]
+				}
+			},
+		]
 		`
 	}
 	return fmt.Sprintf(testAccResourceClumioPolicyWeekly, baseUrl, name, weeklySla)
@@ -889,15 +949,15 @@ func getTestAccResourceClumioPolicyWeekly(update bool) string {
 // containing a backup region.
 func getTestAccResourceClumioPolicyBackupRegion(scenario int) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "Backup Region Policy Create"
+	name := "acceptance-test-backup-region-policy-create"
 	timezone := "UTC"
 	region := `
 	backup_aws_region = "us-west-2"`
 	if scenario == 1 {
-		name = "Backup Region Policy Update"
+		name = "acceptance-test-backup-region-policy-update"
 		region = `` // valid as the region is optional
 	} else if scenario == 2 {
-		name = "Backup Region Policy Update 2"
+		name = "acceptance-test-backup-region-policy-update-2"
 		region = `
 	backup_aws_region = ""` // invalid as empty region is not allowed as request.
 	}
@@ -909,39 +969,43 @@ func getTestAccResourceClumioPolicyBackupRegion(scenario int) string {
 func getTestAccResourceClumioPolicyRDSCompliance(update bool) string {
 
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "Rds Compliance Policy Create"
+	name := "acceptance-test-rds-compliance-policy-create"
 	slas := `
-	slas {
-		retention_duration {
-			unit  = "days"
-			value = 31
-		}
-		rpo_frequency {
-			unit  = "days"
-			value = 7
-		}
-	}
-	advanced_settings {
-		aws_rds_resource_granular_backup {
-			backup_tier = "frozen"
-		}
-	}
-	`
-	if update {
-		name = "Rds Compliance Policy Update"
-		slas = `
-		slas {
-			retention_duration {
+	slas = [
+		{
+			retention_duration = {
 				unit  = "days"
-				value = 28
+				value = 31
 			}
-			rpo_frequency {
+			rpo_frequency = {
 				unit  = "days"
 				value = 7
 			}
+		},
+	]
+	advanced_settings = {
+		aws_rds_resource_granular_backup = {
+			backup_tier = "frozen"
 		}
-		advanced_settings {
-			aws_rds_resource_granular_backup {
+	}
+	`
+	if update {
+		name = "acceptance-test-rds-compliance-policy-update"
+		slas = `
+		slas = [
+			{
+				retention_duration = {
+					unit  = "days"
+					value = 28
+				}
+				rpo_frequency = {
+					unit  = "days"
+					value = 7
+				}
+			},
+		]
+		advanced_settings = {
+			aws_rds_resource_granular_backup = {
 				backup_tier = "frozen"
 			}
 		}
@@ -954,39 +1018,43 @@ func getTestAccResourceClumioPolicyRDSCompliance(update bool) string {
 // support RDS backup.
 func getTestClumioPolicyRds(pitr bool, airgap bool) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "tf-rds-policy"
+	name := "acceptance-test-rds-policy"
 	operations := ""
 	// TODO: add advanced settings on it.
 	pitrTemplate := `
-	operations {
+	{
 		action_setting = "immediate"
 		type           = "aws_rds_resource_aws_snapshot"
-		slas {
-			retention_duration {
-				unit  = "days"
-				value = 7
-			}
-			rpo_frequency {
-				unit  = "days"
-				value = 1
-			}
-		}
-	}`
+		slas = [
+			{
+				retention_duration = {
+					unit  = "days"
+					value = 7
+				}
+				rpo_frequency = {
+					unit  = "days"
+					value = 1
+				}
+			},
+		]
+	},`
 	airgapTemplate := `
-	operations {
+	{
 		action_setting = "immediate"
 		type           = "aws_rds_resource_rolling_backup"
-		slas {
-			retention_duration {
-				unit  = "days"
-				value = 31
-			}
-			rpo_frequency {
-				unit  = "days"
-				value = 7
-			}
-		}
-	}`
+		slas = [
+			{
+				retention_duration = {
+					unit  = "days"
+					value = 31
+				}
+				rpo_frequency = {
+					unit  = "days"
+					value = 7
+				}
+			},
+		]
+	},`
 
 	if pitr {
 		operations += pitrTemplate
@@ -1003,34 +1071,50 @@ func getTestClumioPolicyRds(pitr bool, airgap bool) string {
 // to support RDS PITR backup with advanced settings.
 func getTestClumioPolicyRdsPitrAdv(immediate bool) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "tf-rds-pitr-adv-policy"
+	name := "acceptance-test-rds-pitr-adv-policy"
 	rdsPitrConfigAdv := "immediate"
 	if !immediate {
 		rdsPitrConfigAdv = "maintenance_window"
 	}
 	operations := fmt.Sprintf(`
-	operations {
+	{
 		action_setting = "immediate"
 		type           = "aws_rds_resource_aws_snapshot"
-		slas {
-			retention_duration {
-				unit  = "days"
-				value = 7
-			}
-			rpo_frequency {
-				unit  = "days"
-				value = 1
-			}
-		}
-		advanced_settings {
-			aws_rds_config_sync {
+		slas = [
+			{
+				retention_duration = {
+					unit  = "days"
+					value = 7
+				}
+				rpo_frequency = {
+					unit  = "days"
+					value = 1
+				}
+			},
+		]
+		advanced_settings = {
+			aws_rds_config_sync = {
 				apply = "%s"
 			}
 		}
-	}`, rdsPitrConfigAdv)
+	},`, rdsPitrConfigAdv)
 	return fmt.Sprintf(testClumioPolicyRdsPolicyTemplate, baseUrl, name, operations)
 }
 
+// getTestAccResourceClumioPolicyDynamoDBAndBacktrack returns the Terraform configuration for a
+// clumio_policy resource containing DynamoDB and S3 backtrack advanced settings.
+func getTestAccResourceClumioPolicyDynamoDBAndBacktrack(update bool) string {
+	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
+	backupTier := "standard"
+	disableEventbridgeNotification := true
+	if update {
+		backupTier = "warm"
+		disableEventbridgeNotification = false
+	}
+	return fmt.Sprintf(testAccResourceClumioPolicyDynamoDBAndBacktrack, baseUrl, backupTier,
+		disableEventbridgeNotification)
+}
+
 // getTestAccResourceClumioPolicyParentAndChildTimezone returns the Terraform configuration for a
 // clumio_policy resource containing a parent-level and child-level timezone and a backup window.
 func getTestAccResourceClumioPolicyParentAndChildTimezone(parentTimezone,
@@ -1066,7 +1150,7 @@ func getTestAccResourceClumioPolicyChildTimezone(timezone1, timezone2 *string) s
 // the given param set to empty string.
 func getTestClumioPolicyEmptyParams(param string) string {
 	baseUrl := os.Getenv(common.ClumioApiBaseUrl)
-	name := "tf-acceptance-test-policy-empty-params"
+	name := "acceptance-test-policy-empty-params"
 	emptyParam := fmt.Sprintf("%s = \"\"", param)
 	return fmt.Sprintf(testAccResourceClumioPolicyEmptyParams, baseUrl, name, emptyParam)
 }
@@ -1133,40 +1217,46 @@ provider clumio{
 resource "clumio_policy" "test_policy" {
 	name = "%s"
 	timezone = "%s"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-		%s
-	}
-	operations {
-		action_setting = "immediate"
-		type = "protection_group_backup"
-		slas {
-			retention_duration {
-				unit = "months"
-				value = 3
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 2
-			}
-		}
-		advanced_settings {
-			protection_group_backup {
-				backup_tier = "cold"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+			%s
+		},
+		{
+			action_setting = "immediate"
+			type = "protection_group_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "months"
+						value = 3
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 2
+					}
+				},
+			]
+			advanced_settings = {
+				protection_group_backup = {
+					backup_tier = "cold"
+				}
 			}
-		}
-	}
+		},
+	]
 }
 `
 
@@ -1177,27 +1267,31 @@ provider clumio{
 	clumio_api_base_url = "%s"
 }
 resource "clumio_policy" "tf_timezone_policy" {
-	name = "test_timezone_policy"
+	name = "acceptance-test-timezone-policy"
 	%s
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+			backup_window_tz = {
+				start_time = "05:00"
+				end_time = ""
 			}
-		}
-		backup_window_tz {
-			start_time = "05:00"
-			end_time = ""
-		}
-		%s
-	}
+			%s
+		},
+	]
 }
 `
 
@@ -1209,45 +1303,51 @@ provider clumio{
 }
 
 resource "clumio_policy" "tf_child_timezone_policy" {
-	name = "test_child_timezone_policy"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-		backup_window_tz {
-			start_time = "05:00"
-			end_time = ""
-		}
-		%s
-	}
-	operations {
-		action_setting = "immediate"
-		type = "aws_ec2_instance_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 10
+	name = "acceptance-test-child-timezone-policy"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+			backup_window_tz = {
+				start_time = "05:00"
+				end_time = ""
 			}
-			rpo_frequency {
-				unit = "days"
-				value = 2
+			%s
+		},
+		{
+			action_setting = "immediate"
+			type = "aws_ec2_instance_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 10
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 2
+					}
+				},
+			]
+			backup_window_tz = {
+				start_time = "05:00"
+				end_time = "10:00"
 			}
-		}
-		backup_window_tz {
-			start_time = "05:00"
-			end_time = "10:00"
-		}
-		%s
-	}
+			%s
+		},
+	]
 }
 `
 
@@ -1260,26 +1360,29 @@ provider clumio{
 
 resource "clumio_policy" "secure_vault_lite_success" {
   name = "%s"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 30
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-		%s
-		advanced_settings {
-			aws_ebs_volume_backup {
-				backup_tier = "lite"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 30
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},%s
+			]
+			advanced_settings = {
+				aws_ebs_volume_backup = {
+					backup_tier = "lite"
+				}
 			}
-		}
-	}
+		},
+	]
 }
 `
 
@@ -1291,28 +1394,30 @@ provider clumio{
 }
 resource "clumio_policy" "hourly_minutely_policy" {
 	name = "%s"
-	operations {
-		action_setting = "immediate"
-		type = "ec2_mssql_database_backup"
-		%s
-		advanced_settings {
-			ec2_mssql_database_backup {
-				alternative_replica = "sync_secondary"
-				preferred_replica = "primary"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "ec2_mssql_database_backup"
+			%s
+			advanced_settings = {
+				ec2_mssql_database_backup = {
+					alternative_replica = "sync_secondary"
+					preferred_replica = "primary"
+				}
 			}
-		}
-	}	
-	operations {
-		action_setting = "immediate"
-		type = "ec2_mssql_log_backup"
-		%s
-		advanced_settings {
-			ec2_mssql_log_backup {
-				alternative_replica = "sync_secondary"
-				preferred_replica = "primary"
+		},
+		{
+			action_setting = "immediate"
+			type = "ec2_mssql_log_backup"
+			%s
+			advanced_settings = {
+				ec2_mssql_log_backup = {
+					alternative_replica = "sync_secondary"
+					preferred_replica = "primary"
+				}
 			}
-		}
-	}
+		},
+	]
 }
 `
 
@@ -1324,11 +1429,13 @@ provider clumio{
 }
 resource "clumio_policy" "weekly_policy" {
 	name = "%s"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		%s
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			%s
+		},
+	]
 }
 `
 
@@ -1340,7 +1447,64 @@ provider clumio{
 }
 resource "clumio_policy" "tf_rds_policy" {
 	name = "%s"
-	%s
+	operations = [%s
+	]
+}
+`
+
+// testAccResourceClumioPolicyDynamoDBAndBacktrack is the Terraform configuration for a
+// clumio_policy resource with DynamoDB and S3 backtrack advanced settings.
+const testAccResourceClumioPolicyDynamoDBAndBacktrack = `
+provider clumio{
+	clumio_api_base_url = "%s"
+}
+
+resource "clumio_policy" "test_policy" {
+	name = "acceptance-test-dynamodb-backtrack"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_dynamodb_table_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 7
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+			advanced_settings = {
+				aws_dynamodb_table_backup = {
+					backup_tier = "%s"
+				}
+			}
+		},
+		{
+			action_setting = "immediate"
+			type = "aws_s3_backtrack"
+			slas = [
+				{
+					retention_duration = {
+						unit = "months"
+						value = 3
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+			advanced_settings = {
+				aws_s3_backtrack = {
+					disable_eventbridge_notification = %t
+				}
+			}
+		},
+	]
 }
 `
 
@@ -1353,11 +1517,13 @@ provider clumio{
 
 resource "clumio_policy" "test_policy" {
 	name = "%s"
-	operations {
-		action_setting = "immediate"
-		type           = "aws_rds_resource_granular_backup"
-		%s
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type           = "aws_rds_resource_granular_backup"
+			%s
+		},
+	]
 }
 `
 
@@ -1371,20 +1537,24 @@ provider clumio{
 resource "clumio_policy" "test_policy" {
 	name = "acceptance-test-import"
 	timezone = "UTC"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+	]
 }
 `
 
@@ -1411,21 +1581,25 @@ provider clumio{
 resource "clumio_policy" "backup_region_policy" {
 	name = "%s"
 	timezone = "%s"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-		%s
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+			%s
+		},
+	]
 }
 `
 
@@ -1439,19 +1613,23 @@ provider clumio{
 resource "clumio_policy" "backup_region_policy" {
 	name = "%s"
 	%s
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+	]
 }
 `
@@ -17,6 +17,7 @@ import (
 	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
@@ -73,18 +74,14 @@ func TestCreatePolicy(t *testing.T) {
 				OperationType: basetypes.NewStringValue(operationType),
 				Slas: []*slaModel{
 					{
-						RetentionDuration: []*unitValueModel{
-							{
-								Unit:  basetypes.NewStringValue(retUnit),
-								Value: basetypes.NewInt64Value(retValue),
-							},
+						RetentionDuration: &unitValueModel{
+							Unit:  basetypes.NewStringValue(retUnit),
+							Value: basetypes.NewInt64Value(retValue),
 						},
-						RPOFrequency: []*rpoModel{
-							{
-								Unit:    basetypes.NewStringValue(rpoUnit),
-								Value:   basetypes.NewInt64Value(rpoValue),
-								Offsets: basetypes.NewListNull(types.Int64Type),
-							},
+						RPOFrequency: &rpoModel{
+							Unit:    basetypes.NewStringValue(rpoUnit),
+							Value:   basetypes.NewInt64Value(rpoValue),
+							Offsets: basetypes.NewListNull(types.Int64Type),
 						},
 					},
 				},
@@ -391,18 +388,14 @@ func TestUpdatePolicy(t *testing.T) {
 				OperationType: basetypes.NewStringValue(operationType),
 				Slas: []*slaModel{
 					{
-						RetentionDuration: []*unitValueModel{
-							{
-								Unit:  basetypes.NewStringValue(retUnit),
-								Value: basetypes.NewInt64Value(retValue),
-							},
+						RetentionDuration: &unitValueModel{
+							Unit:  basetypes.NewStringValue(retUnit),
+							Value: basetypes.NewInt64Value(retValue),
 						},
-						RPOFrequency: []*rpoModel{
-							{
-								Unit:    basetypes.NewStringValue(rpoUnit),
-								Value:   basetypes.NewInt64Value(rpoValue),
-								Offsets: basetypes.NewListNull(types.Int64Type),
-							},
+						RPOFrequency: &rpoModel{
+							Unit:    basetypes.NewStringValue(rpoUnit),
+							Value:   basetypes.NewInt64Value(rpoValue),
+							Offsets: basetypes.NewListNull(types.Int64Type),
 						},
 					},
 				},
@@ -544,6 +537,7 @@ func TestUpdatePolicy(t *testing.T) {
 //   - SDK API for delete policy returns error.
 //   - SDK API for delete policy returns nil response.
 //   - Polling of delete policy task returns error.
+//   - Deletion protection prevents the deletion of the policy.
 func TestDeletePolicy(t *testing.T) {
 
 	mockPolicy := sdkclients.NewMockPolicyDefinitionClient(t)
@@ -626,4 +620,41 @@ func TestDeletePolicy(t *testing.T) {
 		diags := pr.deletePolicy(context.Background(), prm)
 		assert.NotNil(t, diags)
 	})
+
+	// Tests that Diagnostics is returned without calling the API when deletion protection is
+	// enabled by the resource or by the provider.
+	t.Run("Deletion protection prevents the deletion", func(t *testing.T) {
+		protected := *prm
+		protected.DeletionProtection = basetypes.NewBoolValue(true)
+		diags := pr.deletePolicy(context.Background(), &protected)
+		assert.True(t, diags.HasError())
+
+		providerProtected := pr
+		providerProtected.client = &common.ApiClient{DeletionProtection: true}
+		diags = providerProtected.deletePolicy(context.Background(), prm)
+		assert.True(t, diags.HasError())
+	})
+}
+
+// Unit test for apiFieldPaths for the following cases:
+//   - Field errors for the fields of the policy definition requests are reported on the attributes
+//     they are set from.
+//   - Field errors for the fields of the operations and their SLAs are reported on the operation and
+//     SLA of the field.
+//   - Field errors for unknown fields are reported without an attribute.
+func TestApiFieldPaths(t *testing.T) {
+
+	common.RunApiFieldPathsTests(t, apiFieldPaths, map[string]path.Path{
+		"name":               path.Root(schemaName),
+		"operations[0].type": path.Root(schemaOperations).AtListIndex(0),
+		"operations[0].slas[1].retention_duration.value": path.Root(schemaOperations).
+			AtListIndex(0).AtName(schemaSlas).AtListIndex(1).AtName(schemaRetentionDuration),
+		"operations[1].slas[0].rpo_frequency.unit": path.Root(schemaOperations).
+			AtListIndex(1).AtName(schemaSlas).AtListIndex(0).AtName(schemaRpoFrequency),
+		"operations[1].backup_window_tz.start_time": path.Root(schemaOperations).
+			AtListIndex(1).AtName(schemaBackupWindowTz),
+		"operations[2].advanced_settings.protection_group_backup.backup_tier": path.Root(
+			schemaOperations).AtListIndex(2).AtName(schemaAdvancedSettings),
+		"unknown_field": path.Empty(),
+	})
 }
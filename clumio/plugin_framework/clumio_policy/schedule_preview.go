@@ -0,0 +1,458 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the logic of the clumio_policy_schedule_preview Terraform datasource to read the
+// operations of a policy and to project when the backups of their SLAs run and expire. The
+// projection is computed entirely by the provider and follows these rules:
+//   - Backups of sub-daily RPOs are scheduled every RPO interval from midnight, within the backup
+//     window if any.
+//   - Backups of daily and longer RPOs are scheduled at the start of the backup window, or
+//     midnight, every RPO interval from the first day of the preview. Weekly RPOs with offsets are
+//     scheduled on the given days of the week instead. Monthly and yearly RPOs use the day of the
+//     month of the first day of the preview, or the last day of shorter months.
+//   - A backup can start from its scheduled start until the end of the backup window. Backups
+//     start at the start of backup windows without end time, and until the next scheduled backup
+//     without backup window.
+//   - A backup window whose end time is before its start time spans midnight, such as 22:00 to
+//     04:00, and so ends on the day after it starts.
+//   - A backup expires its retention duration after the start of its window at the earliest.
+
+package clumio_policy
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// defaultPreviewTimezone is the time zone of the previewed operations if neither the operation,
+	// the data source nor the policy sets one.
+	defaultPreviewTimezone = "UTC"
+)
+
+// now returns the current time. It is a variable so that the unit tests can replace it.
+var now = time.Now
+
+// backupSchedule is the schedule of the backups of one SLA of a policy operation.
+type backupSchedule struct {
+	rpoUnit  string
+	rpoValue int
+	// offsets are the days of the week of the backups of weekly RPOs, where 0 is Sunday.
+	offsets        []int
+	retentionUnit  string
+	retentionValue int
+	// windowStart and windowEnd are the minutes after midnight at which the backup window opens
+	// and closes. hasWindowEnd is false if the backup window has no end time. windowEnd is before
+	// windowStart if the backup window spans midnight.
+	windowStart  int
+	windowEnd    int
+	hasWindow    bool
+	hasWindowEnd bool
+	location     *time.Location
+}
+
+// projectedBackup is a backup projected by a backupSchedule.
+type projectedBackup struct {
+	windowStart time.Time
+	windowEnd   time.Time
+	expiresAt   time.Time
+}
+
+// readPolicyOperations invokes the API to read the policy with the policy_id of the given model and
+// sets the operations and, if not set, the time zone of the model from the policy.
+func (r *policySchedulePreviewDataSource) readPolicyOperations(
+	ctx context.Context, model *policySchedulePreviewDataSourceModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	policyId := model.PolicyId.ValueString()
+
+	// Call the Clumio API to read the policy definition.
+	res, apiErr := r.policyDefinitionClient.ReadPolicyDefinition(policyId, nil)
+	if apiErr != nil {
+		summary := fmt.Sprintf(errorPolicyReadMsg, r.name, policyId)
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return diags
+	}
+	if res == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return diags
+	}
+
+	operations, conversionDiags := mapClumioOperationsToSchemaOperations(ctx, res.Operations)
+	diags.Append(conversionDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	model.Operations = make([]*schedulePreviewOperationModel, 0, len(operations))
+	for _, operation := range operations {
+		model.Operations = append(model.Operations, &schedulePreviewOperationModel{
+			OperationType:   operation.OperationType,
+			BackupAwsRegion: operation.BackupAwsRegion,
+			Timezone:        operation.Timezone,
+			BackupWindowTz:  operation.BackupWindowTz,
+			Slas:            operation.Slas,
+		})
+	}
+	if model.Timezone.IsNull() && types.StringPointerValue(res.Timezone).ValueString() != "" {
+		model.Timezone = types.StringPointerValue(res.Timezone)
+	}
+	return diags
+}
+
+// previewSchedules sets the defaults of the unset attributes of the given model and projects the
+// backups of every SLA of its operations into its schedules.
+func previewSchedules(model *policySchedulePreviewDataSourceModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	if model.Timezone.IsNull() {
+		model.Timezone = types.StringValue(defaultPreviewTimezone)
+	}
+	if model.StartTime.IsNull() {
+		model.StartTime = types.StringValue(
+			now().UTC().Truncate(time.Minute).Format(time.RFC3339))
+	}
+	if model.HorizonDays.IsNull() {
+		model.HorizonDays = types.Int64Value(defaultHorizonDays)
+	}
+
+	from, err := time.Parse(time.RFC3339, model.StartTime.ValueString())
+	if err != nil {
+		summary := "Invalid start time"
+		detail := fmt.Sprintf("Start time %q is not in RFC 3339 format, such as"+
+			" 2025-01-01T00:00:00Z.", model.StartTime.ValueString())
+		diags.AddAttributeError(path.Root(schemaStartTime), summary, detail)
+	}
+	diags.Append(validateTimezone(path.Root(schemaTimezone), model.Timezone)...)
+	if diags.HasError() {
+		return diags
+	}
+	until := from.AddDate(0, 0, int(model.HorizonDays.ValueInt64()))
+
+	model.Schedules = make([]*schedulePreviewScheduleModel, 0)
+	for i, operation := range model.Operations {
+		if operation == nil {
+			continue
+		}
+		operationPath := path.Root(schemaOperations).AtListIndex(i)
+		// The time zone of the data source is valid, so only the one of the operation is validated.
+		timezone := model.Timezone.ValueString()
+		if operation.Timezone.ValueString() != "" {
+			timezone = operation.Timezone.ValueString()
+		}
+		timezoneDiags := validateTimezone(
+			operationPath.AtName(schemaTimezone), types.StringValue(timezone))
+		diags.Append(timezoneDiags...)
+		if timezoneDiags.HasError() {
+			continue
+		}
+		location, _ := time.LoadLocation(timezone)
+
+		for index, sla := range operation.Slas {
+			if sla == nil {
+				continue
+			}
+			schedule, err := newBackupSchedule(sla, operation.BackupWindowTz, location)
+			if err != nil {
+				summary := "Invalid policy operation"
+				detail := fmt.Sprintf("SLA %d of the %q operation cannot be previewed: %v",
+					index, operation.OperationType.ValueString(), err)
+				diags.AddAttributeError(
+					operationPath.AtName(schemaSlas).AtListIndex(index), summary, detail)
+				continue
+			}
+
+			backups, truncated := schedule.project(from, until)
+			if truncated {
+				summary := "Backup preview truncated"
+				detail := fmt.Sprintf("Only the first %d backups of SLA %d of the %q operation"+
+					" are previewed. Reduce horizon_days to preview all of them.",
+					maxPreviewBackups, index, operation.OperationType.ValueString())
+				diags.AddWarning(summary, detail)
+			}
+			model.Schedules = append(model.Schedules, &schedulePreviewScheduleModel{
+				OperationType:      operation.OperationType,
+				BackupAwsRegion:    operation.BackupAwsRegion,
+				SlaIndex:           types.Int64Value(int64(index)),
+				Timezone:           types.StringValue(timezone),
+				MaxIntervalMinutes: types.Int64Value(maxIntervalMinutes(backups)),
+				Backups:            mapProjectedBackupsToSchemaBackups(backups),
+			})
+		}
+	}
+	return diags
+}
+
+// newBackupSchedule returns the schedule of the backups of the given SLA with the given backup
+// window, if any, in the given location. It returns an error if the SLA cannot be previewed.
+func newBackupSchedule(sla *slaModel, window *backupWindowModel, location *time.Location) (
+	*backupSchedule, error) {
+
+	if sla.RetentionDuration == nil || sla.RPOFrequency == nil {
+		return nil, fmt.Errorf("both %s and %s must be set", schemaRetentionDuration,
+			schemaRpoFrequency)
+	}
+	retention := sla.RetentionDuration
+	rpo := sla.RPOFrequency
+	schedule := &backupSchedule{
+		rpoUnit:        rpo.Unit.ValueString(),
+		rpoValue:       int(rpo.Value.ValueInt64()),
+		retentionUnit:  retention.Unit.ValueString(),
+		retentionValue: int(retention.Value.ValueInt64()),
+		location:       location,
+	}
+
+	if _, ok := retentionUnits[schedule.retentionUnit]; !ok {
+		return nil, fmt.Errorf("retention duration unit %q is invalid, valid units are: %s",
+			schedule.retentionUnit, joinKeys(retentionUnits))
+	}
+	if schedule.retentionValue < 1 {
+		return nil, fmt.Errorf("retention duration value must be at least 1")
+	}
+	if _, ok := rpoUnits[schedule.rpoUnit]; !ok {
+		return nil, fmt.Errorf("RPO frequency unit %q is invalid, valid units are: %s",
+			schedule.rpoUnit, joinKeys(rpoUnits))
+	}
+	if schedule.rpoUnit != rpoOnDemand && schedule.rpoValue < 1 {
+		return nil, fmt.Errorf("RPO frequency value must be at least 1")
+	}
+	if schedule.rpoUnit == "weeks" && !rpo.Offsets.IsNull() && !rpo.Offsets.IsUnknown() {
+		for _, element := range rpo.Offsets.Elements() {
+			offset, ok := element.(types.Int64)
+			if !ok || offset.IsNull() || offset.IsUnknown() ||
+				offset.ValueInt64() < 0 || offset.ValueInt64() > 6 {
+				return nil, fmt.Errorf("offsets of weekly RPOs must be days of the week" +
+					" from 0 (Sunday) to 6 (Saturday)")
+			}
+			schedule.offsets = append(schedule.offsets, int(offset.ValueInt64()))
+		}
+		slices.Sort(schedule.offsets)
+		schedule.offsets = slices.Compact(schedule.offsets)
+	}
+
+	if window != nil && window.StartTime.ValueString() != "" {
+		var err error
+		schedule.hasWindow = true
+		schedule.windowStart, err = parseBackupWindowTime(window.StartTime.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if window.EndTime.ValueString() != "" {
+			schedule.hasWindowEnd = true
+			schedule.windowEnd, err = parseBackupWindowTime(window.EndTime.ValueString())
+			if err != nil {
+				return nil, err
+			}
+			if schedule.windowEnd == schedule.windowStart {
+				return nil, fmt.Errorf("the start time of the backup window must differ from"+
+					" its end time %q", window.EndTime.ValueString())
+			}
+		}
+	}
+	return schedule, nil
+}
+
+// parseBackupWindowTime returns the minutes after midnight of the given hh:mm backup window time.
+func parseBackupWindowTime(value string) (int, error) {
+
+	if !backupWindowTimeRegexp.MatchString(value) {
+		return 0, fmt.Errorf("backup window time %q is not in the hh:mm format of the 24 hour"+
+			" clock", value)
+	}
+	parsed, _ := time.Parse("15:04", value)
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+// project returns the backups of the schedule whose window starts in [from, until). If there are
+// more than maxPreviewBackups of them, only the first ones are returned and truncated is true.
+func (s *backupSchedule) project(from, until time.Time) (
+	backups []projectedBackup, truncated bool) {
+
+	if s.rpoUnit == rpoOnDemand {
+		return []projectedBackup{}, false
+	}
+	from = from.In(s.location)
+	anchor := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, s.location)
+
+	// Collect the scheduled starts up to the first one of the period starting at or after until,
+	// which is needed to end the window of the last backup.
+	var starts []time.Time
+	for period := 0; len(starts) <= maxPreviewBackups; period++ {
+		periodStart, periodStarts := s.periodStarts(anchor, period)
+		for _, start := range periodStarts {
+			if !start.Before(from) {
+				starts = append(starts, start)
+			}
+		}
+		if !periodStart.Before(until) {
+			break
+		}
+	}
+
+	backups = make([]projectedBackup, 0, len(starts))
+	for i, start := range starts {
+		if !start.Before(until) {
+			break
+		}
+		if len(backups) == maxPreviewBackups {
+			truncated = true
+			break
+		}
+		end := s.nominalEnd(start)
+		if i+1 < len(starts) && starts[i+1].Before(end) {
+			end = starts[i+1]
+		}
+		if s.hasWindow && !s.hasWindowEnd {
+			end = start
+		}
+		if s.hasWindowEnd {
+			// The window of a backup starting before midnight in a window which spans midnight
+			// ends on the next day.
+			windowEnd := time.Date(start.Year(), start.Month(), start.Day(),
+				s.windowEnd/60, s.windowEnd%60, 0, 0, s.location)
+			if !windowEnd.After(start) {
+				windowEnd = windowEnd.AddDate(0, 0, 1)
+			}
+			if windowEnd.Before(end) {
+				end = windowEnd
+			}
+		}
+		backups = append(backups, projectedBackup{
+			windowStart: start,
+			windowEnd:   end,
+			expiresAt:   addUnits(start, s.retentionUnit, s.retentionValue),
+		})
+	}
+	return backups, truncated
+}
+
+// periodStarts returns the start of the given period of the schedule, counted from the given
+// midnight, and the scheduled starts of the backups in the period in chronological order.
+func (s *backupSchedule) periodStarts(anchor time.Time, period int) (time.Time, []time.Time) {
+
+	switch s.rpoUnit {
+	case "minutes", "hours":
+		step := time.Minute
+		if s.rpoUnit == "hours" {
+			step = time.Hour
+		}
+		start := anchor.Add(time.Duration(period*s.rpoValue) * step)
+		if !s.inWindow(start) {
+			return start, nil
+		}
+		return start, []time.Time{start}
+	case "weeks":
+		if len(s.offsets) == 0 {
+			start := anchor.AddDate(0, 0, 7*period*s.rpoValue)
+			return start, []time.Time{s.atWindowStart(start)}
+		}
+		// Periods of weekly RPOs with offsets start on the Sunday of their first week.
+		weekStart := anchor.AddDate(0, 0, 7*period*s.rpoValue-int(anchor.Weekday()))
+		starts := make([]time.Time, 0, len(s.offsets))
+		for _, offset := range s.offsets {
+			starts = append(starts, s.atWindowStart(weekStart.AddDate(0, 0, offset)))
+		}
+		return weekStart, starts
+	default:
+		start := addUnits(anchor, s.rpoUnit, period*s.rpoValue)
+		return start, []time.Time{s.atWindowStart(start)}
+	}
+}
+
+// nominalEnd returns the time at which the next backup is due after a backup starting at the given
+// time, ignoring the backup window and the offsets.
+func (s *backupSchedule) nominalEnd(start time.Time) time.Time {
+
+	switch s.rpoUnit {
+	case "minutes":
+		return start.Add(time.Duration(s.rpoValue) * time.Minute)
+	case "hours":
+		return start.Add(time.Duration(s.rpoValue) * time.Hour)
+	default:
+		return addUnits(start, s.rpoUnit, s.rpoValue)
+	}
+}
+
+// inWindow returns whether the given time is within the backup window of the schedule. Every time
+// is within the window if the schedule has none.
+func (s *backupSchedule) inWindow(t time.Time) bool {
+
+	if !s.hasWindow {
+		return true
+	}
+	minutes := t.Hour()*60 + t.Minute()
+	if s.hasWindowEnd && s.windowEnd < s.windowStart {
+		// The window spans midnight.
+		return minutes >= s.windowStart || minutes < s.windowEnd
+	}
+	if minutes < s.windowStart {
+		return false
+	}
+	return !s.hasWindowEnd || minutes < s.windowEnd
+}
+
+// atWindowStart returns the time at which the backup window of the schedule opens on the day of
+// the given time.
+func (s *backupSchedule) atWindowStart(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), s.windowStart/60, s.windowStart%60, 0, 0,
+		s.location)
+}
+
+// addUnits returns the given time plus the given number of days, weeks, months or years. Adding
+// months keeps the day of the month, or uses the last day of shorter months.
+func addUnits(t time.Time, unit string, value int) time.Time {
+
+	switch unit {
+	case "days":
+		return t.AddDate(0, 0, value)
+	case "weeks":
+		return t.AddDate(0, 0, 7*value)
+	case "months", "years":
+		months := value
+		if unit == "years" {
+			months = 12 * value
+		}
+		// The first day of the target month does not overflow into the next month.
+		first := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(),
+			t.Second(), t.Nanosecond(), t.Location())
+		lastDay := first.AddDate(0, 1, -1).Day()
+		return first.AddDate(0, 0, min(t.Day(), lastDay)-1)
+	default:
+		return t
+	}
+}
+
+// maxIntervalMinutes returns the longest time in minutes between the start of the window of a
+// backup and the end of the window of the next one, or 0 if there are fewer than two backups.
+func maxIntervalMinutes(backups []projectedBackup) int64 {
+
+	var interval time.Duration
+	for i := 1; i < len(backups); i++ {
+		interval = max(interval, backups[i].windowEnd.Sub(backups[i-1].windowStart))
+	}
+	return int64(interval / time.Minute)
+}
+
+// mapProjectedBackupsToSchemaBackups maps the given projected backups to the schema format.
+func mapProjectedBackupsToSchemaBackups(
+	backups []projectedBackup) []*schedulePreviewBackupModel {
+
+	schemaBackups := make([]*schedulePreviewBackupModel, 0, len(backups))
+	for _, backup := range backups {
+		schemaBackups = append(schemaBackups, &schedulePreviewBackupModel{
+			WindowStart: types.StringValue(backup.windowStart.Format(time.RFC3339)),
+			WindowEnd:   types.StringValue(backup.windowEnd.Format(time.RFC3339)),
+			ExpiresAt:   types.StringValue(backup.expiresAt.Format(time.RFC3339)),
+		})
+	}
+	return schemaBackups
+}
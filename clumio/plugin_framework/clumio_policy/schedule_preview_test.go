@@ -0,0 +1,426 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in schedule_preview.go
+
+//go:build unit
+
+package clumio_policy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testPreviewSla returns an SLA to be previewed with the given retention and RPO.
+func testPreviewSla(retentionUnit string, retentionValue int64, rpoUnit string, rpoValue int64,
+	offsets ...int64) *slaModel {
+
+	offsetsValue := types.ListNull(types.Int64Type)
+	if len(offsets) > 0 {
+		elements := make([]attr.Value, 0, len(offsets))
+		for _, offset := range offsets {
+			elements = append(elements, types.Int64Value(offset))
+		}
+		offsetsValue = types.ListValueMust(types.Int64Type, elements)
+	}
+	return &slaModel{
+		RetentionDuration: &unitValueModel{
+			Unit:  types.StringValue(retentionUnit),
+			Value: types.Int64Value(retentionValue),
+		},
+		RPOFrequency: &rpoModel{
+			Unit:    types.StringValue(rpoUnit),
+			Value:   types.Int64Value(rpoValue),
+			Offsets: offsetsValue,
+		},
+	}
+}
+
+// testBackupWindow returns the backup window with the given start and end times.
+func testBackupWindow(startTime string, endTime string) *backupWindowModel {
+	return &backupWindowModel{
+		StartTime: types.StringValue(startTime),
+		EndTime:   types.StringValue(endTime),
+	}
+}
+
+// projectTestSchedule returns the backups projected for the given SLA and backup window in the
+// given time zone from 2025-01-01T00:00:00Z for the given number of days.
+func projectTestSchedule(t *testing.T, sla *slaModel, window *backupWindowModel,
+	timezone string, days int) ([]projectedBackup, bool) {
+
+	location, err := time.LoadLocation(timezone)
+	assert.Nil(t, err)
+	schedule, err := newBackupSchedule(sla, window, location)
+	assert.Nil(t, err)
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return schedule.project(from, from.AddDate(0, 0, days))
+}
+
+// Unit test for the following cases:
+//   - Daily RPO with a backup window.
+//   - Hourly RPO restricted to the backup window.
+//   - Daily RPO with a backup window spanning midnight.
+//   - Hourly RPO restricted to a backup window spanning midnight.
+//   - Weekly RPO with offsets.
+//   - Monthly RPO and retention on days missing in shorter months.
+//   - Daily RPO in a time zone other than UTC.
+//   - On demand RPO does not schedule backups.
+//   - Backups beyond the maximum number of previewed backups are truncated.
+func TestBackupScheduleProject(t *testing.T) {
+
+	// Tests that daily backups run in the backup window and expire after their retention.
+	t.Run("Daily RPO with backup window", func(t *testing.T) {
+		backups, truncated := projectTestSchedule(t, testPreviewSla("days", 7, "days", 1),
+			testBackupWindow("01:00", "05:00"), "UTC", 3)
+		assert.False(t, truncated)
+		assert.Len(t, backups, 3)
+		assert.Equal(t, time.Date(2025, time.January, 1, 1, 0, 0, 0, time.UTC),
+			backups[0].windowStart)
+		assert.Equal(t, time.Date(2025, time.January, 1, 5, 0, 0, 0, time.UTC),
+			backups[0].windowEnd)
+		assert.Equal(t, time.Date(2025, time.January, 8, 1, 0, 0, 0, time.UTC),
+			backups[0].expiresAt)
+		assert.Equal(t, time.Date(2025, time.January, 3, 1, 0, 0, 0, time.UTC),
+			backups[2].windowStart)
+		// The next backup can start 28 hours after the start of the previous window at most.
+		assert.Equal(t, int64(28*60), maxIntervalMinutes(backups))
+	})
+
+	// Tests that sub-daily backups only run within the backup window.
+	t.Run("Hourly RPO with backup window", func(t *testing.T) {
+		backups, _ := projectTestSchedule(t, testPreviewSla("days", 1, "hours", 4),
+			testBackupWindow("06:00", "14:00"), "UTC", 2)
+		assert.Len(t, backups, 4)
+		for _, backup := range backups {
+			assert.Contains(t, []int{8, 12}, backup.windowStart.Hour())
+		}
+		assert.Equal(t, time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC),
+			backups[0].windowEnd)
+		assert.Equal(t, time.Date(2025, time.January, 1, 14, 0, 0, 0, time.UTC),
+			backups[1].windowEnd)
+	})
+
+	// Tests that the window of daily backups in a backup window spanning midnight ends on the
+	// next day.
+	t.Run("Daily RPO with backup window spanning midnight", func(t *testing.T) {
+		backups, _ := projectTestSchedule(t, testPreviewSla("days", 7, "days", 1),
+			testBackupWindow("22:00", "04:00"), "UTC", 2)
+		assert.Len(t, backups, 2)
+		assert.Equal(t, time.Date(2025, time.January, 1, 22, 0, 0, 0, time.UTC),
+			backups[0].windowStart)
+		assert.Equal(t, time.Date(2025, time.January, 2, 4, 0, 0, 0, time.UTC),
+			backups[0].windowEnd)
+		assert.Equal(t, time.Date(2025, time.January, 3, 4, 0, 0, 0, time.UTC),
+			backups[1].windowEnd)
+	})
+
+	// Tests that sub-daily backups run on both sides of midnight within a backup window spanning
+	// midnight.
+	t.Run("Hourly RPO with backup window spanning midnight", func(t *testing.T) {
+		backups, _ := projectTestSchedule(t, testPreviewSla("days", 1, "hours", 2),
+			testBackupWindow("22:00", "04:00"), "UTC", 1)
+		assert.Len(t, backups, 3)
+		for i, hour := range []int{0, 2, 22} {
+			assert.Equal(t, hour, backups[i].windowStart.Hour())
+		}
+		assert.Equal(t, time.Date(2025, time.January, 1, 4, 0, 0, 0, time.UTC),
+			backups[1].windowEnd)
+		assert.Equal(t, time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC),
+			backups[2].windowEnd)
+	})
+
+	// Tests that weekly backups with offsets run on the given days of the week. 2025-01-01 is a
+	// Wednesday.
+	t.Run("Weekly RPO with offsets", func(t *testing.T) {
+		backups, _ := projectTestSchedule(t, testPreviewSla("weeks", 4, "weeks", 1, 1, 5),
+			nil, "UTC", 14)
+		var weekdays []time.Weekday
+		for _, backup := range backups {
+			weekdays = append(weekdays, backup.windowStart.Weekday())
+		}
+		assert.Equal(t, []time.Weekday{time.Friday, time.Monday, time.Friday, time.Monday},
+			weekdays)
+		assert.Equal(t, time.Date(2025, time.January, 6, 0, 0, 0, 0, time.UTC),
+			backups[0].windowEnd)
+	})
+
+	// Tests that monthly backups use the last day of shorter months.
+	t.Run("Monthly RPO on the last day of the month", func(t *testing.T) {
+		location := time.UTC
+		schedule, err := newBackupSchedule(testPreviewSla("months", 1, "months", 1), nil,
+			location)
+		assert.Nil(t, err)
+		from := time.Date(2025, time.January, 31, 0, 0, 0, 0, location)
+		backups, _ := schedule.project(from, from.AddDate(0, 0, 45))
+		assert.Len(t, backups, 2)
+		assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, location),
+			backups[1].windowStart)
+		assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, location),
+			backups[0].expiresAt)
+	})
+
+	// Tests that backups are scheduled in the time zone of the schedule.
+	t.Run("Daily RPO in another time zone", func(t *testing.T) {
+		backups, _ := projectTestSchedule(t, testPreviewSla("days", 7, "days", 1),
+			testBackupWindow("22:00", ""), "America/Los_Angeles", 2)
+		assert.Len(t, backups, 2)
+		assert.Equal(t, time.Date(2025, time.January, 1, 6, 0, 0, 0, time.UTC),
+			backups[0].windowStart.UTC())
+		// Backups of windows without end time start at the start of the window.
+		assert.Equal(t, backups[0].windowStart, backups[0].windowEnd)
+		assert.Equal(t, int64(24*60), maxIntervalMinutes(backups))
+	})
+
+	// Tests that on demand SLAs do not schedule any backup.
+	t.Run("On demand RPO", func(t *testing.T) {
+		sla := testPreviewSla("days", 7, rpoOnDemand, 0)
+		sla.RPOFrequency.Value = types.Int64Null()
+		backups, truncated := projectTestSchedule(t, sla, nil, "UTC", 30)
+		assert.False(t, truncated)
+		assert.Empty(t, backups)
+		assert.Equal(t, int64(0), maxIntervalMinutes(backups))
+	})
+
+	// Tests that no more than maxPreviewBackups backups are previewed.
+	t.Run("Truncated backups", func(t *testing.T) {
+		backups, truncated := projectTestSchedule(t, testPreviewSla("days", 1, "minutes", 1),
+			nil, "UTC", 30)
+		assert.True(t, truncated)
+		assert.Len(t, backups, maxPreviewBackups)
+	})
+}
+
+// Unit test for the following cases:
+//   - Unset attributes are set to their defaults.
+//   - Operation time zone overrides the time zone of the data source.
+//   - Invalid start time returns an error.
+//   - Invalid time zone returns an error on the time zone of the operation.
+//   - Invalid SLA returns an error on the SLA.
+func TestPreviewSchedules(t *testing.T) {
+
+	now = func() time.Time {
+		return time.Date(2025, time.January, 1, 10, 30, 15, 0, time.UTC)
+	}
+	defer func() { now = time.Now }()
+
+	// Tests that the start time, time zone and horizon default and that one schedule is previewed
+	// per SLA.
+	t.Run("Defaults", func(t *testing.T) {
+		model := &policySchedulePreviewDataSourceModel{
+			Operations: []*schedulePreviewOperationModel{
+				{
+					OperationType: types.StringValue("aws_ebs_volume_backup"),
+					Slas: []*slaModel{
+						testPreviewSla("days", 7, "days", 1),
+						testPreviewSla("months", 1, "weeks", 1),
+					},
+				},
+			},
+		}
+		diags := previewSchedules(model)
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, "2025-01-01T10:30:00Z", model.StartTime.ValueString())
+		assert.Equal(t, defaultPreviewTimezone, model.Timezone.ValueString())
+		assert.Equal(t, int64(defaultHorizonDays), model.HorizonDays.ValueInt64())
+		assert.Len(t, model.Schedules, 2)
+		assert.Equal(t, int64(1), model.Schedules[1].SlaIndex.ValueInt64())
+		assert.Len(t, model.Schedules[0].Backups, defaultHorizonDays)
+		assert.Equal(t, "2025-01-02T00:00:00Z",
+			model.Schedules[0].Backups[0].WindowStart.ValueString())
+	})
+
+	// Tests that the time zone of an operation overrides the one of the data source.
+	t.Run("Operation time zone", func(t *testing.T) {
+		model := &policySchedulePreviewDataSourceModel{
+			Timezone:    types.StringValue("America/New_York"),
+			StartTime:   types.StringValue("2025-01-01T00:00:00Z"),
+			HorizonDays: types.Int64Value(1),
+			Operations: []*schedulePreviewOperationModel{
+				{
+					OperationType: types.StringValue("aws_ebs_volume_backup"),
+					Timezone:      types.StringValue("Asia/Tokyo"),
+					Slas:          []*slaModel{testPreviewSla("days", 7, "days", 1)},
+				},
+			},
+		}
+		diags := previewSchedules(model)
+		assert.False(t, diags.HasError(), diags)
+		assert.Equal(t, "Asia/Tokyo", model.Schedules[0].Timezone.ValueString())
+		assert.Equal(t, "2025-01-02T00:00:00+09:00",
+			model.Schedules[0].Backups[0].WindowStart.ValueString())
+	})
+
+	// Tests that a start time not in RFC 3339 format returns an error.
+	t.Run("Invalid start time", func(t *testing.T) {
+		model := &policySchedulePreviewDataSourceModel{
+			StartTime: types.StringValue("2025-01-01"),
+		}
+		diags := previewSchedules(model)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that an invalid time zone returns an error on the time zone of the operation.
+	t.Run("Invalid time zone", func(t *testing.T) {
+		model := &policySchedulePreviewDataSourceModel{
+			Operations: []*schedulePreviewOperationModel{
+				{
+					OperationType: types.StringValue("aws_ebs_volume_backup"),
+					Slas:          []*slaModel{testPreviewSla("days", 7, "days", 1)},
+				},
+				{
+					OperationType: types.StringValue("aws_ec2_instance_backup"),
+					Timezone:      types.StringValue("Mars/Olympus_Mons"),
+					Slas:          []*slaModel{testPreviewSla("days", 7, "days", 1)},
+				},
+			},
+		}
+		diags := previewSchedules(model)
+		assertErrorPaths(t, diags,
+			path.Root(schemaOperations).AtListIndex(1).AtName(schemaTimezone))
+	})
+
+	// Tests that SLAs which cannot be previewed return an error on the SLA.
+	t.Run("Invalid SLA", func(t *testing.T) {
+		for _, sla := range []*slaModel{
+			testPreviewSla("hours", 7, "days", 1),
+			testPreviewSla("days", 7, "fortnights", 1),
+			testPreviewSla("days", 7, "days", 0),
+			testPreviewSla("weeks", 4, "weeks", 1, 7),
+			{},
+		} {
+			model := &policySchedulePreviewDataSourceModel{
+				Operations: []*schedulePreviewOperationModel{
+					{Slas: []*slaModel{testPreviewSla("days", 7, "days", 1), sla}},
+				},
+			}
+			diags := previewSchedules(model)
+			assertErrorPaths(t, diags, path.Root(schemaOperations).AtListIndex(0).
+				AtName(schemaSlas).AtListIndex(1))
+		}
+
+		model := &policySchedulePreviewDataSourceModel{
+			Operations: []*schedulePreviewOperationModel{
+				{
+					Slas:           []*slaModel{testPreviewSla("days", 7, "days", 1)},
+					BackupWindowTz: testBackupWindow("05:00", "05:00"),
+				},
+			},
+		}
+		diags := previewSchedules(model)
+		assertErrorPaths(t, diags, path.Root(schemaOperations).AtListIndex(0).
+			AtName(schemaSlas).AtListIndex(0))
+	})
+}
+
+// Unit test for the following cases:
+//   - Read policy operations success scenario.
+//   - SDK API for read policy definition returns an error.
+//   - SDK API for read policy definition returns an empty response.
+func TestReadPolicyOperations(t *testing.T) {
+
+	ctx := context.Background()
+	policyClient := sdkclients.NewMockPolicyDefinitionClient(t)
+	policyId := "test-policy-id"
+	timezone := "Europe/Paris"
+	operationType := "aws_ebs_volume_backup"
+	retentionUnit := "days"
+	rpoUnit := "days"
+	retentionValue := int64(7)
+	rpoValue := int64(1)
+	startTime := "01:00"
+	testError := "Test Error"
+
+	ds := policySchedulePreviewDataSource{
+		name:                   "clumio_policy_schedule_preview",
+		policyDefinitionClient: policyClient,
+	}
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests that the operations and time zone of the policy are set in the model.
+	t.Run("Basic success scenario for read policy operations", func(t *testing.T) {
+
+		model := &policySchedulePreviewDataSourceModel{
+			PolicyId: types.StringValue(policyId),
+		}
+		readResponse := &models.ReadPolicyResponse{
+			Id:       &policyId,
+			Timezone: &timezone,
+			Operations: []*models.PolicyOperation{
+				{
+					ClumioType: &operationType,
+					BackupWindowTz: &models.BackupWindow{
+						StartTime: &startTime,
+					},
+					Slas: []*models.BackupSLA{
+						{
+							RetentionDuration: &models.RetentionBackupSLAParam{
+								Unit:  &retentionUnit,
+								Value: &retentionValue,
+							},
+							RpoFrequency: &models.RPOBackupSLAParam{
+								Unit:  &rpoUnit,
+								Value: &rpoValue,
+							},
+						},
+					},
+				},
+			},
+		}
+		policyClient.EXPECT().ReadPolicyDefinition(policyId, mock.Anything).Times(1).Return(
+			readResponse, nil)
+
+		diags := ds.readPolicyOperations(ctx, model)
+		assert.Nil(t, diags)
+		assert.Equal(t, timezone, model.Timezone.ValueString())
+		assert.Len(t, model.Operations, 1)
+		assert.Equal(t, operationType, model.Operations[0].OperationType.ValueString())
+		assert.Equal(t, startTime, model.Operations[0].BackupWindowTz.StartTime.ValueString())
+		assert.Equal(t, rpoValue, model.Operations[0].Slas[0].RPOFrequency.Value.ValueInt64())
+	})
+
+	// Tests that Diagnostics is returned in case the read policy definition API call returns an
+	// error.
+	t.Run("read policy definition returns an error", func(t *testing.T) {
+
+		model := &policySchedulePreviewDataSourceModel{
+			PolicyId: types.StringValue(policyId),
+		}
+		policyClient.EXPECT().ReadPolicyDefinition(policyId, mock.Anything).Times(1).Return(
+			nil, apiError)
+
+		diags := ds.readPolicyOperations(ctx, model)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the read policy definition API call returns an
+	// empty response.
+	t.Run("read policy definition returns an empty response", func(t *testing.T) {
+
+		model := &policySchedulePreviewDataSourceModel{
+			PolicyId: types.StringValue(policyId),
+		}
+		policyClient.EXPECT().ReadPolicyDefinition(policyId, mock.Anything).Times(1).Return(
+			nil, nil)
+
+		diags := ds.readPolicyOperations(ctx, model)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
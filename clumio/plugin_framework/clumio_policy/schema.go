@@ -9,11 +9,11 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
-
-	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -25,12 +25,21 @@ import (
 // the schema of the resource and the data it holds. This schema is used by customers to configure
 // the resource and by the Clumio provider to read and write the resource.
 type policyResourceModel struct {
-	ID               types.String            `tfsdk:"id"`
-	LockStatus       types.String            `tfsdk:"lock_status"`
-	Name             types.String            `tfsdk:"name"`
-	Timezone         types.String            `tfsdk:"timezone"`
-	ActivationStatus types.String            `tfsdk:"activation_status"`
-	Operations       []*policyOperationModel `tfsdk:"operations"`
+	ID                        types.String            `tfsdk:"id"`
+	LockStatus                types.String            `tfsdk:"lock_status"`
+	Name                      types.String            `tfsdk:"name"`
+	Timezone                  types.String            `tfsdk:"timezone"`
+	ActivationStatus          types.String            `tfsdk:"activation_status"`
+	Operations                []*policyOperationModel `tfsdk:"operations"`
+	OrganizationalUnitContext types.String            `tfsdk:"organizational_unit_context"`
+	DeletionProtection        types.Bool              `tfsdk:"deletion_protection"`
+	Timeouts                  timeouts.Value          `tfsdk:"timeouts"`
+}
+
+// policyResourceIdentityModel is the identity model for the clumio_policy Terraform resource. It
+// identifies the policy when importing it and when listing policies with `terraform query`.
+type policyResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 // replicaModel maps to some of the attributes in the advancedSettingsModel which require a
@@ -52,8 +61,8 @@ type pitrConfigModel struct {
 	Apply types.String `tfsdk:"apply"`
 }
 
-// ContinuousConfigModel maps to the ProtectionGroupContinuousBackup attribute in
-// advancedSettingsModel which determines whether eventbridge notification is enabled or not.
+// ContinuousConfigModel maps to the ProtectionGroupContinuousBackup and S3Backtrack attributes in
+// advancedSettingsModel which determine whether eventbridge notification is enabled or not.
 type ContinuousConfigModel struct {
 	DisableEventbridgeNotification types.Bool `tfsdk:"disable_eventbridge_notification"`
 }
@@ -61,30 +70,32 @@ type ContinuousConfigModel struct {
 // advancedSettingsModel maps to the AdvancedSettings attribute in policyOperationModel which
 // contains additional operation-specific policy settings.
 type advancedSettingsModel struct {
-	EC2MssqlDatabaseBackup []*replicaModel          `tfsdk:"ec2_mssql_database_backup"`
-	EC2MssqlLogBackup      []*replicaModel          `tfsdk:"ec2_mssql_log_backup"`
-	MssqlDatabaseBackup    []*replicaModel          `tfsdk:"mssql_database_backup"`
-	MssqlLogBackup         []*replicaModel          `tfsdk:"mssql_log_backup"`
-	ProtectionGroupBackup  []*backupTierModel       `tfsdk:"protection_group_backup"`
-	S3ContinuousBackup     []*ContinuousConfigModel `tfsdk:"protection_group_continuous_backup"`
-	EBSVolumeBackup        []*backupTierModel       `tfsdk:"aws_ebs_volume_backup"`
-	EC2InstanceBackup      []*backupTierModel       `tfsdk:"aws_ec2_instance_backup"`
-	RDSPitrConfigSync      []*pitrConfigModel       `tfsdk:"aws_rds_config_sync"`
-	RDSLogicalBackup       []*backupTierModel       `tfsdk:"aws_rds_resource_granular_backup"`
-	IcebergTableBackup     []*backupTierModel       `tfsdk:"aws_iceberg_table_backup"`
+	EC2MssqlDatabaseBackup *replicaModel          `tfsdk:"ec2_mssql_database_backup"`
+	EC2MssqlLogBackup      *replicaModel          `tfsdk:"ec2_mssql_log_backup"`
+	MssqlDatabaseBackup    *replicaModel          `tfsdk:"mssql_database_backup"`
+	MssqlLogBackup         *replicaModel          `tfsdk:"mssql_log_backup"`
+	ProtectionGroupBackup  *backupTierModel       `tfsdk:"protection_group_backup"`
+	S3ContinuousBackup     *ContinuousConfigModel `tfsdk:"protection_group_continuous_backup"`
+	EBSVolumeBackup        *backupTierModel       `tfsdk:"aws_ebs_volume_backup"`
+	EC2InstanceBackup      *backupTierModel       `tfsdk:"aws_ec2_instance_backup"`
+	RDSPitrConfigSync      *pitrConfigModel       `tfsdk:"aws_rds_config_sync"`
+	RDSLogicalBackup       *backupTierModel       `tfsdk:"aws_rds_resource_granular_backup"`
+	IcebergTableBackup     *backupTierModel       `tfsdk:"aws_iceberg_table_backup"`
+	DynamoDBTableBackup    *backupTierModel       `tfsdk:"aws_dynamodb_table_backup"`
+	S3Backtrack            *ContinuousConfigModel `tfsdk:"aws_s3_backtrack"`
 }
 
 // policyOperationModel maps to the Operations attribute in policyResourceModel and contains
 // information such as how often to protect the data source, whether a backup window is desired,
 // which type of protection to perform, etc
 type policyOperationModel struct {
-	ActionSetting    types.String             `tfsdk:"action_setting"`
-	OperationType    types.String             `tfsdk:"type"`
-	BackupWindowTz   []*backupWindowModel     `tfsdk:"backup_window_tz"`
-	Slas             []*slaModel              `tfsdk:"slas"`
-	AdvancedSettings []*advancedSettingsModel `tfsdk:"advanced_settings"`
-	BackupAwsRegion  types.String             `tfsdk:"backup_aws_region"`
-	Timezone         types.String             `tfsdk:"timezone"`
+	ActionSetting    types.String           `tfsdk:"action_setting"`
+	OperationType    types.String           `tfsdk:"type"`
+	BackupWindowTz   *backupWindowModel     `tfsdk:"backup_window_tz"`
+	Slas             []*slaModel            `tfsdk:"slas"`
+	AdvancedSettings *advancedSettingsModel `tfsdk:"advanced_settings"`
+	BackupAwsRegion  types.String           `tfsdk:"backup_aws_region"`
+	Timezone         types.String           `tfsdk:"timezone"`
 }
 
 // unitValueModel maps tho the RetentionDuration attribute in slaModel and and it provides the unit
@@ -105,8 +116,8 @@ type rpoModel struct {
 // slaModel maps to the Slas attribute in policyOperationModel and it refers to the service level
 // agreement (SLA) for the policy.
 type slaModel struct {
-	RetentionDuration []*unitValueModel `tfsdk:"retention_duration"`
-	RPOFrequency      []*rpoModel       `tfsdk:"rpo_frequency"`
+	RetentionDuration *unitValueModel `tfsdk:"retention_duration"`
+	RPOFrequency      *rpoModel       `tfsdk:"rpo_frequency"`
 }
 
 // backupWindowModel maps to the BackupWindowTz attribute policyOperationModel and it refers to
@@ -120,7 +131,7 @@ type backupWindowModel struct {
 // method on the policyResource struct. It sets the schema for the clumio_policy Terraform resource,
 // which is used to create a policy for scheduling backups on Clumio supported data sources.
 func (r *policyResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	retentionUnitAttribute := schema.StringAttribute{
 		Required: true,
@@ -176,144 +187,120 @@ func (r *policyResource) Schema(
 		},
 	}
 
-	advancedSettingsSchemaBlocks := map[string]schema.Block{
-		schemaEc2MssqlDatabaseBackup: schema.SetNestedBlock{
+	advancedSettingsSchemaAttributes := map[string]schema.Attribute{
+		schemaEc2MssqlDatabaseBackup: schema.SingleNestedAttribute{
 			Description: mssqlDatabaseBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: databaseBackupSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Optional:    true,
+			Attributes:  databaseBackupSchemaAttributes,
 		},
-		schemaEc2MssqlLogBackup: schema.SetNestedBlock{
+		schemaEc2MssqlLogBackup: schema.SingleNestedAttribute{
 			Description: mssqlLogBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: logBackupSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Optional:    true,
+			Attributes:  logBackupSchemaAttributes,
 		},
-		schemaMssqlDatabaseBackup: schema.SetNestedBlock{
+		schemaMssqlDatabaseBackup: schema.SingleNestedAttribute{
 			Description: mssqlDatabaseBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: databaseBackupSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Optional:    true,
+			Attributes:  databaseBackupSchemaAttributes,
 		},
-		schemaMssqlLogBackup: schema.SetNestedBlock{
+		schemaMssqlLogBackup: schema.SingleNestedAttribute{
 			Description: mssqlLogBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: logBackupSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Optional:    true,
+			Attributes:  logBackupSchemaAttributes,
 		},
-		schemaProtectionGroupBackup: schema.SetNestedBlock{
+		schemaProtectionGroupBackup: schema.SingleNestedAttribute{
 			Description: "Additional policy configuration settings for the" +
 				" protection_group_backup operation. If this operation is not of" +
 				" type protection_group_backup, then this field is omitted from" +
 				" the response.",
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaBackupTier: schema.StringAttribute{
-						Optional: true,
-						Description: "Backup tier to store the backup in. Valid values are:" +
-							" `cold` and `frozen`.\n\t- `cold` = Clumio SecureVault Standard\n\t" +
-							"- `frozen` = Clumio SecureVault Archive",
-					},
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				schemaBackupTier: schema.StringAttribute{
+					Optional: true,
+					Description: "Backup tier to store the backup in. Valid values are:" +
+						" `cold` and `frozen`.\n\t- `cold` = Clumio SecureVault Standard\n\t" +
+						"- `frozen` = Clumio SecureVault Archive",
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
 		},
-		schemaS3ContinuousBackup: schema.SetNestedBlock{
+		schemaS3ContinuousBackup: schema.SingleNestedAttribute{
 			Description: S3ContinuousBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaDisableEventbridgeNotification: schema.BoolAttribute{
-						Optional:    true,
-						Description: DisableEventbridgeNotificationDesc,
-					},
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaDisableEventbridgeNotification: schema.BoolAttribute{
+					Optional:    true,
+					Description: DisableEventbridgeNotificationDesc,
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
 		},
-		schemaEBSVolumeBackup: schema.SetNestedBlock{
+		schemaEBSVolumeBackup: schema.SingleNestedAttribute{
 			Description: ebsBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaBackupTier: schema.StringAttribute{
-						Optional:    true,
-						Description: ebsEc2BackupTierDesc,
-					},
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaBackupTier: schema.StringAttribute{
+					Optional:    true,
+					Description: ebsEc2BackupTierDesc,
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
 		},
-		schemaEC2InstanceBackup: schema.SetNestedBlock{
+		schemaEC2InstanceBackup: schema.SingleNestedAttribute{
 			Description: ec2BackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaBackupTier: schema.StringAttribute{
-						Optional:    true,
-						Description: ebsEc2BackupTierDesc,
-					},
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaBackupTier: schema.StringAttribute{
+					Optional:    true,
+					Description: ebsEc2BackupTierDesc,
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
 		},
-		schemaRDSPitrConfigSync: schema.SetNestedBlock{
+		schemaRDSPitrConfigSync: schema.SingleNestedAttribute{
 			Description: rdsPitrConfigSyncDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaApply: schema.StringAttribute{
-						Optional:    true,
-						Description: pitrConfigDesc,
-					},
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaApply: schema.StringAttribute{
+					Optional:    true,
+					Description: pitrConfigDesc,
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
 		},
-		schemaRdsLogicalBackup: schema.SetNestedBlock{
+		schemaRdsLogicalBackup: schema.SingleNestedAttribute{
 			Description: rdsLogicalBackupDesc,
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaBackupTier: schema.StringAttribute{
-						Optional:    true,
-						Description: rdsLogicalBackupAdvancedSettingDesc,
-					},
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaBackupTier: schema.StringAttribute{
+					Optional:    true,
+					Description: rdsLogicalBackupAdvancedSettingDesc,
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
 		},
-		schemaIcebergTableBackup: schema.SetNestedBlock{
+		schemaIcebergTableBackup: schema.SingleNestedAttribute{
 			Description: "The advanced settings for Iceberg backup operations.",
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					schemaBackupTier: schema.StringAttribute{
-						Optional:    true,
-						Description: "Backup tier to store the backup in. Valid values are: `standard`.",
-					},
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaBackupTier: schema.StringAttribute{
+					Optional:    true,
+					Description: "Backup tier to store the backup in. Valid values are: `standard`.",
 				},
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
+		},
+		schemaDynamoDBTableBackup: schema.SingleNestedAttribute{
+			Description: dynamoDBBackupDesc,
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaBackupTier: schema.StringAttribute{
+					Optional:    true,
+					Description: dynamoDBBackupTierDesc,
+				},
+			},
+		},
+		schemaS3Backtrack: schema.SingleNestedAttribute{
+			Description: s3BacktrackDesc,
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				schemaDisableEventbridgeNotification: schema.BoolAttribute{
+					Optional:    true,
+					Description: s3BacktrackDisableEventbridgeNotificationDesc,
+				},
 			},
 		},
 	}
@@ -332,7 +319,9 @@ func (r *policyResource) Schema(
 				" Specify the end time in the format `hh:mm`," +
 				" where `hh` represents the hour of the day and" +
 				" `mm` represents the minute of the day based on" +
-				" the 24 hour clock. Leave empty if you do not want" +
+				" the 24 hour clock. An end time before the start time" +
+				" denotes a backup window which spans midnight, such as" +
+				" 22:00 to 04:00. Leave empty if you do not want" +
 				" to specify an end time. If the backup window closes" +
 				" while a backup is in progress, the entire backup process" +
 				" is aborted. The next backup will be performed when the " +
@@ -346,20 +335,15 @@ func (r *policyResource) Schema(
 		},
 	}
 
-	slaSchemaBlocks := map[string]schema.Block{
-		schemaRetentionDuration: schema.SetNestedBlock{
+	slaSchemaAttributes := map[string]schema.Attribute{
+		schemaRetentionDuration: schema.SingleNestedAttribute{
 			Description: "The retention time for this SLA. " +
 				"For example, to retain the backup for 1 month," +
 				" set unit=months and value=1.",
-			NestedObject: schema.NestedBlockObject{
-				Attributes: retentionSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.IsRequired()),
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Required:   true,
+			Attributes: retentionSchemaAttributes,
 		},
-		schemaRpoFrequency: schema.SetNestedBlock{
+		schemaRpoFrequency: schema.SingleNestedAttribute{
 			Description: "The minimum frequency between " +
 				"backups for this SLA. Also known as the " +
 				"recovery point objective (RPO) interval. For" +
@@ -371,13 +355,8 @@ func (r *policyResource) Schema(
 				"specify a day of week for Weekly SLA. For example, " +
 				"set offsets=[1] will trigger backup on every " +
 				"Monday.",
-			NestedObject: schema.NestedBlockObject{
-				Attributes: rpoSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.IsRequired()),
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Required:   true,
+			Attributes: rpoSchemaAttributes,
 		},
 	}
 
@@ -414,47 +393,58 @@ func (r *policyResource) Schema(
 				stringvalidator.LengthAtLeast(1),
 			},
 		},
-	}
-
-	operationSchemaBlocks := map[string]schema.Block{
-		schemaBackupWindowTz: schema.SetNestedBlock{
+		schemaBackupWindowTz: schema.SingleNestedAttribute{
 			Description: "The start and end times for the customized" +
 				" backup window that reflects the user-defined timezone.",
-			NestedObject: schema.NestedBlockObject{
-				Attributes: backupWindowSchemaAttributes,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Optional:   true,
+			Attributes: backupWindowSchemaAttributes,
 		},
-		schemaAdvancedSettings: schema.SetNestedBlock{
+		schemaAdvancedSettings: schema.SingleNestedAttribute{
 			Description: "Additional operation-specific policy settings.",
-			NestedObject: schema.NestedBlockObject{
-				Blocks: advancedSettingsSchemaBlocks,
-			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.SizeAtMost(1)),
-			},
+			Optional:    true,
+			Attributes:  advancedSettingsSchemaAttributes,
 		},
-		schemaSlas: schema.SetNestedBlock{
+		schemaSlas: schema.ListNestedAttribute{
 			Description: "The service level agreement (SLA) for the policy." +
 				" A policy can include one or more SLAs. For example, " +
 				"a policy can retain daily backups for a month each, " +
 				"and monthly backups for a year each.",
-			NestedObject: schema.NestedBlockObject{
-				Blocks: slaSchemaBlocks,
+			Required: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: slaSchemaAttributes,
 			},
-			Validators: []validator.Set{
-				common.WrapSetValidator(setvalidator.IsRequired()),
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
 			},
 		},
 	}
 
 	resp.Schema = schema.Schema{
+		// The version is incremented whenever the structure of the schema changes, in which case
+		// UpgradeState upgrades the state of the prior versions.
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		Description: "Clumio Policy Resource used to schedule backups on" +
 			" Clumio supported data sources.",
 		Attributes: map[string]schema.Attribute{
+			schemaDeletionProtection: schema.BoolAttribute{
+				Description: "Whether to prevent the deletion of the policy. If not set, the" +
+					" deletion_protection of the provider is used. To delete the policy, set it" +
+					" to false and apply the change first.",
+				Optional: true,
+			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier of the policy.",
 				Computed:    true,
@@ -502,21 +492,39 @@ func (r *policyResource) Schema(
 					stringvalidator.OneOf(activationStatusActivated, activationStatusDectivated),
 				},
 			},
-		},
-		Blocks: map[string]schema.Block{
-			schemaOperations: schema.SetNestedBlock{
+			schemaOperations: schema.ListNestedAttribute{
 				Description: "Each data source to be protected should have details provided in " +
 					"the list of operations. These details include information such as how often " +
 					"to protect the data source, whether a backup window is desired, which type " +
 					"of protection to perform, etc.",
-				NestedObject: schema.NestedBlockObject{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
 					Attributes: operationSchemaAttributes,
-					Blocks:     operationSchemaBlocks,
 				},
-				Validators: []validator.Set{
-					common.WrapSetValidator(setvalidator.IsRequired()),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_policy Terraform resource, which is the ID of
+// the policy.
+func (r *policyResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the policy.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
@@ -0,0 +1,164 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema of version 0 of the clumio_policy Terraform
+// resource. Version 0 modelled the single-valued objects of the policy operations as set blocks of
+// at most one element. It is only used to read the state written by older versions of the provider
+// so that it can be upgraded to the current version.
+
+package clumio_policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyResourceModelV0 is the resource model of version 0 of the clumio_policy Terraform resource.
+type policyResourceModelV0 struct {
+	ID                        types.String              `tfsdk:"id"`
+	LockStatus                types.String              `tfsdk:"lock_status"`
+	Name                      types.String              `tfsdk:"name"`
+	Timezone                  types.String              `tfsdk:"timezone"`
+	ActivationStatus          types.String              `tfsdk:"activation_status"`
+	Operations                []*policyOperationModelV0 `tfsdk:"operations"`
+	OrganizationalUnitContext types.String              `tfsdk:"organizational_unit_context"`
+	DeletionProtection        types.Bool                `tfsdk:"deletion_protection"`
+	Timeouts                  timeouts.Value            `tfsdk:"timeouts"`
+}
+
+// advancedSettingsModelV0 maps to the AdvancedSettings attribute in policyOperationModelV0.
+type advancedSettingsModelV0 struct {
+	EC2MssqlDatabaseBackup []*replicaModel          `tfsdk:"ec2_mssql_database_backup"`
+	EC2MssqlLogBackup      []*replicaModel          `tfsdk:"ec2_mssql_log_backup"`
+	MssqlDatabaseBackup    []*replicaModel          `tfsdk:"mssql_database_backup"`
+	MssqlLogBackup         []*replicaModel          `tfsdk:"mssql_log_backup"`
+	ProtectionGroupBackup  []*backupTierModel       `tfsdk:"protection_group_backup"`
+	S3ContinuousBackup     []*ContinuousConfigModel `tfsdk:"protection_group_continuous_backup"`
+	EBSVolumeBackup        []*backupTierModel       `tfsdk:"aws_ebs_volume_backup"`
+	EC2InstanceBackup      []*backupTierModel       `tfsdk:"aws_ec2_instance_backup"`
+	RDSPitrConfigSync      []*pitrConfigModel       `tfsdk:"aws_rds_config_sync"`
+	RDSLogicalBackup       []*backupTierModel       `tfsdk:"aws_rds_resource_granular_backup"`
+	IcebergTableBackup     []*backupTierModel       `tfsdk:"aws_iceberg_table_backup"`
+}
+
+// policyOperationModelV0 maps to the Operations attribute in policyResourceModelV0.
+type policyOperationModelV0 struct {
+	ActionSetting    types.String               `tfsdk:"action_setting"`
+	OperationType    types.String               `tfsdk:"type"`
+	BackupWindowTz   []*backupWindowModel       `tfsdk:"backup_window_tz"`
+	Slas             []*slaModelV0              `tfsdk:"slas"`
+	AdvancedSettings []*advancedSettingsModelV0 `tfsdk:"advanced_settings"`
+	BackupAwsRegion  types.String               `tfsdk:"backup_aws_region"`
+	Timezone         types.String               `tfsdk:"timezone"`
+}
+
+// slaModelV0 maps to the Slas attribute in policyOperationModelV0.
+type slaModelV0 struct {
+	RetentionDuration []*unitValueModel `tfsdk:"retention_duration"`
+	RPOFrequency      []*rpoModel       `tfsdk:"rpo_frequency"`
+}
+
+// policySchemaV0 returns version 0 of the schema of the clumio_policy Terraform resource. Only the
+// structure of the schema is kept as it is solely used to read prior state; descriptions,
+// validators and plan modifiers are omitted.
+func policySchemaV0(ctx context.Context) schema.Schema {
+
+	setBlock := func(attributes map[string]schema.Attribute) schema.Block {
+		return schema.SetNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: attributes,
+			},
+		}
+	}
+	optionalString := schema.StringAttribute{Optional: true}
+	replicaAttributes := map[string]schema.Attribute{
+		schemaAlternativeReplica: optionalString,
+		schemaPreferredReplica:   optionalString,
+	}
+	backupTierAttributes := map[string]schema.Attribute{
+		schemaBackupTier: optionalString,
+	}
+	applyAttributes := map[string]schema.Attribute{
+		schemaApply: optionalString,
+	}
+
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			schemaDeletionProtection:        schema.BoolAttribute{Optional: true},
+			schemaOrganizationalUnitContext: optionalString,
+			schemaId:                        schema.StringAttribute{Computed: true},
+			schemaLockStatus:                schema.StringAttribute{Computed: true},
+			schemaName:                      schema.StringAttribute{Required: true},
+			schemaTimezone:                  optionalString,
+			schemaActivationStatus: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			schemaOperations: schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						schemaActionSetting:   schema.StringAttribute{Required: true},
+						schemaOperationType:   schema.StringAttribute{Required: true},
+						schemaBackupAwsRegion: optionalString,
+						schemaTimezone:        optionalString,
+					},
+					Blocks: map[string]schema.Block{
+						schemaBackupWindowTz: setBlock(map[string]schema.Attribute{
+							schemaStartTime: optionalString,
+							schemaEndTime: schema.StringAttribute{
+								Optional: true,
+								Computed: true,
+							},
+						}),
+						schemaAdvancedSettings: schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									schemaEc2MssqlDatabaseBackup: setBlock(replicaAttributes),
+									schemaEc2MssqlLogBackup:      setBlock(replicaAttributes),
+									schemaMssqlDatabaseBackup:    setBlock(replicaAttributes),
+									schemaMssqlLogBackup:         setBlock(replicaAttributes),
+									schemaProtectionGroupBackup:  setBlock(backupTierAttributes),
+									schemaS3ContinuousBackup: setBlock(map[string]schema.Attribute{
+										schemaDisableEventbridgeNotification: schema.BoolAttribute{
+											Optional: true,
+										},
+									}),
+									schemaEBSVolumeBackup:    setBlock(backupTierAttributes),
+									schemaEC2InstanceBackup:  setBlock(backupTierAttributes),
+									schemaRDSPitrConfigSync:  setBlock(applyAttributes),
+									schemaRdsLogicalBackup:   setBlock(backupTierAttributes),
+									schemaIcebergTableBackup: setBlock(backupTierAttributes),
+								},
+							},
+						},
+						schemaSlas: schema.SetNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									schemaRetentionDuration: setBlock(map[string]schema.Attribute{
+										schemaUnit:  schema.StringAttribute{Required: true},
+										schemaValue: schema.Int64Attribute{Required: true},
+									}),
+									schemaRpoFrequency: setBlock(map[string]schema.Attribute{
+										schemaUnit:  schema.StringAttribute{Required: true},
+										schemaValue: schema.Int64Attribute{Required: true},
+										schemaOffsets: schema.ListAttribute{
+											Optional:    true,
+											ElementType: types.Int64Type,
+										},
+									}),
+								},
+							},
+						},
+					},
+				},
+			},
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
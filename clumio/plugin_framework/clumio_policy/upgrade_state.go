@@ -0,0 +1,105 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the upgrade of the state written by prior versions of the schema of the
+// clumio_policy Terraform resource to the current version.
+
+package clumio_policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// UpgradeState returns the state upgraders of the prior versions of the schema of the clumio_policy
+// Terraform resource, keyed by the version they upgrade from.
+func (r *policyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+
+	schemaV0 := policySchemaV0(ctx)
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradePolicyStateV0,
+		},
+	}
+}
+
+// upgradePolicyStateV0 upgrades the state of version 0 of the schema to the current version. The
+// set blocks of at most one element of version 0 become single nested attributes, and the
+// operations and their SLAs become lists.
+func upgradePolicyStateV0(
+	ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+
+	var prior policyResourceModelV0
+	diags := req.State.Get(ctx, &prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := policyResourceModel{
+		ID:                        prior.ID,
+		LockStatus:                prior.LockStatus,
+		Name:                      prior.Name,
+		Timezone:                  prior.Timezone,
+		ActivationStatus:          prior.ActivationStatus,
+		OrganizationalUnitContext: prior.OrganizationalUnitContext,
+		DeletionProtection:        prior.DeletionProtection,
+		Timeouts:                  prior.Timeouts,
+	}
+	if prior.Operations != nil {
+		upgraded.Operations = make([]*policyOperationModel, 0, len(prior.Operations))
+		for _, operation := range prior.Operations {
+			upgraded.Operations = append(upgraded.Operations, upgradeOperationV0(operation))
+		}
+	}
+
+	diags = resp.State.Set(ctx, &upgraded)
+	resp.Diagnostics.Append(diags...)
+}
+
+// upgradeOperationV0 upgrades an operation of version 0 of the schema to the current version.
+func upgradeOperationV0(operation *policyOperationModelV0) *policyOperationModel {
+
+	upgraded := &policyOperationModel{
+		ActionSetting:   operation.ActionSetting,
+		OperationType:   operation.OperationType,
+		BackupWindowTz:  first(operation.BackupWindowTz),
+		BackupAwsRegion: operation.BackupAwsRegion,
+		Timezone:        operation.Timezone,
+	}
+	if operation.Slas != nil {
+		upgraded.Slas = make([]*slaModel, 0, len(operation.Slas))
+		for _, sla := range operation.Slas {
+			upgraded.Slas = append(upgraded.Slas, &slaModel{
+				RetentionDuration: first(sla.RetentionDuration),
+				RPOFrequency:      first(sla.RPOFrequency),
+			})
+		}
+	}
+	if settings := first(operation.AdvancedSettings); settings != nil {
+		upgraded.AdvancedSettings = &advancedSettingsModel{
+			EC2MssqlDatabaseBackup: first(settings.EC2MssqlDatabaseBackup),
+			EC2MssqlLogBackup:      first(settings.EC2MssqlLogBackup),
+			MssqlDatabaseBackup:    first(settings.MssqlDatabaseBackup),
+			MssqlLogBackup:         first(settings.MssqlLogBackup),
+			ProtectionGroupBackup:  first(settings.ProtectionGroupBackup),
+			S3ContinuousBackup:     first(settings.S3ContinuousBackup),
+			EBSVolumeBackup:        first(settings.EBSVolumeBackup),
+			EC2InstanceBackup:      first(settings.EC2InstanceBackup),
+			RDSPitrConfigSync:      first(settings.RDSPitrConfigSync),
+			RDSLogicalBackup:       first(settings.RDSLogicalBackup),
+			IcebergTableBackup:     first(settings.IcebergTableBackup),
+		}
+	}
+	return upgraded
+}
+
+// first returns the first element of the given set block of at most one element, or nil if the
+// block is not set.
+func first[T any](block []*T) *T {
+	if len(block) == 0 {
+		return nil
+	}
+	return block[0]
+}
@@ -0,0 +1,623 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in upgrade_state.go and generates the section
+// of the migration guide describing the upgrade from version 0 of the schema.
+
+//go:build unit
+
+package clumio_policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// migrationGuidePath is the path of the migration guide from the directory of the package.
+	migrationGuidePath = "../../../MIGRATION_GUIDE.md"
+	// migrationGuideBegin and migrationGuideEnd delimit the section of the migration guide which
+	// is generated by TestMigrationGuide.
+	migrationGuideBegin = "<!-- BEGIN GENERATED: clumio_policy schema version 1." +
+		" Run the unit tests with UPDATE_MIGRATION_GUIDE=1 to update. -->\n"
+	migrationGuideEnd = "<!-- END GENERATED: clumio_policy schema version 1. -->"
+)
+
+// stateUpgradeTestCase is a state of version 0 of the schema along with the state it is upgraded
+// to. The configs of both versions which produce the states are rendered into the migration guide.
+type stateUpgradeTestCase struct {
+	title       string
+	description string
+	configV0    string
+	configV1    string
+	prior       policyResourceModelV0
+	expected    policyResourceModel
+}
+
+// testTimeoutsNull returns the value of the timeouts block when it is not set.
+func testTimeoutsNull() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
+// testOffsets returns the offsets of an RPO frequency, which are null if none is given.
+func testOffsets(offsets ...int64) types.List {
+	if len(offsets) == 0 {
+		return types.ListNull(types.Int64Type)
+	}
+	elements := make([]attr.Value, 0, len(offsets))
+	for _, offset := range offsets {
+		elements = append(elements, types.Int64Value(offset))
+	}
+	return types.ListValueMust(types.Int64Type, elements)
+}
+
+// testUnitValue returns the retention duration with the given unit and value.
+func testUnitValue(unit string, value int64) *unitValueModel {
+	return &unitValueModel{Unit: types.StringValue(unit), Value: types.Int64Value(value)}
+}
+
+// testRpo returns the RPO frequency with the given unit, value and offsets.
+func testRpo(unit string, value int64, offsets ...int64) *rpoModel {
+	return &rpoModel{
+		Unit:    types.StringValue(unit),
+		Value:   types.Int64Value(value),
+		Offsets: testOffsets(offsets...),
+	}
+}
+
+// testUpgradePolicy returns a policy of the current version of the schema with the given
+// operations and the attributes shared by the test cases.
+func testUpgradePolicy(operations ...*policyOperationModel) policyResourceModel {
+	return policyResourceModel{
+		ID:               types.StringValue("test-policy-id"),
+		LockStatus:       types.StringValue("unlocked"),
+		Name:             types.StringValue("example-policy"),
+		ActivationStatus: types.StringValue(activationStatusActivated),
+		Operations:       operations,
+		Timeouts:         testTimeoutsNull(),
+	}
+}
+
+// testUpgradePolicyV0 returns a policy of version 0 of the schema with the given operations and
+// the attributes shared by the test cases.
+func testUpgradePolicyV0(operations ...*policyOperationModelV0) policyResourceModelV0 {
+	return policyResourceModelV0{
+		ID:               types.StringValue("test-policy-id"),
+		LockStatus:       types.StringValue("unlocked"),
+		Name:             types.StringValue("example-policy"),
+		ActivationStatus: types.StringValue(activationStatusActivated),
+		Operations:       operations,
+		Timeouts:         testTimeoutsNull(),
+	}
+}
+
+// stateUpgradeTestCases are the test cases of the upgrade from version 0 of the schema. They are
+// rendered into the migration guide in the given order.
+var stateUpgradeTestCases = []stateUpgradeTestCase{
+	{
+		title: "Operations, SLAs and backup windows",
+		description: "The `operations` and `slas` blocks become lists of objects, and the" +
+			" `backup_window_tz`, `retention_duration` and `rpo_frequency` blocks become objects.",
+		configV0: `resource "clumio_policy" "example" {
+  name              = "example-policy"
+  activation_status = "activated"
+  operations {
+    action_setting = "window"
+    type           = "aws_ebs_volume_backup"
+    timezone       = "America/Los_Angeles"
+    backup_window_tz {
+      start_time = "05:00"
+      end_time   = "07:00"
+    }
+    slas {
+      retention_duration {
+        unit  = "days"
+        value = 7
+      }
+      rpo_frequency {
+        unit  = "days"
+        value = 1
+      }
+    }
+    slas {
+      retention_duration {
+        unit  = "months"
+        value = 3
+      }
+      rpo_frequency {
+        unit    = "weeks"
+        value   = 1
+        offsets = [1]
+      }
+    }
+  }
+}
+`,
+		configV1: `resource "clumio_policy" "example" {
+  name              = "example-policy"
+  activation_status = "activated"
+  operations = [
+    {
+      action_setting = "window"
+      type           = "aws_ebs_volume_backup"
+      timezone       = "America/Los_Angeles"
+      backup_window_tz = {
+        start_time = "05:00"
+        end_time   = "07:00"
+      }
+      slas = [
+        {
+          retention_duration = {
+            unit  = "days"
+            value = 7
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 1
+          }
+        },
+        {
+          retention_duration = {
+            unit  = "months"
+            value = 3
+          }
+          rpo_frequency = {
+            unit    = "weeks"
+            value   = 1
+            offsets = [1]
+          }
+        },
+      ]
+    },
+  ]
+}
+`,
+		prior: testUpgradePolicyV0(&policyOperationModelV0{
+			ActionSetting: types.StringValue(actionSettingWindow),
+			OperationType: types.StringValue("aws_ebs_volume_backup"),
+			Timezone:      types.StringValue("America/Los_Angeles"),
+			BackupWindowTz: []*backupWindowModel{
+				{
+					StartTime: types.StringValue("05:00"),
+					EndTime:   types.StringValue("07:00"),
+				},
+			},
+			Slas: []*slaModelV0{
+				{
+					RetentionDuration: []*unitValueModel{testUnitValue("days", 7)},
+					RPOFrequency:      []*rpoModel{testRpo("days", 1)},
+				},
+				{
+					RetentionDuration: []*unitValueModel{testUnitValue("months", 3)},
+					RPOFrequency:      []*rpoModel{testRpo("weeks", 1, 1)},
+				},
+			},
+		}),
+		expected: testUpgradePolicy(&policyOperationModel{
+			ActionSetting: types.StringValue(actionSettingWindow),
+			OperationType: types.StringValue("aws_ebs_volume_backup"),
+			Timezone:      types.StringValue("America/Los_Angeles"),
+			BackupWindowTz: &backupWindowModel{
+				StartTime: types.StringValue("05:00"),
+				EndTime:   types.StringValue("07:00"),
+			},
+			Slas: []*slaModel{
+				{
+					RetentionDuration: testUnitValue("days", 7),
+					RPOFrequency:      testRpo("days", 1),
+				},
+				{
+					RetentionDuration: testUnitValue("months", 3),
+					RPOFrequency:      testRpo("weeks", 1, 1),
+				},
+			},
+		}),
+	},
+	{
+		title: "Advanced settings",
+		description: "The `advanced_settings` block and the blocks of every advanced setting" +
+			" become objects.",
+		configV0: `resource "clumio_policy" "example" {
+  name              = "example-policy"
+  activation_status = "activated"
+  operations {
+    action_setting = "immediate"
+    type           = "protection_group_backup"
+    slas {
+      retention_duration {
+        unit  = "months"
+        value = 3
+      }
+      rpo_frequency {
+        unit  = "days"
+        value = 1
+      }
+    }
+    advanced_settings {
+      protection_group_backup {
+        backup_tier = "cold"
+      }
+    }
+  }
+  operations {
+    action_setting = "immediate"
+    type           = "ec2_mssql_database_backup"
+    slas {
+      retention_duration {
+        unit  = "days"
+        value = 30
+      }
+      rpo_frequency {
+        unit  = "days"
+        value = 1
+      }
+    }
+    advanced_settings {
+      ec2_mssql_database_backup {
+        alternative_replica = "sync_secondary"
+        preferred_replica   = "primary"
+      }
+    }
+  }
+}
+`,
+		configV1: `resource "clumio_policy" "example" {
+  name              = "example-policy"
+  activation_status = "activated"
+  operations = [
+    {
+      action_setting = "immediate"
+      type           = "protection_group_backup"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "months"
+            value = 3
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 1
+          }
+        },
+      ]
+      advanced_settings = {
+        protection_group_backup = {
+          backup_tier = "cold"
+        }
+      }
+    },
+    {
+      action_setting = "immediate"
+      type           = "ec2_mssql_database_backup"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "days"
+            value = 30
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 1
+          }
+        },
+      ]
+      advanced_settings = {
+        ec2_mssql_database_backup = {
+          alternative_replica = "sync_secondary"
+          preferred_replica   = "primary"
+        }
+      }
+    },
+  ]
+}
+`,
+		prior: testUpgradePolicyV0(
+			&policyOperationModelV0{
+				ActionSetting: types.StringValue(actionSettingImmediate),
+				OperationType: types.StringValue("protection_group_backup"),
+				Slas: []*slaModelV0{
+					{
+						RetentionDuration: []*unitValueModel{testUnitValue("months", 3)},
+						RPOFrequency:      []*rpoModel{testRpo("days", 1)},
+					},
+				},
+				AdvancedSettings: []*advancedSettingsModelV0{
+					{
+						ProtectionGroupBackup: []*backupTierModel{
+							{BackupTier: types.StringValue("cold")},
+						},
+					},
+				},
+			},
+			&policyOperationModelV0{
+				ActionSetting: types.StringValue(actionSettingImmediate),
+				OperationType: types.StringValue("ec2_mssql_database_backup"),
+				Slas: []*slaModelV0{
+					{
+						RetentionDuration: []*unitValueModel{testUnitValue("days", 30)},
+						RPOFrequency:      []*rpoModel{testRpo("days", 1)},
+					},
+				},
+				AdvancedSettings: []*advancedSettingsModelV0{
+					{
+						EC2MssqlDatabaseBackup: []*replicaModel{
+							{
+								AlternativeReplica: types.StringValue("sync_secondary"),
+								PreferredReplica:   types.StringValue("primary"),
+							},
+						},
+					},
+				},
+			},
+		),
+		expected: testUpgradePolicy(
+			&policyOperationModel{
+				ActionSetting: types.StringValue(actionSettingImmediate),
+				OperationType: types.StringValue("protection_group_backup"),
+				Slas: []*slaModel{
+					{
+						RetentionDuration: testUnitValue("months", 3),
+						RPOFrequency:      testRpo("days", 1),
+					},
+				},
+				AdvancedSettings: &advancedSettingsModel{
+					ProtectionGroupBackup: &backupTierModel{
+						BackupTier: types.StringValue("cold"),
+					},
+				},
+			},
+			&policyOperationModel{
+				ActionSetting: types.StringValue(actionSettingImmediate),
+				OperationType: types.StringValue("ec2_mssql_database_backup"),
+				Slas: []*slaModel{
+					{
+						RetentionDuration: testUnitValue("days", 30),
+						RPOFrequency:      testRpo("days", 1),
+					},
+				},
+				AdvancedSettings: &advancedSettingsModel{
+					EC2MssqlDatabaseBackup: &replicaModel{
+						AlternativeReplica: types.StringValue("sync_secondary"),
+						PreferredReplica:   types.StringValue("primary"),
+					},
+				},
+			},
+		),
+	},
+	{
+		title: "Attributes outside of blocks",
+		description: "The attributes of the policy and of its operations which are not" +
+			" within a block, such as `backup_aws_region`, `deletion_protection` and" +
+			" `timeouts`, are unchanged.",
+		configV0: `resource "clumio_policy" "example" {
+  name                = "example-policy"
+  activation_status   = "activated"
+  deletion_protection = true
+  operations {
+    action_setting    = "immediate"
+    type              = "aws_dynamodb_table_backup"
+    backup_aws_region = "us-east-1"
+    slas {
+      retention_duration {
+        unit  = "days"
+        value = 30
+      }
+      rpo_frequency {
+        unit  = "days"
+        value = 1
+      }
+    }
+  }
+  timeouts {
+    create = "10m"
+  }
+}
+`,
+		configV1: `resource "clumio_policy" "example" {
+  name                = "example-policy"
+  activation_status   = "activated"
+  deletion_protection = true
+  operations = [
+    {
+      action_setting    = "immediate"
+      type              = "aws_dynamodb_table_backup"
+      backup_aws_region = "us-east-1"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "days"
+            value = 30
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 1
+          }
+        },
+      ]
+    },
+  ]
+  timeouts {
+    create = "10m"
+  }
+}
+`,
+		prior: func() policyResourceModelV0 {
+			policy := testUpgradePolicyV0(&policyOperationModelV0{
+				ActionSetting:   types.StringValue(actionSettingImmediate),
+				OperationType:   types.StringValue("aws_dynamodb_table_backup"),
+				BackupAwsRegion: types.StringValue("us-east-1"),
+				Slas: []*slaModelV0{
+					{
+						RetentionDuration: []*unitValueModel{testUnitValue("days", 30)},
+						RPOFrequency:      []*rpoModel{testRpo("days", 1)},
+					},
+				},
+			})
+			policy.DeletionProtection = types.BoolValue(true)
+			policy.Timeouts.Object = types.ObjectValueMust(
+				policy.Timeouts.AttributeTypes(context.Background()),
+				map[string]attr.Value{
+					"create": types.StringValue("10m"),
+					"read":   types.StringNull(),
+					"update": types.StringNull(),
+					"delete": types.StringNull(),
+				})
+			return policy
+		}(),
+		expected: func() policyResourceModel {
+			policy := testUpgradePolicy(&policyOperationModel{
+				ActionSetting:   types.StringValue(actionSettingImmediate),
+				OperationType:   types.StringValue("aws_dynamodb_table_backup"),
+				BackupAwsRegion: types.StringValue("us-east-1"),
+				Slas: []*slaModel{
+					{
+						RetentionDuration: testUnitValue("days", 30),
+						RPOFrequency:      testRpo("days", 1),
+					},
+				},
+			})
+			policy.DeletionProtection = types.BoolValue(true)
+			policy.Timeouts.Object = types.ObjectValueMust(
+				policy.Timeouts.AttributeTypes(context.Background()),
+				map[string]attr.Value{
+					"create": types.StringValue("10m"),
+					"read":   types.StringNull(),
+					"update": types.StringNull(),
+					"delete": types.StringNull(),
+				})
+			return policy
+		}(),
+	},
+}
+
+// Unit test for the following cases:
+//   - Upgrade the state of version 0 of the schema of every test case to the expected state.
+//   - Upgrade a state of version 0 with an empty set of operations.
+func TestUpgradePolicyStateV0(t *testing.T) {
+
+	ctx := context.Background()
+	res := &policyResource{}
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	upgrader, ok := res.UpgradeState(ctx)[0]
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), schemaResp.Schema.Version)
+
+	// upgrade upgrades the given state of version 0 and returns the upgraded state.
+	upgrade := func(t *testing.T, prior policyResourceModelV0) policyResourceModel {
+		priorState := tfsdk.State{Schema: *upgrader.PriorSchema}
+		diags := priorState.Set(ctx, &prior)
+		assert.False(t, diags.HasError(), diags)
+
+		req := resource.UpgradeStateRequest{State: &priorState}
+		resp := &resource.UpgradeStateResponse{
+			State: tfsdk.State{Schema: schemaResp.Schema},
+		}
+		upgrader.StateUpgrader(ctx, req, resp)
+		assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var upgraded policyResourceModel
+		diags = resp.State.Get(ctx, &upgraded)
+		assert.False(t, diags.HasError(), diags)
+		return upgraded
+	}
+
+	for _, tc := range stateUpgradeTestCases {
+		// Tests that the state of the test case is upgraded to the expected state.
+		t.Run(tc.title, func(t *testing.T) {
+			assert.Equal(t, tc.expected, upgrade(t, tc.prior))
+		})
+	}
+
+	// Tests that a state with an empty set of operations is upgraded to a state with an empty
+	// list of operations.
+	t.Run("Empty operations", func(t *testing.T) {
+		prior := testUpgradePolicyV0()
+		prior.Operations = []*policyOperationModelV0{}
+		expected := testUpgradePolicy()
+		expected.Operations = []*policyOperationModel{}
+		assert.Equal(t, expected, upgrade(t, prior))
+	})
+}
+
+// TestMigrationGuide checks that the section of the migration guide describing the upgrade from
+// version 0 of the schema matches the stateUpgradeTestCases. If the UPDATE_MIGRATION_GUIDE
+// environment variable is set, the section is updated instead.
+func TestMigrationGuide(t *testing.T) {
+
+	content, err := os.ReadFile(migrationGuidePath)
+	assert.NoError(t, err)
+	guide := string(content)
+	begin := strings.Index(guide, migrationGuideBegin)
+	end := strings.Index(guide, migrationGuideEnd)
+	if !assert.True(t, begin >= 0 && end > begin, "The markers of the generated section of"+
+		" the migration guide are missing.") {
+		return
+	}
+	begin += len(migrationGuideBegin)
+	section := renderMigrationGuide(stateUpgradeTestCases)
+
+	if os.Getenv("UPDATE_MIGRATION_GUIDE") != "" {
+		guide = guide[:begin] + section + guide[end:]
+		assert.NoError(t, os.WriteFile(migrationGuidePath, []byte(guide), 0644))
+		return
+	}
+	assert.Equal(t, section, guide[begin:end], "The migration guide is out of date. Run the unit"+
+		" tests with UPDATE_MIGRATION_GUIDE=1 to update it.")
+}
+
+// anchorRegexp matches the characters removed from a heading to build its anchor.
+var anchorRegexp = regexp.MustCompile(`[^a-z0-9 _-]`)
+
+// anchor returns the anchor of the given heading in the migration guide.
+func anchor(heading string) string {
+	return strings.ReplaceAll(anchorRegexp.ReplaceAllString(strings.ToLower(heading), ""), " ",
+		"-")
+}
+
+// renderMigrationGuide renders the section of the migration guide describing the upgrade of the
+// configs of the given test cases.
+func renderMigrationGuide(cases []stateUpgradeTestCase) string {
+
+	const immediateAction = "Immediate action for clumio_policy"
+	var b strings.Builder
+	b.WriteString("### Redesigned schema of resource clumio_policy\n")
+	fmt.Fprintf(&b, "- [Immediate action](#%s)\n", anchor(immediateAction))
+	for _, tc := range cases {
+		fmt.Fprintf(&b, "- [%s](#%s)\n", tc.title, anchor(tc.title))
+	}
+	fmt.Fprintf(&b, "\n#### %s\n", immediateAction)
+	b.WriteString("Starting with version 0.20.0, the `operations` and `slas` of `clumio_policy`" +
+		" are lists of objects, and `backup_window_tz`, `retention_duration`, `rpo_frequency`," +
+		" `advanced_settings` and every advanced setting are objects, instead of blocks.\n" +
+		"The state of existing policies is upgraded automatically the first time the updated" +
+		" provider runs, so neither an import nor a state edit is required. The config must" +
+		" however be changed to the attribute syntax, as shown in the examples below.\n" +
+		"Once the config is changed, the plan shows no changes, unless the operations or SLAs" +
+		" are listed in a different order than in the upgraded state. In that case the plan" +
+		" shows a one-time in-place update which reorders them without changing the behavior" +
+		" of the policy.\n")
+	for _, tc := range cases {
+		fmt.Fprintf(&b, "\n#### %s\n%s\n", tc.title, tc.description)
+		fmt.Fprintf(&b, "\nConfig in existing version:\n```hcl\n%s```\n", tc.configV0)
+		fmt.Fprintf(&b, "\nConfig in updated version:\n```hcl\n%s```\n", tc.configV1)
+	}
+	return b.String()
+}
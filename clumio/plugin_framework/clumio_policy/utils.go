@@ -5,8 +5,10 @@
 package clumio_policy
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
@@ -45,6 +47,7 @@ func readPolicyAndUpdateModel(ctx context.Context,
 	state.Name = types.StringPointerValue(res.Name)
 	state.ActivationStatus = types.StringPointerValue(res.ActivationStatus)
 	stateOp, diags := mapClumioOperationsToSchemaOperations(ctx, res.Operations)
+	orderOperationsLike(state.Operations, stateOp)
 	state.Operations = stateOp
 	return nil, diags
 }
@@ -62,8 +65,8 @@ func mapSchemaOperationsToClumioOperations(ctx context.Context,
 		var backupWindowTz *models.BackupWindow
 		if operation.BackupWindowTz != nil {
 			backupWindowTz = &models.BackupWindow{
-				EndTime:   operation.BackupWindowTz[0].EndTime.ValueStringPointer(),
-				StartTime: operation.BackupWindowTz[0].StartTime.ValueStringPointer(),
+				EndTime:   operation.BackupWindowTz.EndTime.ValueStringPointer(),
+				StartTime: operation.BackupWindowTz.StartTime.ValueStringPointer(),
 			}
 		}
 
@@ -77,16 +80,16 @@ func mapSchemaOperationsToClumioOperations(ctx context.Context,
 				backupSLA := &models.BackupSLA{}
 				if operationSla.RetentionDuration != nil {
 					backupSLA.RetentionDuration = &models.RetentionBackupSLAParam{
-						Unit:  operationSla.RetentionDuration[0].Unit.ValueStringPointer(),
-						Value: operationSla.RetentionDuration[0].Value.ValueInt64Pointer(),
+						Unit:  operationSla.RetentionDuration.Unit.ValueStringPointer(),
+						Value: operationSla.RetentionDuration.Value.ValueInt64Pointer(),
 					}
 				}
 				if operationSla.RPOFrequency != nil {
 					var offsets []*int64
-					diags = operationSla.RPOFrequency[0].Offsets.ElementsAs(ctx, &offsets, true)
+					diags = operationSla.RPOFrequency.Offsets.ElementsAs(ctx, &offsets, true)
 					backupSLA.RpoFrequency = &models.RPOBackupSLAParam{
-						Unit:    operationSla.RPOFrequency[0].Unit.ValueStringPointer(),
-						Value:   operationSla.RPOFrequency[0].Value.ValueInt64Pointer(),
+						Unit:    operationSla.RPOFrequency.Unit.ValueStringPointer(),
+						Value:   operationSla.RPOFrequency.Value.ValueInt64Pointer(),
 						Offsets: offsets,
 					}
 				}
@@ -129,7 +132,7 @@ func mapClumioOperationsToSchemaOperations(ctx context.Context,
 			window := &backupWindowModel{}
 			window.StartTime = types.StringPointerValue(operation.BackupWindowTz.StartTime)
 			window.EndTime = types.StringPointerValue(operation.BackupWindowTz.EndTime)
-			schemaOperation.BackupWindowTz = []*backupWindowModel{window}
+			schemaOperation.BackupWindowTz = window
 		}
 
 		if operation.Slas != nil {
@@ -163,23 +166,19 @@ func buildSchemaOperationBackupSlas(
 	for _, sla := range operation.Slas {
 		backupSla := &slaModel{}
 		if sla.RetentionDuration != nil {
-			backupSla.RetentionDuration = []*unitValueModel{
-				{
-					Unit:  types.StringPointerValue(sla.RetentionDuration.Unit),
-					Value: types.Int64PointerValue(sla.RetentionDuration.Value),
-				},
+			backupSla.RetentionDuration = &unitValueModel{
+				Unit:  types.StringPointerValue(sla.RetentionDuration.Unit),
+				Value: types.Int64PointerValue(sla.RetentionDuration.Value),
 			}
 		}
 		if sla.RpoFrequency != nil {
 			offsets, rpoDiags := types.ListValueFrom(ctx,
 				types.Int64Type, sla.RpoFrequency.Offsets)
 			diags = &rpoDiags
-			backupSla.RPOFrequency = []*rpoModel{
-				{
-					Unit:    types.StringPointerValue(sla.RpoFrequency.Unit),
-					Value:   types.Int64PointerValue(sla.RpoFrequency.Value),
-					Offsets: offsets,
-				},
+			backupSla.RPOFrequency = &rpoModel{
+				Unit:    types.StringPointerValue(sla.RpoFrequency.Unit),
+				Value:   types.Int64PointerValue(sla.RpoFrequency.Value),
+				Offsets: offsets,
 			}
 		}
 		backupSlas = append(backupSlas, backupSla)
@@ -194,103 +193,93 @@ func buildSchemaOperationAdvancedSettings(
 
 	advSettings := &advancedSettingsModel{}
 	if operation.AdvancedSettings.Ec2MssqlDatabaseBackup != nil {
-		advSettings.EC2MssqlDatabaseBackup = []*replicaModel{
-			{
-				AlternativeReplica: types.StringPointerValue(
-					operation.AdvancedSettings.Ec2MssqlDatabaseBackup.AlternativeReplica),
-				PreferredReplica: types.StringPointerValue(
-					operation.AdvancedSettings.Ec2MssqlDatabaseBackup.PreferredReplica),
-			},
+		advSettings.EC2MssqlDatabaseBackup = &replicaModel{
+			AlternativeReplica: types.StringPointerValue(
+				operation.AdvancedSettings.Ec2MssqlDatabaseBackup.AlternativeReplica),
+			PreferredReplica: types.StringPointerValue(
+				operation.AdvancedSettings.Ec2MssqlDatabaseBackup.PreferredReplica),
 		}
 	}
 	if operation.AdvancedSettings.Ec2MssqlLogBackup != nil {
-		advSettings.EC2MssqlLogBackup = []*replicaModel{
-			{
-				AlternativeReplica: types.StringPointerValue(
-					operation.AdvancedSettings.Ec2MssqlLogBackup.AlternativeReplica),
-				PreferredReplica: types.StringPointerValue(
-					operation.AdvancedSettings.Ec2MssqlLogBackup.PreferredReplica),
-			},
+		advSettings.EC2MssqlLogBackup = &replicaModel{
+			AlternativeReplica: types.StringPointerValue(
+				operation.AdvancedSettings.Ec2MssqlLogBackup.AlternativeReplica),
+			PreferredReplica: types.StringPointerValue(
+				operation.AdvancedSettings.Ec2MssqlLogBackup.PreferredReplica),
 		}
 	}
 	if operation.AdvancedSettings.MssqlDatabaseBackup != nil {
-		advSettings.MssqlDatabaseBackup = []*replicaModel{
-			{
-				AlternativeReplica: types.StringPointerValue(
-					operation.AdvancedSettings.MssqlDatabaseBackup.AlternativeReplica),
-				PreferredReplica: types.StringPointerValue(
-					operation.AdvancedSettings.MssqlDatabaseBackup.PreferredReplica),
-			},
+		advSettings.MssqlDatabaseBackup = &replicaModel{
+			AlternativeReplica: types.StringPointerValue(
+				operation.AdvancedSettings.MssqlDatabaseBackup.AlternativeReplica),
+			PreferredReplica: types.StringPointerValue(
+				operation.AdvancedSettings.MssqlDatabaseBackup.PreferredReplica),
 		}
 	}
 	if operation.AdvancedSettings.MssqlLogBackup != nil {
-		advSettings.MssqlLogBackup = []*replicaModel{
-			{
-				AlternativeReplica: types.StringPointerValue(
-					operation.AdvancedSettings.MssqlLogBackup.AlternativeReplica),
-				PreferredReplica: types.StringPointerValue(
-					operation.AdvancedSettings.MssqlLogBackup.PreferredReplica),
-			},
+		advSettings.MssqlLogBackup = &replicaModel{
+			AlternativeReplica: types.StringPointerValue(
+				operation.AdvancedSettings.MssqlLogBackup.AlternativeReplica),
+			PreferredReplica: types.StringPointerValue(
+				operation.AdvancedSettings.MssqlLogBackup.PreferredReplica),
 		}
 	}
 	if operation.AdvancedSettings.ProtectionGroupBackup != nil {
-		advSettings.ProtectionGroupBackup = []*backupTierModel{
-			{
-				BackupTier: types.StringPointerValue(
-					operation.AdvancedSettings.ProtectionGroupBackup.BackupTier),
-			},
+		advSettings.ProtectionGroupBackup = &backupTierModel{
+			BackupTier: types.StringPointerValue(
+				operation.AdvancedSettings.ProtectionGroupBackup.BackupTier),
 		}
 	}
 	if operation.AdvancedSettings.ProtectionGroupContinuousBackup != nil {
-		advSettings.S3ContinuousBackup = []*ContinuousConfigModel{
-			{
-				DisableEventbridgeNotification: types.BoolPointerValue(
-					operation.AdvancedSettings.ProtectionGroupContinuousBackup.
-						DisableEventbridgeNotification),
-			},
+		advSettings.S3ContinuousBackup = &ContinuousConfigModel{
+			DisableEventbridgeNotification: types.BoolPointerValue(
+				operation.AdvancedSettings.ProtectionGroupContinuousBackup.
+					DisableEventbridgeNotification),
 		}
 	}
 	if operation.AdvancedSettings.AwsEbsVolumeBackup != nil {
-		advSettings.EBSVolumeBackup = []*backupTierModel{
-			{
-				BackupTier: types.StringPointerValue(
-					operation.AdvancedSettings.AwsEbsVolumeBackup.BackupTier),
-			},
+		advSettings.EBSVolumeBackup = &backupTierModel{
+			BackupTier: types.StringPointerValue(
+				operation.AdvancedSettings.AwsEbsVolumeBackup.BackupTier),
 		}
 	}
 	if operation.AdvancedSettings.AwsEc2InstanceBackup != nil {
-		advSettings.EC2InstanceBackup = []*backupTierModel{
-			{
-				BackupTier: types.StringPointerValue(
-					operation.AdvancedSettings.AwsEc2InstanceBackup.BackupTier),
-			},
+		advSettings.EC2InstanceBackup = &backupTierModel{
+			BackupTier: types.StringPointerValue(
+				operation.AdvancedSettings.AwsEc2InstanceBackup.BackupTier),
 		}
 	}
 	if operation.AdvancedSettings.AwsRdsConfigSync != nil {
-		advSettings.RDSPitrConfigSync = []*pitrConfigModel{
-			{
-				Apply: types.StringPointerValue(
-					operation.AdvancedSettings.AwsRdsConfigSync.Apply),
-			},
+		advSettings.RDSPitrConfigSync = &pitrConfigModel{
+			Apply: types.StringPointerValue(
+				operation.AdvancedSettings.AwsRdsConfigSync.Apply),
 		}
 	}
 	if operation.AdvancedSettings.AwsRdsResourceGranularBackup != nil {
-		advSettings.RDSLogicalBackup = []*backupTierModel{
-			{
-				BackupTier: types.StringPointerValue(
-					operation.AdvancedSettings.AwsRdsResourceGranularBackup.BackupTier),
-			},
+		advSettings.RDSLogicalBackup = &backupTierModel{
+			BackupTier: types.StringPointerValue(
+				operation.AdvancedSettings.AwsRdsResourceGranularBackup.BackupTier),
 		}
 	}
 	if operation.AdvancedSettings.AwsIcebergTableBackup != nil {
-		advSettings.IcebergTableBackup = []*backupTierModel{
-			{
-				BackupTier: types.StringPointerValue(
-					operation.AdvancedSettings.AwsIcebergTableBackup.BackupTier),
-			},
+		advSettings.IcebergTableBackup = &backupTierModel{
+			BackupTier: types.StringPointerValue(
+				operation.AdvancedSettings.AwsIcebergTableBackup.BackupTier),
 		}
 	}
-	schemaOperation.AdvancedSettings = []*advancedSettingsModel{advSettings}
+	if operation.AdvancedSettings.AwsDynamodbTableBackup != nil {
+		advSettings.DynamoDBTableBackup = &backupTierModel{
+			BackupTier: types.StringPointerValue(
+				operation.AdvancedSettings.AwsDynamodbTableBackup.BackupTier),
+		}
+	}
+	if operation.AdvancedSettings.AwsS3Backtrack != nil {
+		advSettings.S3Backtrack = &ContinuousConfigModel{
+			DisableEventbridgeNotification: types.BoolPointerValue(
+				operation.AdvancedSettings.AwsS3Backtrack.DisableEventbridgeNotification),
+		}
+	}
+	schemaOperation.AdvancedSettings = advSettings
 }
 
 // getOperationAdvancedSettings returns the models.PolicyAdvancedSettings after parsing
@@ -300,89 +289,164 @@ func getOperationAdvancedSettings(
 	var advancedSettings *models.PolicyAdvancedSettings
 	if operation.AdvancedSettings != nil {
 		advancedSettings = &models.PolicyAdvancedSettings{}
-		if operation.AdvancedSettings[0].EBSVolumeBackup != nil {
+		if operation.AdvancedSettings.EBSVolumeBackup != nil {
 			advancedSettings.AwsEbsVolumeBackup = &models.EBSBackupAdvancedSetting{
-				BackupTier: operation.AdvancedSettings[0].EBSVolumeBackup[0].BackupTier.
+				BackupTier: operation.AdvancedSettings.EBSVolumeBackup.BackupTier.
 					ValueStringPointer(),
 			}
 		}
-		if operation.AdvancedSettings[0].EC2InstanceBackup != nil {
+		if operation.AdvancedSettings.EC2InstanceBackup != nil {
 			advancedSettings.AwsEc2InstanceBackup = &models.EC2BackupAdvancedSetting{
-				BackupTier: operation.AdvancedSettings[0].EC2InstanceBackup[0].
+				BackupTier: operation.AdvancedSettings.EC2InstanceBackup.
 					BackupTier.ValueStringPointer(),
 			}
 		}
-		if operation.AdvancedSettings[0].ProtectionGroupBackup != nil {
+		if operation.AdvancedSettings.ProtectionGroupBackup != nil {
 			advancedSettings.ProtectionGroupBackup =
 				&models.ProtectionGroupBackupAdvancedSetting{
-					BackupTier: operation.AdvancedSettings[0].ProtectionGroupBackup[0].
+					BackupTier: operation.AdvancedSettings.ProtectionGroupBackup.
 						BackupTier.ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].S3ContinuousBackup != nil {
+		if operation.AdvancedSettings.S3ContinuousBackup != nil {
 			advancedSettings.ProtectionGroupContinuousBackup =
 				&models.ProtectionGroupContinuousBackupAdvancedSetting{
-					DisableEventbridgeNotification: operation.AdvancedSettings[0].
-						S3ContinuousBackup[0].DisableEventbridgeNotification.ValueBoolPointer(),
+					DisableEventbridgeNotification: operation.AdvancedSettings.
+						S3ContinuousBackup.DisableEventbridgeNotification.ValueBoolPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].EC2MssqlDatabaseBackup != nil {
+		if operation.AdvancedSettings.EC2MssqlDatabaseBackup != nil {
 			advancedSettings.Ec2MssqlDatabaseBackup =
 				&models.EC2MSSQLDatabaseBackupAdvancedSetting{
-					AlternativeReplica: operation.AdvancedSettings[0].EC2MssqlDatabaseBackup[0].
+					AlternativeReplica: operation.AdvancedSettings.EC2MssqlDatabaseBackup.
 						AlternativeReplica.ValueStringPointer(),
-					PreferredReplica: operation.AdvancedSettings[0].EC2MssqlDatabaseBackup[0].
+					PreferredReplica: operation.AdvancedSettings.EC2MssqlDatabaseBackup.
 						PreferredReplica.ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].EC2MssqlLogBackup != nil {
+		if operation.AdvancedSettings.EC2MssqlLogBackup != nil {
 			advancedSettings.Ec2MssqlLogBackup =
 				&models.EC2MSSQLLogBackupAdvancedSetting{
-					AlternativeReplica: operation.AdvancedSettings[0].EC2MssqlLogBackup[0].
+					AlternativeReplica: operation.AdvancedSettings.EC2MssqlLogBackup.
 						AlternativeReplica.ValueStringPointer(),
-					PreferredReplica: operation.AdvancedSettings[0].EC2MssqlLogBackup[0].
+					PreferredReplica: operation.AdvancedSettings.EC2MssqlLogBackup.
 						PreferredReplica.ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].MssqlDatabaseBackup != nil {
+		if operation.AdvancedSettings.MssqlDatabaseBackup != nil {
 			advancedSettings.MssqlDatabaseBackup =
 				&models.MSSQLDatabaseBackupAdvancedSetting{
-					AlternativeReplica: operation.AdvancedSettings[0].MssqlDatabaseBackup[0].
+					AlternativeReplica: operation.AdvancedSettings.MssqlDatabaseBackup.
 						AlternativeReplica.ValueStringPointer(),
-					PreferredReplica: operation.AdvancedSettings[0].MssqlDatabaseBackup[0].
+					PreferredReplica: operation.AdvancedSettings.MssqlDatabaseBackup.
 						PreferredReplica.ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].MssqlLogBackup != nil {
+		if operation.AdvancedSettings.MssqlLogBackup != nil {
 			advancedSettings.MssqlLogBackup =
 				&models.MSSQLLogBackupAdvancedSetting{
-					AlternativeReplica: operation.AdvancedSettings[0].MssqlLogBackup[0].
+					AlternativeReplica: operation.AdvancedSettings.MssqlLogBackup.
 						AlternativeReplica.ValueStringPointer(),
-					PreferredReplica: operation.AdvancedSettings[0].MssqlLogBackup[0].
+					PreferredReplica: operation.AdvancedSettings.MssqlLogBackup.
 						PreferredReplica.ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].RDSPitrConfigSync != nil {
+		if operation.AdvancedSettings.RDSPitrConfigSync != nil {
 			advancedSettings.AwsRdsConfigSync =
 				&models.RDSConfigSyncAdvancedSetting{
-					Apply: operation.AdvancedSettings[0].RDSPitrConfigSync[0].Apply.
+					Apply: operation.AdvancedSettings.RDSPitrConfigSync.Apply.
 						ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].RDSLogicalBackup != nil {
+		if operation.AdvancedSettings.RDSLogicalBackup != nil {
 			advancedSettings.AwsRdsResourceGranularBackup =
 				&models.RDSLogicalBackupAdvancedSetting{
-					BackupTier: operation.AdvancedSettings[0].RDSLogicalBackup[0].
+					BackupTier: operation.AdvancedSettings.RDSLogicalBackup.
 						BackupTier.ValueStringPointer(),
 				}
 		}
-		if operation.AdvancedSettings[0].IcebergTableBackup != nil {
+		if operation.AdvancedSettings.IcebergTableBackup != nil {
 			advancedSettings.AwsIcebergTableBackup =
 				&models.IcebergBackupAdvancedSetting{
-					BackupTier: operation.AdvancedSettings[0].IcebergTableBackup[0].
+					BackupTier: operation.AdvancedSettings.IcebergTableBackup.
 						BackupTier.ValueStringPointer(),
 				}
 		}
+		if operation.AdvancedSettings.DynamoDBTableBackup != nil {
+			advancedSettings.AwsDynamodbTableBackup =
+				&models.DynamoDBBackupAdvancedSetting{
+					BackupTier: operation.AdvancedSettings.DynamoDBTableBackup.
+						BackupTier.ValueStringPointer(),
+				}
+		}
+		if operation.AdvancedSettings.S3Backtrack != nil {
+			advancedSettings.AwsS3Backtrack =
+				&models.S3BacktrackAdvancedSetting{
+					DisableEventbridgeNotification: operation.AdvancedSettings.
+						S3Backtrack.DisableEventbridgeNotification.ValueBoolPointer(),
+				}
+		}
 	}
 	return advancedSettings
 }
+
+// orderOperationsLike orders the given operations, and the SLAs of each of them, in the order of
+// the matching ones of the prior operations. As operations and SLAs are lists, this prevents a
+// diff when the Clumio API returns them in a different order than they are configured in.
+func orderOperationsLike(prior, operations []*policyOperationModel) {
+
+	priorSlas := make(map[string][]*slaModel, len(prior))
+	for _, operation := range prior {
+		if operation != nil {
+			priorSlas[operationKey(operation)] = operation.Slas
+		}
+	}
+	orderLike(prior, operations, operationKey)
+	for _, operation := range operations {
+		orderLike(priorSlas[operationKey(operation)], operation.Slas, slaKey)
+	}
+}
+
+// orderLike stably sorts the elements of current in the order of the elements of prior with the
+// same key. Elements without a match in prior are placed after the matching ones.
+func orderLike[T any](prior, current []*T, key func(*T) string) {
+
+	index := make(map[string]int, len(prior))
+	for i, element := range prior {
+		if element == nil {
+			continue
+		}
+		if _, ok := index[key(element)]; !ok {
+			index[key(element)] = i
+		}
+	}
+	position := func(element *T) int {
+		if i, ok := index[key(element)]; ok {
+			return i
+		}
+		return len(prior)
+	}
+	slices.SortStableFunc(current, func(a, b *T) int {
+		return cmp.Compare(position(a), position(b))
+	})
+}
+
+// operationKey returns the key identifying the given operation, which is unique within a policy.
+func operationKey(operation *policyOperationModel) string {
+	return operation.OperationType.ValueString() + "/" + operation.BackupAwsRegion.ValueString()
+}
+
+// slaKey returns the key identifying the given SLA within its operation.
+func slaKey(sla *slaModel) string {
+
+	var key string
+	if sla.RetentionDuration != nil {
+		key += sla.RetentionDuration.Unit.String() + sla.RetentionDuration.Value.String()
+	}
+	key += "/"
+	if sla.RPOFrequency != nil {
+		key += sla.RPOFrequency.Unit.String() + sla.RPOFrequency.Value.String() +
+			sla.RPOFrequency.Offsets.String()
+	}
+	return key
+}
@@ -29,6 +29,8 @@ var (
 	operationType9                 = "protection_group_backup"
 	operationType10                = "aws_s3_continuous_backup"
 	operationType11                = "aws_iceberg_table_backup"
+	operationType12                = "aws_dynamodb_table_backup"
+	operationType13                = "aws_s3_backtrack"
 	retUnit                        = "days"
 	retValue                       = int64(5)
 	retUnit2                       = "hours"
@@ -99,37 +101,37 @@ func TestMapClumioOperationsToSchemaOperations(t *testing.T) {
 	assert.Equal(t, *modelOp.ActionSetting, schemaOp.ActionSetting.ValueString())
 	assert.Equal(t, *modelOp.BackupAwsRegion, schemaOp.BackupAwsRegion.ValueString())
 	assert.Equal(t, *modelOp.BackupWindowTz.StartTime,
-		schemaOp.BackupWindowTz[0].StartTime.ValueString())
+		schemaOp.BackupWindowTz.StartTime.ValueString())
 	assert.Equal(t, *modelOp.BackupWindowTz.EndTime,
-		schemaOp.BackupWindowTz[0].EndTime.ValueString())
+		schemaOp.BackupWindowTz.EndTime.ValueString())
 	assert.Equal(t, *modelOp.ClumioType, schemaOp.OperationType.ValueString())
 
 	// Ensure the first SLA's attributes are correct.
 	modelSla := *modelOperations[0].Slas[0]
 	schemaSla := schemaOperations[0].Slas[0]
 	assert.Equal(t, *modelSla.RetentionDuration.Unit,
-		schemaSla.RetentionDuration[0].Unit.ValueString())
+		schemaSla.RetentionDuration.Unit.ValueString())
 	assert.Equal(t, *modelSla.RetentionDuration.Value,
-		schemaSla.RetentionDuration[0].Value.ValueInt64())
-	assert.Equal(t, *modelSla.RpoFrequency.Unit, schemaSla.RPOFrequency[0].Unit.ValueString())
-	assert.Equal(t, *modelSla.RpoFrequency.Value, schemaSla.RPOFrequency[0].Value.ValueInt64())
+		schemaSla.RetentionDuration.Value.ValueInt64())
+	assert.Equal(t, *modelSla.RpoFrequency.Unit, schemaSla.RPOFrequency.Unit.ValueString())
+	assert.Equal(t, *modelSla.RpoFrequency.Value, schemaSla.RPOFrequency.Value.ValueInt64())
 	var offsets []*int64
-	diags = schemaSla.RPOFrequency[0].Offsets.ElementsAs(ctx, &offsets, true)
+	diags = schemaSla.RPOFrequency.Offsets.ElementsAs(ctx, &offsets, true)
 	assert.Nil(t, diags)
 
 	// Ensure the second SLA's attributes are correct.
 	modelSla = *modelOperations[0].Slas[1]
 	schemaSla = schemaOperations[0].Slas[1]
 	assert.Equal(t, *modelSla.RetentionDuration.Unit,
-		schemaSla.RetentionDuration[0].Unit.ValueString())
+		schemaSla.RetentionDuration.Unit.ValueString())
 	assert.Equal(t, *modelSla.RetentionDuration.Value,
-		schemaSla.RetentionDuration[0].Value.ValueInt64())
+		schemaSla.RetentionDuration.Value.ValueInt64())
 	assert.Equal(t, *modelSla.RpoFrequency.Unit,
-		schemaSla.RPOFrequency[0].Unit.ValueString())
+		schemaSla.RPOFrequency.Unit.ValueString())
 	assert.Equal(t, *modelSla.RpoFrequency.Value,
-		schemaSla.RPOFrequency[0].Value.ValueInt64())
+		schemaSla.RPOFrequency.Value.ValueInt64())
 	var offsets2 []*int64
-	diags = schemaSla.RPOFrequency[0].Offsets.ElementsAs(ctx, &offsets2, true)
+	diags = schemaSla.RPOFrequency.Offsets.ElementsAs(ctx, &offsets2, true)
 	assert.Nil(t, diags)
 	assert.Equal(t, *modelSla.RpoFrequency.Offsets[0], *offsets2[0])
 }
@@ -171,9 +173,9 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.AwsEbsVolumeBackup.BackupTier,
-			schemaOpAdvSettings.EBSVolumeBackup[0].BackupTier.ValueString())
+			schemaOpAdvSettings.EBSVolumeBackup.BackupTier.ValueString())
 	})
 	t.Run("Test EC2 Instance Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -185,9 +187,9 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.AwsEc2InstanceBackup.BackupTier,
-			schemaOpAdvSettings.EC2InstanceBackup[0].BackupTier.ValueString())
+			schemaOpAdvSettings.EC2InstanceBackup.BackupTier.ValueString())
 	})
 	t.Run("Test RDS Config Sync Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -199,9 +201,9 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.AwsRdsConfigSync.Apply,
-			schemaOpAdvSettings.RDSPitrConfigSync[0].Apply.ValueString())
+			schemaOpAdvSettings.RDSPitrConfigSync.Apply.ValueString())
 	})
 	t.Run("Test RDS Granular Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -213,9 +215,9 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.AwsRdsResourceGranularBackup.BackupTier,
-			schemaOpAdvSettings.RDSLogicalBackup[0].BackupTier.ValueString())
+			schemaOpAdvSettings.RDSLogicalBackup.BackupTier.ValueString())
 	})
 	t.Run("Test EC2 MSSQL Database Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -228,11 +230,11 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.Ec2MssqlDatabaseBackup.PreferredReplica,
-			schemaOpAdvSettings.EC2MssqlDatabaseBackup[0].PreferredReplica.ValueString())
+			schemaOpAdvSettings.EC2MssqlDatabaseBackup.PreferredReplica.ValueString())
 		assert.Equal(t, *modelOpAdvSettings.Ec2MssqlDatabaseBackup.AlternativeReplica,
-			schemaOpAdvSettings.EC2MssqlDatabaseBackup[0].AlternativeReplica.ValueString())
+			schemaOpAdvSettings.EC2MssqlDatabaseBackup.AlternativeReplica.ValueString())
 	})
 	t.Run("Test EC2 MSSQL Log Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -245,11 +247,11 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.Ec2MssqlLogBackup.PreferredReplica,
-			schemaOpAdvSettings.EC2MssqlLogBackup[0].PreferredReplica.ValueString())
+			schemaOpAdvSettings.EC2MssqlLogBackup.PreferredReplica.ValueString())
 		assert.Equal(t, *modelOpAdvSettings.Ec2MssqlLogBackup.AlternativeReplica,
-			schemaOpAdvSettings.EC2MssqlLogBackup[0].AlternativeReplica.ValueString())
+			schemaOpAdvSettings.EC2MssqlLogBackup.AlternativeReplica.ValueString())
 	})
 	t.Run("Test Mssql Database Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -262,11 +264,11 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.MssqlDatabaseBackup.PreferredReplica,
-			schemaOpAdvSettings.MssqlDatabaseBackup[0].PreferredReplica.ValueString())
+			schemaOpAdvSettings.MssqlDatabaseBackup.PreferredReplica.ValueString())
 		assert.Equal(t, *modelOpAdvSettings.MssqlDatabaseBackup.AlternativeReplica,
-			schemaOpAdvSettings.MssqlDatabaseBackup[0].AlternativeReplica.ValueString())
+			schemaOpAdvSettings.MssqlDatabaseBackup.AlternativeReplica.ValueString())
 	})
 	t.Run("Test Mssql Log Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -279,11 +281,11 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.MssqlLogBackup.PreferredReplica,
-			schemaOpAdvSettings.MssqlLogBackup[0].PreferredReplica.ValueString())
+			schemaOpAdvSettings.MssqlLogBackup.PreferredReplica.ValueString())
 		assert.Equal(t, *modelOpAdvSettings.MssqlLogBackup.AlternativeReplica,
-			schemaOpAdvSettings.MssqlLogBackup[0].AlternativeReplica.ValueString())
+			schemaOpAdvSettings.MssqlLogBackup.AlternativeReplica.ValueString())
 	})
 	t.Run("Test Protection Group Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -295,9 +297,9 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.ProtectionGroupBackup.BackupTier,
-			schemaOpAdvSettings.ProtectionGroupBackup[0].BackupTier.ValueString())
+			schemaOpAdvSettings.ProtectionGroupBackup.BackupTier.ValueString())
 	})
 	t.Run("Test S3 Continuous Backup Advanced Setting", func(t *testing.T) {
 		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
@@ -309,9 +311,9 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.ProtectionGroupContinuousBackup.DisableEventbridgeNotification,
-			schemaOpAdvSettings.S3ContinuousBackup[0].DisableEventbridgeNotification.ValueBool())
+			schemaOpAdvSettings.S3ContinuousBackup.DisableEventbridgeNotification.ValueBool())
 	})
 
 	t.Run("Test Iceberg Advanced Setting", func(t *testing.T) {
@@ -324,9 +326,39 @@ func TestMapClumioOperationsToSchemaOperationsAdvSettings(t *testing.T) {
 		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
 		assert.Equal(t, *modelOpAdvSettings.AwsIcebergTableBackup.BackupTier,
-			schemaOpAdvSettings.IcebergTableBackup[0].BackupTier.ValueString())
+			schemaOpAdvSettings.IcebergTableBackup.BackupTier.ValueString())
+	})
+
+	t.Run("Test DynamoDB Advanced Setting", func(t *testing.T) {
+		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
+			AwsDynamodbTableBackup: &models.DynamoDBBackupAdvancedSetting{
+				BackupTier: &backupTier,
+			},
+		}
+		modelOperations[0].ClumioType = &operationType12
+		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
+		assert.Nil(t, diags)
+		modelOpAdvSettings := modelOperations[0].AdvancedSettings
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, *modelOpAdvSettings.AwsDynamodbTableBackup.BackupTier,
+			schemaOpAdvSettings.DynamoDBTableBackup.BackupTier.ValueString())
+	})
+
+	t.Run("Test S3 Backtrack Advanced Setting", func(t *testing.T) {
+		modelOperations[0].AdvancedSettings = &models.PolicyAdvancedSettings{
+			AwsS3Backtrack: &models.S3BacktrackAdvancedSetting{
+				DisableEventbridgeNotification: &disableEventbridgeNotification,
+			},
+		}
+		modelOperations[0].ClumioType = &operationType13
+		schemaOperations, diags := mapClumioOperationsToSchemaOperations(ctx, modelOperations)
+		assert.Nil(t, diags)
+		modelOpAdvSettings := modelOperations[0].AdvancedSettings
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, *modelOpAdvSettings.AwsS3Backtrack.DisableEventbridgeNotification,
+			schemaOpAdvSettings.S3Backtrack.DisableEventbridgeNotification.ValueBool())
 	})
 }
 
@@ -341,41 +373,31 @@ func TestMapSchemaOperationsToClumioOperations(t *testing.T) {
 		{
 			ActionSetting: basetypes.NewStringValue(actionSetting),
 			OperationType: basetypes.NewStringValue(operationType),
-			BackupWindowTz: []*backupWindowModel{
-				{
-					StartTime: basetypes.NewStringValue(startTime),
-					EndTime:   basetypes.NewStringValue(endTime),
-				},
+			BackupWindowTz: &backupWindowModel{
+				StartTime: basetypes.NewStringValue(startTime),
+				EndTime:   basetypes.NewStringValue(endTime),
 			},
 			Slas: []*slaModel{
 				{
-					RetentionDuration: []*unitValueModel{
-						{
-							Unit:  basetypes.NewStringValue(retUnit),
-							Value: basetypes.NewInt64Value(retValue),
-						},
+					RetentionDuration: &unitValueModel{
+						Unit:  basetypes.NewStringValue(retUnit),
+						Value: basetypes.NewInt64Value(retValue),
 					},
-					RPOFrequency: []*rpoModel{
-						{
-							Unit:    basetypes.NewStringValue(rpoUnit),
-							Value:   basetypes.NewInt64Value(rpoValue),
-							Offsets: offsets,
-						},
+					RPOFrequency: &rpoModel{
+						Unit:    basetypes.NewStringValue(rpoUnit),
+						Value:   basetypes.NewInt64Value(rpoValue),
+						Offsets: offsets,
 					},
 				},
 				{
-					RetentionDuration: []*unitValueModel{
-						{
-							Unit:  basetypes.NewStringValue(retUnit2),
-							Value: basetypes.NewInt64Value(retValue2),
-						},
+					RetentionDuration: &unitValueModel{
+						Unit:  basetypes.NewStringValue(retUnit2),
+						Value: basetypes.NewInt64Value(retValue2),
 					},
-					RPOFrequency: []*rpoModel{
-						{
-							Unit:    basetypes.NewStringValue(rpoUnit2),
-							Value:   basetypes.NewInt64Value(rpoValue2),
-							Offsets: offsets,
-						},
+					RPOFrequency: &rpoModel{
+						Unit:    basetypes.NewStringValue(rpoUnit2),
+						Value:   basetypes.NewInt64Value(rpoValue2),
+						Offsets: offsets,
 					},
 				},
 			},
@@ -391,31 +413,31 @@ func TestMapSchemaOperationsToClumioOperations(t *testing.T) {
 	assert.Equal(t, schemaOp.ActionSetting.ValueString(), *modelOp.ActionSetting)
 	assert.Equal(t, schemaOp.OperationType.ValueString(), *modelOp.ClumioType)
 	assert.Equal(t, schemaOp.BackupAwsRegion.ValueString(), *modelOp.BackupAwsRegion)
-	assert.Equal(t, schemaOp.BackupWindowTz[0].StartTime.ValueString(),
+	assert.Equal(t, schemaOp.BackupWindowTz.StartTime.ValueString(),
 		*modelOp.BackupWindowTz.StartTime)
-	assert.Equal(t, schemaOp.BackupWindowTz[0].EndTime.ValueString(),
+	assert.Equal(t, schemaOp.BackupWindowTz.EndTime.ValueString(),
 		*modelOp.BackupWindowTz.EndTime)
 
 	// Ensure the first SLA's attributes are correct.
 	modelSla := modelOp.Slas[0]
 	schemaSla := schemaOp.Slas[0]
-	assert.Equal(t, schemaSla.RetentionDuration[0].Unit.ValueString(),
+	assert.Equal(t, schemaSla.RetentionDuration.Unit.ValueString(),
 		*modelSla.RetentionDuration.Unit)
-	assert.Equal(t, schemaSla.RetentionDuration[0].Value.ValueInt64(),
+	assert.Equal(t, schemaSla.RetentionDuration.Value.ValueInt64(),
 		*modelSla.RetentionDuration.Value)
-	assert.Equal(t, schemaSla.RPOFrequency[0].Unit.ValueString(), *modelSla.RpoFrequency.Unit)
-	assert.Equal(t, schemaSla.RPOFrequency[0].Value.ValueInt64(), *modelSla.RpoFrequency.Value)
+	assert.Equal(t, schemaSla.RPOFrequency.Unit.ValueString(), *modelSla.RpoFrequency.Unit)
+	assert.Equal(t, schemaSla.RPOFrequency.Value.ValueInt64(), *modelSla.RpoFrequency.Value)
 	assert.Equal(t, offset, *modelSla.RpoFrequency.Offsets[0])
 
 	// Ensure the second SLA's attributes are correct.
 	modelSla = modelOp.Slas[1]
 	schemaSla = schemaOp.Slas[1]
-	assert.Equal(t, schemaSla.RetentionDuration[0].Unit.ValueString(),
+	assert.Equal(t, schemaSla.RetentionDuration.Unit.ValueString(),
 		*modelSla.RetentionDuration.Unit)
-	assert.Equal(t, schemaSla.RetentionDuration[0].Value.ValueInt64(),
+	assert.Equal(t, schemaSla.RetentionDuration.Value.ValueInt64(),
 		*modelSla.RetentionDuration.Value)
-	assert.Equal(t, schemaSla.RPOFrequency[0].Unit.ValueString(), *modelSla.RpoFrequency.Unit)
-	assert.Equal(t, schemaSla.RPOFrequency[0].Value.ValueInt64(), *modelSla.RpoFrequency.Value)
+	assert.Equal(t, schemaSla.RPOFrequency.Unit.ValueString(), *modelSla.RpoFrequency.Unit)
+	assert.Equal(t, schemaSla.RPOFrequency.Value.ValueInt64(), *modelSla.RpoFrequency.Value)
 	assert.Equal(t, offset, *modelSla.RpoFrequency.Offsets[0])
 }
 
@@ -431,51 +453,37 @@ func TestMapSchemaOperationsToClumioOperationsAdvSettings(t *testing.T) {
 		{
 			ActionSetting: basetypes.NewStringValue(actionSetting),
 			OperationType: basetypes.NewStringValue(operationType),
-			BackupWindowTz: []*backupWindowModel{
-				{
-					StartTime: basetypes.NewStringValue(startTime),
-					EndTime:   basetypes.NewStringValue(endTime),
-				},
+			BackupWindowTz: &backupWindowModel{
+				StartTime: basetypes.NewStringValue(startTime),
+				EndTime:   basetypes.NewStringValue(endTime),
 			},
 			Slas: []*slaModel{
 				{
-					RetentionDuration: []*unitValueModel{
-						{
-							Unit:  basetypes.NewStringValue(retUnit),
-							Value: basetypes.NewInt64Value(retValue),
-						},
+					RetentionDuration: &unitValueModel{
+						Unit:  basetypes.NewStringValue(retUnit),
+						Value: basetypes.NewInt64Value(retValue),
 					},
-					RPOFrequency: []*rpoModel{
-						{
-							Unit:    basetypes.NewStringValue(rpoUnit),
-							Value:   basetypes.NewInt64Value(rpoValue),
-							Offsets: offsets,
-						},
+					RPOFrequency: &rpoModel{
+						Unit:    basetypes.NewStringValue(rpoUnit),
+						Value:   basetypes.NewInt64Value(rpoValue),
+						Offsets: offsets,
 					},
 				},
 				{
-					RetentionDuration: []*unitValueModel{
-						{
-							Unit:  basetypes.NewStringValue(retUnit2),
-							Value: basetypes.NewInt64Value(retValue2),
-						},
+					RetentionDuration: &unitValueModel{
+						Unit:  basetypes.NewStringValue(retUnit2),
+						Value: basetypes.NewInt64Value(retValue2),
 					},
-					RPOFrequency: []*rpoModel{
-						{
-							Unit:    basetypes.NewStringValue(rpoUnit2),
-							Value:   basetypes.NewInt64Value(rpoValue2),
-							Offsets: offsets,
-						},
+					RPOFrequency: &rpoModel{
+						Unit:    basetypes.NewStringValue(rpoUnit2),
+						Value:   basetypes.NewInt64Value(rpoValue2),
+						Offsets: offsets,
 					},
 				},
 			},
-			AdvancedSettings: []*advancedSettingsModel{
-				{
-					EBSVolumeBackup: []*backupTierModel{
-						{
-							BackupTier: basetypes.NewStringValue(backupTier),
-						},
-					},
+			AdvancedSettings: &advancedSettingsModel{
+				EBSVolumeBackup: &backupTierModel{
+					BackupTier: basetypes.NewStringValue(backupTier),
 				},
 			},
 			BackupAwsRegion: basetypes.NewStringValue(backupRegion),
@@ -483,224 +491,279 @@ func TestMapSchemaOperationsToClumioOperationsAdvSettings(t *testing.T) {
 	}
 
 	t.Run("Test EBS Volume Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				EBSVolumeBackup: []*backupTierModel{
-					{
-						BackupTier: basetypes.NewStringValue(backupTier),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			EBSVolumeBackup: &backupTierModel{
+				BackupTier: basetypes.NewStringValue(backupTier),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.EBSVolumeBackup[0].BackupTier.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.EBSVolumeBackup.BackupTier.ValueString(),
 			*modelOpAdvSettings.AwsEbsVolumeBackup.BackupTier)
 	})
 
 	t.Run("Test EC2 Instance Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				EC2InstanceBackup: []*backupTierModel{
-					{
-						BackupTier: basetypes.NewStringValue(backupTier),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			EC2InstanceBackup: &backupTierModel{
+				BackupTier: basetypes.NewStringValue(backupTier),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType2)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.EC2InstanceBackup[0].BackupTier.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.EC2InstanceBackup.BackupTier.ValueString(),
 			*modelOpAdvSettings.AwsEc2InstanceBackup.BackupTier)
 	})
 
 	t.Run("Test RDS Config Sync Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				RDSPitrConfigSync: []*pitrConfigModel{
-					{
-						Apply: basetypes.NewStringValue(rdsConfigSyncApply),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			RDSPitrConfigSync: &pitrConfigModel{
+				Apply: basetypes.NewStringValue(rdsConfigSyncApply),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType3)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.RDSPitrConfigSync[0].Apply.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.RDSPitrConfigSync.Apply.ValueString(),
 			*modelOpAdvSettings.AwsRdsConfigSync.Apply)
 	})
 
 	t.Run("Test RDS Granular Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				RDSLogicalBackup: []*backupTierModel{
-					{
-						BackupTier: basetypes.NewStringValue(backupTier),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			RDSLogicalBackup: &backupTierModel{
+				BackupTier: basetypes.NewStringValue(backupTier),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType4)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.RDSLogicalBackup[0].BackupTier.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.RDSLogicalBackup.BackupTier.ValueString(),
 			*modelOpAdvSettings.AwsRdsResourceGranularBackup.BackupTier)
 	})
 
 	t.Run("Test EC2 MSSQL Database Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				EC2MssqlDatabaseBackup: []*replicaModel{
-					{
-						PreferredReplica:   basetypes.NewStringValue(preferredReplica),
-						AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			EC2MssqlDatabaseBackup: &replicaModel{
+				PreferredReplica:   basetypes.NewStringValue(preferredReplica),
+				AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType5)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.EC2MssqlDatabaseBackup[0].PreferredReplica.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.EC2MssqlDatabaseBackup.PreferredReplica.ValueString(),
 			*modelOpAdvSettings.Ec2MssqlDatabaseBackup.PreferredReplica)
-		assert.Equal(t, schemaOpAdvSettings.EC2MssqlDatabaseBackup[0].AlternativeReplica.ValueString(),
+		assert.Equal(t, schemaOpAdvSettings.EC2MssqlDatabaseBackup.AlternativeReplica.ValueString(),
 			*modelOpAdvSettings.Ec2MssqlDatabaseBackup.AlternativeReplica)
 	})
 
 	t.Run("Test EC2 MSSQL Log Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				EC2MssqlLogBackup: []*replicaModel{
-					{
-						PreferredReplica:   basetypes.NewStringValue(preferredReplica),
-						AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			EC2MssqlLogBackup: &replicaModel{
+				PreferredReplica:   basetypes.NewStringValue(preferredReplica),
+				AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType6)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.EC2MssqlLogBackup[0].PreferredReplica.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.EC2MssqlLogBackup.PreferredReplica.ValueString(),
 			*modelOpAdvSettings.Ec2MssqlLogBackup.PreferredReplica)
-		assert.Equal(t, schemaOpAdvSettings.EC2MssqlLogBackup[0].AlternativeReplica.ValueString(),
+		assert.Equal(t, schemaOpAdvSettings.EC2MssqlLogBackup.AlternativeReplica.ValueString(),
 			*modelOpAdvSettings.Ec2MssqlLogBackup.AlternativeReplica)
 	})
 
 	t.Run("Test MSSQL Database Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				MssqlDatabaseBackup: []*replicaModel{
-					{
-						PreferredReplica:   basetypes.NewStringValue(preferredReplica),
-						AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			MssqlDatabaseBackup: &replicaModel{
+				PreferredReplica:   basetypes.NewStringValue(preferredReplica),
+				AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType7)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.MssqlDatabaseBackup[0].PreferredReplica.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.MssqlDatabaseBackup.PreferredReplica.ValueString(),
 			*modelOpAdvSettings.MssqlDatabaseBackup.PreferredReplica)
-		assert.Equal(t, schemaOpAdvSettings.MssqlDatabaseBackup[0].AlternativeReplica.ValueString(),
+		assert.Equal(t, schemaOpAdvSettings.MssqlDatabaseBackup.AlternativeReplica.ValueString(),
 			*modelOpAdvSettings.MssqlDatabaseBackup.AlternativeReplica)
 	})
 
 	t.Run("Test MSSQL Log Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				MssqlLogBackup: []*replicaModel{
-					{
-						PreferredReplica:   basetypes.NewStringValue(preferredReplica),
-						AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			MssqlLogBackup: &replicaModel{
+				PreferredReplica:   basetypes.NewStringValue(preferredReplica),
+				AlternativeReplica: basetypes.NewStringValue(alternativeReplica),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType8)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.MssqlLogBackup[0].PreferredReplica.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.MssqlLogBackup.PreferredReplica.ValueString(),
 			*modelOpAdvSettings.MssqlLogBackup.PreferredReplica)
-		assert.Equal(t, schemaOpAdvSettings.MssqlLogBackup[0].AlternativeReplica.ValueString(),
+		assert.Equal(t, schemaOpAdvSettings.MssqlLogBackup.AlternativeReplica.ValueString(),
 			*modelOpAdvSettings.MssqlLogBackup.AlternativeReplica)
 	})
 
 	t.Run("Test Protection Group Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				ProtectionGroupBackup: []*backupTierModel{
-					{
-						BackupTier: basetypes.NewStringValue(backupTier),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			ProtectionGroupBackup: &backupTierModel{
+				BackupTier: basetypes.NewStringValue(backupTier),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType9)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.ProtectionGroupBackup[0].BackupTier.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.ProtectionGroupBackup.BackupTier.ValueString(),
 			*modelOpAdvSettings.ProtectionGroupBackup.BackupTier)
 	})
 
 	t.Run("Test S3 Continuous Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				S3ContinuousBackup: []*ContinuousConfigModel{
-					{
-						DisableEventbridgeNotification: basetypes.NewBoolValue(
-							disableEventbridgeNotification),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			S3ContinuousBackup: &ContinuousConfigModel{
+				DisableEventbridgeNotification: basetypes.NewBoolValue(
+					disableEventbridgeNotification),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType10)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.S3ContinuousBackup[0].DisableEventbridgeNotification.ValueBool(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.S3ContinuousBackup.DisableEventbridgeNotification.ValueBool(),
 			*modelOpAdvSettings.ProtectionGroupContinuousBackup.DisableEventbridgeNotification)
 	})
 
 	t.Run("Test Iceberg Backup Advanced Setting", func(t *testing.T) {
-		schemaOperations[0].AdvancedSettings = []*advancedSettingsModel{
-			{
-				IcebergTableBackup: []*backupTierModel{
-					{
-						BackupTier: basetypes.NewStringValue(backupTier),
-					},
-				},
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			IcebergTableBackup: &backupTierModel{
+				BackupTier: basetypes.NewStringValue(backupTier),
 			},
 		}
 		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType10)
 		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
 		assert.Nil(t, diags)
 		modelOpAdvSettings := modelOperations[0].AdvancedSettings
-		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings[0]
-		assert.Equal(t, schemaOpAdvSettings.IcebergTableBackup[0].BackupTier.ValueString(),
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.IcebergTableBackup.BackupTier.ValueString(),
 			*modelOpAdvSettings.AwsIcebergTableBackup.BackupTier)
 	})
+
+	t.Run("Test DynamoDB Backup Advanced Setting", func(t *testing.T) {
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			DynamoDBTableBackup: &backupTierModel{
+				BackupTier: basetypes.NewStringValue(backupTier),
+			},
+		}
+		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType12)
+		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
+		assert.Nil(t, diags)
+		modelOpAdvSettings := modelOperations[0].AdvancedSettings
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.DynamoDBTableBackup.BackupTier.ValueString(),
+			*modelOpAdvSettings.AwsDynamodbTableBackup.BackupTier)
+	})
+
+	t.Run("Test S3 Backtrack Advanced Setting", func(t *testing.T) {
+		schemaOperations[0].AdvancedSettings = &advancedSettingsModel{
+			S3Backtrack: &ContinuousConfigModel{
+				DisableEventbridgeNotification: basetypes.NewBoolValue(
+					disableEventbridgeNotification),
+			},
+		}
+		schemaOperations[0].OperationType = basetypes.NewStringValue(operationType13)
+		modelOperations, diags := mapSchemaOperationsToClumioOperations(ctx, schemaOperations)
+		assert.Nil(t, diags)
+		modelOpAdvSettings := modelOperations[0].AdvancedSettings
+		schemaOpAdvSettings := schemaOperations[0].AdvancedSettings
+		assert.Equal(t, schemaOpAdvSettings.S3Backtrack.DisableEventbridgeNotification.ValueBool(),
+			*modelOpAdvSettings.AwsS3Backtrack.DisableEventbridgeNotification)
+	})
+}
+
+// Unit test for the following cases:
+//   - Operations and SLAs are ordered like the matching prior ones.
+//   - Operations without a matching prior one are placed after the matching ones.
+func TestOrderOperationsLike(t *testing.T) {
+
+	// testOrderOperation returns an operation of the given type and region with an SLA for every
+	// given retention value.
+	testOrderOperation := func(
+		operationType string, region string, retentionValues ...int64) *policyOperationModel {
+		operation := &policyOperationModel{
+			OperationType:   basetypes.NewStringValue(operationType),
+			BackupAwsRegion: basetypes.NewStringNull(),
+		}
+		if region != "" {
+			operation.BackupAwsRegion = basetypes.NewStringValue(region)
+		}
+		for _, value := range retentionValues {
+			operation.Slas = append(operation.Slas, &slaModel{
+				RetentionDuration: &unitValueModel{
+					Unit:  basetypes.NewStringValue(retUnit),
+					Value: basetypes.NewInt64Value(value),
+				},
+				RPOFrequency: &rpoModel{
+					Unit:    basetypes.NewStringValue(rpoUnit),
+					Value:   basetypes.NewInt64Value(rpoValue),
+					Offsets: basetypes.NewListNull(types.Int64Type),
+				},
+			})
+		}
+		return operation
+	}
+
+	// Tests that the operations and SLAs returned by the API are ordered like the prior ones.
+	t.Run("Order like prior operations", func(t *testing.T) {
+		prior := []*policyOperationModel{
+			testOrderOperation(operationType, "", 7, 30),
+			testOrderOperation(operationType, "us-east-1", 7),
+			testOrderOperation(operationType2, "", 7),
+		}
+		operations := []*policyOperationModel{
+			testOrderOperation(operationType2, "", 7),
+			testOrderOperation(operationType, "us-east-1", 7),
+			testOrderOperation(operationType, "", 30, 7),
+		}
+		orderOperationsLike(prior, operations)
+		assert.Equal(t, prior, operations)
+	})
+
+	// Tests that new operations and SLAs are placed after the ones matching the prior ones in the
+	// order returned by the API.
+	t.Run("New operations and SLAs", func(t *testing.T) {
+		prior := []*policyOperationModel{
+			testOrderOperation(operationType2, "", 7),
+		}
+		operations := []*policyOperationModel{
+			testOrderOperation(operationType9, "", 7),
+			testOrderOperation(operationType, "", 7),
+			testOrderOperation(operationType2, "", 30, 7),
+		}
+		orderOperationsLike(prior, operations)
+		assert.Equal(t, []*policyOperationModel{
+			testOrderOperation(operationType2, "", 7, 30),
+			testOrderOperation(operationType9, "", 7),
+			testOrderOperation(operationType, "", 7),
+		}, operations)
+	})
 }
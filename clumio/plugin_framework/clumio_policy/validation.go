@@ -0,0 +1,376 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the plan-time validation of the operations of the clumio_policy Terraform
+// resource. The validation is driven by the capabilities of the operation types, so that mistakes
+// in a policy are reported before any resource is changed rather than by the API at apply time.
+
+package clumio_policy
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	// The time zone database is embedded so that the time zones can be validated on hosts
+	// without one, such as Windows.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// operationCapability describes what a policy operation type supports.
+type operationCapability struct {
+	// advancedSettings are the advanced_settings which can be set on operations of the type.
+	advancedSettings []string
+}
+
+// operationCapabilities holds the capabilities of every policy operation type supported by the
+// provider, keyed by operation type.
+var operationCapabilities = map[string]operationCapability{
+	"aws_dynamodb_table_backup": {
+		advancedSettings: []string{schemaDynamoDBTableBackup},
+	},
+	"aws_dynamodb_table_snapshot": {},
+	"aws_ebs_volume_backup": {
+		advancedSettings: []string{schemaEBSVolumeBackup},
+	},
+	"aws_ebs_volume_snapshot": {},
+	"aws_ec2_instance_backup": {
+		advancedSettings: []string{schemaEC2InstanceBackup},
+	},
+	"aws_ec2_instance_snapshot": {},
+	"aws_iceberg_table_backup": {
+		advancedSettings: []string{schemaIcebergTableBackup},
+	},
+	"aws_rds_config_sync": {
+		advancedSettings: []string{schemaRDSPitrConfigSync},
+	},
+	"aws_rds_resource_aws_snapshot": {
+		advancedSettings: []string{schemaRDSPitrConfigSync},
+	},
+	"aws_rds_resource_granular_backup": {
+		advancedSettings: []string{schemaRdsLogicalBackup},
+	},
+	"aws_rds_resource_rolling_backup": {},
+	"aws_s3_backtrack": {
+		advancedSettings: []string{schemaS3Backtrack},
+	},
+	"aws_s3_continuous_backup": {
+		advancedSettings: []string{schemaS3ContinuousBackup},
+	},
+	"ec2_mssql_database_backup": {
+		advancedSettings: []string{schemaEc2MssqlDatabaseBackup},
+	},
+	"ec2_mssql_log_backup": {
+		advancedSettings: []string{schemaEc2MssqlLogBackup},
+	},
+	"mssql_database_backup": {
+		advancedSettings: []string{schemaMssqlDatabaseBackup},
+	},
+	"mssql_log_backup": {
+		advancedSettings: []string{schemaMssqlLogBackup},
+	},
+	"protection_group_backup": {
+		advancedSettings: []string{schemaProtectionGroupBackup},
+	},
+}
+
+// unitDuration is the range of durations of one SLA unit. Units such as months do not have a fixed
+// duration, in which case min and max are their shortest and longest durations.
+type unitDuration struct {
+	min time.Duration
+	max time.Duration
+}
+
+const day = 24 * time.Hour
+
+var (
+	// retentionUnits are the valid units of a retention_duration.
+	retentionUnits = map[string]unitDuration{
+		"days":   {day, day},
+		"weeks":  {7 * day, 7 * day},
+		"months": {28 * day, 31 * day},
+		"years":  {365 * day, 366 * day},
+	}
+
+	// rpoUnits are the valid units of an rpo_frequency. Backups of the on_demand unit are not
+	// scheduled and thus have no duration.
+	rpoUnits = map[string]unitDuration{
+		"minutes":   {time.Minute, time.Minute},
+		"hours":     {time.Hour, time.Hour},
+		"days":      {day, day},
+		"weeks":     {7 * day, 7 * day},
+		"months":    {28 * day, 31 * day},
+		"years":     {365 * day, 366 * day},
+		rpoOnDemand: {},
+	}
+
+	// actionSettings are the valid action settings of an operation.
+	actionSettings = []string{actionSettingImmediate, actionSettingWindow}
+
+	// backupWindowTimeRegexp matches the hh:mm times of a backup window.
+	backupWindowTimeRegexp = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+)
+
+// validatePolicy validates the given policy, whose values may be unknown, and returns the errors
+// found. Unknown values are not validated.
+func validatePolicy(model *policyResourceModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	diags.Append(validateTimezone(path.Root(schemaTimezone), model.Timezone)...)
+
+	// Operations are keyed by their type and backup region to find the duplicate ones.
+	operationKeys := make(map[string]bool)
+	for i, operation := range model.Operations {
+		if operation == nil {
+			continue
+		}
+		operationPath := path.Root(schemaOperations).AtListIndex(i)
+		diags.Append(validateOperation(operationPath, operation)...)
+
+		if !isKnown(operation.OperationType) || operation.BackupAwsRegion.IsUnknown() {
+			continue
+		}
+		key := operation.OperationType.ValueString() + "/" + operation.BackupAwsRegion.ValueString()
+		if operationKeys[key] {
+			summary := "Duplicate policy operation"
+			detail := fmt.Sprintf("The policy has more than one operation of type %q",
+				operation.OperationType.ValueString())
+			if operation.BackupAwsRegion.ValueString() != "" {
+				detail += fmt.Sprintf(" with backup_aws_region %q",
+					operation.BackupAwsRegion.ValueString())
+			}
+			detail += ". A policy can have at most one operation per type and backup region."
+			diags.AddAttributeError(operationPath, summary, detail)
+		}
+		operationKeys[key] = true
+	}
+	return diags
+}
+
+// validateOperation validates the given operation, at the given path, against the capabilities of
+// its operation type.
+func validateOperation(operationPath path.Path, operation *policyOperationModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	operationType := operation.OperationType.ValueString()
+
+	if isKnown(operation.OperationType) {
+		capability, ok := operationCapabilities[operationType]
+		if !ok {
+			summary := "Unknown policy operation type"
+			detail := fmt.Sprintf("Operation type %q is not supported. Valid types are: %s.",
+				operationType, joinKeys(operationCapabilities))
+			diags.AddAttributeError(operationPath.AtName(schemaOperationType), summary, detail)
+		} else {
+			for _, setting := range operation.setAdvancedSettings() {
+				if slices.Contains(capability.advancedSettings, setting) {
+					continue
+				}
+				summary := "Unsupported advanced setting"
+				detail := fmt.Sprintf("The %s advanced setting cannot be set on an operation of"+
+					" type %q.", setting, operationType)
+				if len(capability.advancedSettings) > 0 {
+					detail += fmt.Sprintf(" Supported advanced settings are: %s.",
+						strings.Join(capability.advancedSettings, ", "))
+				}
+				diags.AddAttributeError(
+					operationPath.AtName(schemaAdvancedSettings).AtName(setting), summary, detail)
+			}
+		}
+	}
+
+	if isKnown(operation.ActionSetting) &&
+		!slices.Contains(actionSettings, operation.ActionSetting.ValueString()) {
+		summary := "Invalid action setting"
+		detail := fmt.Sprintf("Action setting %q of the %q operation is invalid. Valid values"+
+			" are: %s.", operation.ActionSetting.ValueString(), operationType,
+			strings.Join(actionSettings, ", "))
+		diags.AddAttributeError(operationPath.AtName(schemaActionSetting), summary, detail)
+	}
+
+	diags.Append(validateTimezone(operationPath.AtName(schemaTimezone), operation.Timezone)...)
+	diags.Append(validateBackupWindow(operationPath, operation)...)
+	for j, sla := range operation.Slas {
+		if sla != nil {
+			slaPath := operationPath.AtName(schemaSlas).AtListIndex(j)
+			diags.Append(validateSla(slaPath, operationType, sla)...)
+		}
+	}
+	return diags
+}
+
+// validateBackupWindow validates the backup window of the given operation at the given path. Its
+// times must be in the hh:mm format and its start time must differ from its end time, if any. A
+// start time after the end time denotes a window which spans midnight, such as 22:00 to 04:00.
+// Operations with the window action setting require a backup window with a start time.
+func validateBackupWindow(
+	operationPath path.Path, operation *policyOperationModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	windowPath := operationPath.AtName(schemaBackupWindowTz)
+	operationType := operation.OperationType.ValueString()
+
+	window := operation.BackupWindowTz
+	if operation.ActionSetting.ValueString() == actionSettingWindow &&
+		(window == nil || window.StartTime.IsNull()) {
+		summary := "Missing backup window"
+		detail := fmt.Sprintf("The %q operation has the %q action setting and thus requires"+
+			" a %s with a %s.", operationType, actionSettingWindow, schemaBackupWindowTz,
+			schemaStartTime)
+		diags.AddAttributeError(windowPath, summary, detail)
+	}
+	if window == nil {
+		return diags
+	}
+
+	valid := true
+	for name, value := range map[string]types.String{
+		schemaStartTime: window.StartTime, schemaEndTime: window.EndTime} {
+		// An empty end time denotes a backup window without end time.
+		if !isKnown(value) || value.ValueString() == "" ||
+			backupWindowTimeRegexp.MatchString(value.ValueString()) {
+			continue
+		}
+		valid = false
+		summary := "Invalid backup window time"
+		detail := fmt.Sprintf("The %s %q of the backup window of the %q operation is not in"+
+			" the hh:mm format of the 24 hour clock.", name, value.ValueString(), operationType)
+		diags.AddAttributeError(windowPath.AtName(name), summary, detail)
+	}
+	if valid && isKnown(window.StartTime) && isKnown(window.EndTime) &&
+		window.EndTime.ValueString() != "" &&
+		window.StartTime.ValueString() == window.EndTime.ValueString() {
+		summary := "Invalid backup window"
+		detail := fmt.Sprintf("The start time %q of the backup window of the %q operation must"+
+			" differ from its end time %q.", window.StartTime.ValueString(), operationType,
+			window.EndTime.ValueString())
+		diags.AddAttributeError(windowPath.AtName(schemaEndTime), summary, detail)
+	}
+	return diags
+}
+
+// validateSla validates the units of the given SLA, at the given path, of an operation of the given
+// type and that its retention is at least as long as its RPO.
+func validateSla(slaPath path.Path, operationType string, sla *slaModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	retentionPath := slaPath.AtName(schemaRetentionDuration)
+
+	var retention, rpo *unitDuration
+	var retentionValue, rpoValue types.Int64
+	if sla.RetentionDuration != nil {
+		unit := sla.RetentionDuration.Unit
+		retentionValue = sla.RetentionDuration.Value
+		if duration, ok := retentionUnits[unit.ValueString()]; ok {
+			retention = &duration
+		} else if isKnown(unit) {
+			summary := "Invalid retention duration unit"
+			detail := fmt.Sprintf("Retention duration unit %q of the %q operation is invalid."+
+				" Valid units are: %s.", unit.ValueString(), operationType,
+				joinKeys(retentionUnits))
+			diags.AddAttributeError(retentionPath.AtName(schemaUnit), summary, detail)
+		}
+	}
+	if sla.RPOFrequency != nil {
+		unit := sla.RPOFrequency.Unit
+		rpoValue = sla.RPOFrequency.Value
+		if duration, ok := rpoUnits[unit.ValueString()]; ok {
+			rpo = &duration
+		} else if isKnown(unit) {
+			summary := "Invalid RPO frequency unit"
+			detail := fmt.Sprintf("RPO frequency unit %q of the %q operation is invalid. Valid"+
+				" units are: %s.", unit.ValueString(), operationType,
+				joinKeys(rpoUnits))
+			diags.AddAttributeError(
+				slaPath.AtName(schemaRpoFrequency).AtName(schemaUnit), summary, detail)
+		}
+	}
+
+	// The retention is only known to be shorter than the RPO if its longest possible duration is
+	// shorter than the shortest possible duration of the RPO. On demand backups have no RPO.
+	if retention == nil || rpo == nil || rpo.max == 0 ||
+		!isKnownInt64(retentionValue) || !isKnownInt64(rpoValue) {
+		return diags
+	}
+	if time.Duration(retentionValue.ValueInt64())*retention.max <
+		time.Duration(rpoValue.ValueInt64())*rpo.min {
+		summary := "Retention shorter than RPO"
+		detail := fmt.Sprintf("The retention duration of %d %s of the %q operation is shorter"+
+			" than its RPO frequency of %d %s. Backups would expire before the next backup is"+
+			" taken.", retentionValue.ValueInt64(), sla.RetentionDuration.Unit.ValueString(),
+			operationType, rpoValue.ValueInt64(), sla.RPOFrequency.Unit.ValueString())
+		diags.AddAttributeError(retentionPath.AtName(schemaValue), summary, detail)
+	}
+	return diags
+}
+
+// validateTimezone validates that the given time zone, if set, is a location of the IANA Time Zone
+// database.
+func validateTimezone(attrPath path.Path, timezone types.String) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	if !isKnown(timezone) || timezone.ValueString() == "" {
+		return diags
+	}
+	// LoadLocation also accepts "Local", the time zone of the host, which is not an IANA location.
+	if _, err := time.LoadLocation(timezone.ValueString()); err != nil ||
+		timezone.ValueString() == "Local" {
+		summary := "Invalid time zone"
+		detail := fmt.Sprintf("Time zone %q is not a location of the IANA Time Zone database,"+
+			" such as America/Los_Angeles or Etc/UTC.", timezone.ValueString())
+		diags.AddAttributeError(attrPath, summary, detail)
+	}
+	return diags
+}
+
+// setAdvancedSettings returns the names of the advanced_settings set on the operation.
+func (operation *policyOperationModel) setAdvancedSettings() []string {
+
+	settings := operation.AdvancedSettings
+	if settings == nil {
+		return nil
+	}
+	isSet := map[string]bool{
+		schemaEc2MssqlDatabaseBackup: settings.EC2MssqlDatabaseBackup != nil,
+		schemaEc2MssqlLogBackup:      settings.EC2MssqlLogBackup != nil,
+		schemaMssqlDatabaseBackup:    settings.MssqlDatabaseBackup != nil,
+		schemaMssqlLogBackup:         settings.MssqlLogBackup != nil,
+		schemaProtectionGroupBackup:  settings.ProtectionGroupBackup != nil,
+		schemaS3ContinuousBackup:     settings.S3ContinuousBackup != nil,
+		schemaEBSVolumeBackup:        settings.EBSVolumeBackup != nil,
+		schemaEC2InstanceBackup:      settings.EC2InstanceBackup != nil,
+		schemaRDSPitrConfigSync:      settings.RDSPitrConfigSync != nil,
+		schemaRdsLogicalBackup:       settings.RDSLogicalBackup != nil,
+		schemaIcebergTableBackup:     settings.IcebergTableBackup != nil,
+		schemaDynamoDBTableBackup:    settings.DynamoDBTableBackup != nil,
+		schemaS3Backtrack:            settings.S3Backtrack != nil,
+	}
+	var names []string
+	for name, set := range isSet {
+		if set {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// isKnown returns whether the given string value is neither null nor unknown.
+func isKnown(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// isKnownInt64 returns whether the given int64 value is neither null nor unknown.
+func isKnownInt64(value types.Int64) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// joinKeys returns the sorted keys of the given map separated by commas.
+func joinKeys[V any](m map[string]V) string {
+	return strings.Join(slices.Sorted(maps.Keys(m)), ", ")
+}
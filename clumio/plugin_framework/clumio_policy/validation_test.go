@@ -0,0 +1,308 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in validation.go
+
+//go:build unit
+
+package clumio_policy
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testValidationOperation returns a valid operation of the given type to be validated, with a
+// single SLA retaining daily backups for a week.
+func testValidationOperation(operationType string) *policyOperationModel {
+	return &policyOperationModel{
+		ActionSetting: types.StringValue(actionSettingImmediate),
+		OperationType: types.StringValue(operationType),
+		Slas: []*slaModel{
+			{
+				RetentionDuration: &unitValueModel{
+					Unit:  types.StringValue("days"),
+					Value: types.Int64Value(7),
+				},
+				RPOFrequency: &rpoModel{
+					Unit:    types.StringValue("days"),
+					Value:   types.Int64Value(1),
+					Offsets: types.ListNull(types.Int64Type),
+				},
+			},
+		},
+	}
+}
+
+// testValidationPolicy returns a policy to be validated with the given operations.
+func testValidationPolicy(operations ...*policyOperationModel) *policyResourceModel {
+	return &policyResourceModel{
+		Name:       types.StringValue("test-policy"),
+		Operations: operations,
+	}
+}
+
+// Unit test for the following cases:
+//   - Valid policies do not return an error.
+//   - Unknown operation type returns an error.
+//   - Invalid action setting returns an error.
+//   - Advanced settings not matching the operation type return an error.
+//   - Invalid retention duration and RPO frequency units return an error.
+//   - Retention shorter than the RPO returns an error.
+//   - Invalid backup window times return an error.
+//   - Backup window ending when it starts returns an error.
+//   - Backup window spanning midnight does not return an error.
+//   - Window action setting without backup window returns an error.
+//   - Invalid time zones return an error.
+//   - More than one operation per type and backup region returns an error.
+//   - Errors are reported on the attribute of the invalid value.
+//   - Unknown values are not validated.
+func TestValidatePolicy(t *testing.T) {
+
+	// Tests that valid policies do not return an error.
+	t.Run("Valid policies", func(t *testing.T) {
+		ebs := testValidationOperation("aws_ebs_volume_backup")
+		ebs.Timezone = types.StringValue("America/Los_Angeles")
+		ebs.AdvancedSettings = &advancedSettingsModel{
+			EBSVolumeBackup: &backupTierModel{BackupTier: types.StringValue("standard")},
+		}
+		window := testValidationOperation("protection_group_backup")
+		window.ActionSetting = types.StringValue(actionSettingWindow)
+		window.BackupWindowTz = &backupWindowModel{
+			StartTime: types.StringValue("01:00"),
+			EndTime:   types.StringValue("05:00"),
+		}
+		onDemand := testValidationOperation("aws_ec2_instance_backup")
+		onDemand.Slas[0].RPOFrequency.Unit = types.StringValue(rpoOnDemand)
+		onDemand.Slas[0].RPOFrequency.Value = types.Int64Null()
+		crossRegion := testValidationOperation("aws_ebs_volume_backup")
+		crossRegion.BackupAwsRegion = types.StringValue("us-east-1")
+		noEndTime := testValidationOperation("aws_dynamodb_table_backup")
+		noEndTime.BackupWindowTz = &backupWindowModel{
+			StartTime: types.StringValue("05:00"),
+			EndTime:   types.StringValue(""),
+		}
+		noEndTime.AdvancedSettings = &advancedSettingsModel{
+			DynamoDBTableBackup: &backupTierModel{BackupTier: types.StringValue("warm")},
+		}
+		backtrack := testValidationOperation("aws_s3_backtrack")
+		backtrack.AdvancedSettings = &advancedSettingsModel{
+			S3Backtrack: &ContinuousConfigModel{
+				DisableEventbridgeNotification: types.BoolValue(true),
+			},
+		}
+
+		policy := testValidationPolicy(ebs, window, onDemand, crossRegion, noEndTime, backtrack)
+		policy.Timezone = types.StringValue("UTC")
+		diags := validatePolicy(policy)
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	// Tests that an unknown operation type returns an error.
+	t.Run("Unknown operation type", func(t *testing.T) {
+		diags := validatePolicy(testValidationPolicy(testValidationOperation("aws_ebs_backup")))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that an invalid action setting returns an error.
+	t.Run("Invalid action setting", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.ActionSetting = types.StringValue("later")
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that advanced settings of another operation type return an error.
+	t.Run("Advanced settings not matching the operation type", func(t *testing.T) {
+		operation := testValidationOperation("aws_ec2_instance_backup")
+		operation.AdvancedSettings = &advancedSettingsModel{
+			EBSVolumeBackup: &backupTierModel{BackupTier: types.StringValue("standard")},
+		}
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+
+		operation = testValidationOperation("aws_dynamodb_table_backup")
+		operation.AdvancedSettings = &advancedSettingsModel{
+			ProtectionGroupBackup: &backupTierModel{BackupTier: types.StringValue("cold")},
+		}
+		diags = validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+
+		operation = testValidationOperation("aws_s3_continuous_backup")
+		operation.AdvancedSettings = &advancedSettingsModel{
+			S3Backtrack: &ContinuousConfigModel{
+				DisableEventbridgeNotification: types.BoolValue(true),
+			},
+		}
+		diags = validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that invalid units return an error.
+	t.Run("Invalid units", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.Slas[0].RetentionDuration.Unit = types.StringValue("hours")
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+
+		operation = testValidationOperation("aws_ebs_volume_backup")
+		operation.Slas[0].RPOFrequency.Unit = types.StringValue("fortnights")
+		diags = validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that a retention shorter than the RPO returns an error, also across units.
+	t.Run("Retention shorter than RPO", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.Slas[0].RetentionDuration.Value = types.Int64Value(1)
+		operation.Slas[0].RPOFrequency.Value = types.Int64Value(2)
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+
+		operation = testValidationOperation("aws_ebs_volume_backup")
+		operation.Slas[0].RetentionDuration.Unit = types.StringValue("weeks")
+		operation.Slas[0].RetentionDuration.Value = types.Int64Value(1)
+		operation.Slas[0].RPOFrequency.Unit = types.StringValue("months")
+		diags = validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+
+		// A retention of 4 weeks is not known to be shorter than a month.
+		operation.Slas[0].RetentionDuration.Value = types.Int64Value(4)
+		diags = validatePolicy(testValidationPolicy(operation))
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	// Tests that backup window times not in the hh:mm format return an error.
+	t.Run("Invalid backup window times", func(t *testing.T) {
+		for _, startTime := range []string{"5:00", "24:00", "05:60", "05:00:00", "noon"} {
+			operation := testValidationOperation("aws_ebs_volume_backup")
+			operation.BackupWindowTz = &backupWindowModel{
+				StartTime: types.StringValue(startTime),
+				EndTime:   types.StringNull(),
+			}
+			diags := validatePolicy(testValidationPolicy(operation))
+			assert.True(t, diags.HasError(), startTime)
+		}
+	})
+
+	// Tests that a backup window which ends when it starts returns an error.
+	t.Run("Backup window ending when it starts", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.BackupWindowTz = &backupWindowModel{
+			StartTime: types.StringValue("05:00"),
+			EndTime:   types.StringValue("05:00"),
+		}
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that a backup window which starts after its end, and so spans midnight, is valid.
+	t.Run("Backup window spanning midnight", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.BackupWindowTz = &backupWindowModel{
+			StartTime: types.StringValue("22:00"),
+			EndTime:   types.StringValue("04:00"),
+		}
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.False(t, diags.HasError(), diags)
+	})
+
+	// Tests that the window action setting without backup window returns an error.
+	t.Run("Window action setting without backup window", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.ActionSetting = types.StringValue(actionSettingWindow)
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that time zones which are not IANA locations return an error.
+	t.Run("Invalid time zones", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.Timezone = types.StringValue("Pacific Standard Time")
+		diags := validatePolicy(testValidationPolicy(operation))
+		assert.True(t, diags.HasError())
+
+		policy := testValidationPolicy(testValidationOperation("aws_ebs_volume_backup"))
+		policy.Timezone = types.StringValue("Local")
+		diags = validatePolicy(policy)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that more than one operation per type and backup region returns an error.
+	t.Run("Duplicate operations", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		duplicate := testValidationOperation("aws_ebs_volume_backup")
+		duplicate.Timezone = types.StringValue("UTC")
+		diags := validatePolicy(testValidationPolicy(operation, duplicate))
+		assert.True(t, diags.HasError())
+
+		operation.BackupAwsRegion = types.StringValue("us-west-2")
+		duplicate.BackupAwsRegion = types.StringValue("us-west-2")
+		diags = validatePolicy(testValidationPolicy(operation, duplicate))
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that the errors are reported on the attribute of the invalid value, within its operation
+	// and SLA.
+	t.Run("Errors are reported on the invalid attribute", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		invalid := testValidationOperation("aws_ec2_instance_backup")
+		invalid.Timezone = types.StringValue("Mars/Olympus_Mons")
+		invalid.Slas = append(invalid.Slas, &slaModel{
+			RetentionDuration: &unitValueModel{
+				Unit:  types.StringValue("days"),
+				Value: types.Int64Value(1),
+			},
+			RPOFrequency: &rpoModel{
+				Unit:    types.StringValue("weeks"),
+				Value:   types.Int64Value(1),
+				Offsets: types.ListNull(types.Int64Type),
+			},
+		})
+		invalid.BackupWindowTz = &backupWindowModel{
+			StartTime: types.StringValue("05:00"),
+			EndTime:   types.StringValue("5:00"),
+		}
+		diags := validatePolicy(testValidationPolicy(operation, invalid))
+		invalidPath := path.Root(schemaOperations).AtListIndex(1)
+		assertErrorPaths(t, diags,
+			invalidPath.AtName(schemaTimezone),
+			invalidPath.AtName(schemaBackupWindowTz).AtName(schemaEndTime),
+			invalidPath.AtName(schemaSlas).AtListIndex(1).AtName(schemaRetentionDuration).
+				AtName(schemaValue),
+		)
+	})
+
+	// Tests that unknown values are not validated.
+	t.Run("Unknown values", func(t *testing.T) {
+		operation := testValidationOperation("aws_ebs_volume_backup")
+		operation.OperationType = types.StringUnknown()
+		operation.Timezone = types.StringUnknown()
+		operation.Slas[0].RetentionDuration.Value = types.Int64Unknown()
+		operation.Slas[0].RPOFrequency.Unit = types.StringUnknown()
+		operation.BackupWindowTz = &backupWindowModel{
+			StartTime: types.StringUnknown(),
+			EndTime:   types.StringValue("01:00"),
+		}
+		diags := validatePolicy(testValidationPolicy(operation, operation))
+		assert.False(t, diags.HasError(), diags)
+	})
+}
+
+// assertErrorPaths asserts that the given diagnostics are errors reported on the given paths.
+func assertErrorPaths(t *testing.T, diags diag.Diagnostics, paths ...path.Path) {
+	t.Helper()
+	assert.Len(t, diags.Errors(), len(paths), diags)
+	for _, expected := range paths {
+		found := false
+		for _, d := range diags.Errors() {
+			if withPath, ok := d.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(expected) {
+				found = true
+			}
+		}
+		assert.True(t, found, "no error reported on %s: %v", expected, diags)
+	}
+}
@@ -5,10 +5,12 @@ package clumio_policy_assignment
 const (
 	// Constants used by the resource model for the clumio_policy_assignment Terraform resource.
 	// These values should match the schema tfsdk tags on the resource model struct in schema.go.
-	schemaId         = "id"
-	schemaEntityId   = "entity_id"
-	schemaEntityType = "entity_type"
-	schemaPolicyId   = "policy_id"
+	schemaId                        = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
+	schemaEntityId                  = "entity_id"
+	schemaEntityType                = "entity_type"
+	schemaPolicyId                  = "policy_id"
+	schemaTimeouts                  = "timeouts"
 
 	entityTypeProtectionGroup  = "protection_group"
 	entityTypeAWSDynamoDBTable = "aws_dynamodb_table"
@@ -20,6 +22,9 @@ const (
 	awsS3Continuous            = "aws_s3_continuous_backup"
 	awsIcebergTableBackup      = "aws_iceberg_table_backup"
 
+	// importIdSeparator separates the entity type, entity ID and policy ID in the import ID.
+	importIdSeparator = ":"
+
 	//Common error messages used by the resource.
 	readProtectionGroupErrFmt = "Unable to read Protection Group %v."
 	readDynamoDBTableErrFmt   = "Unable to read DynamoDB table %v."
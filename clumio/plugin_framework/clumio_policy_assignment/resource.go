@@ -61,19 +61,19 @@ func (r *clumioPolicyAssignmentResource) createPolicyAssignment(
 
 	// As setting policy assignments is an asynchronous operation, the task ID
 	// returned by the API is used to poll for the completion of the task.
-	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf("Unable to poll task after assigning policy %v to entity %v",
 			policyId, *assignment.Entity.Id)
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 		return diags
 	}
 
 	// Populate all computed fields of the plan including the ID given that the resource is getting
 	// created.
 	plan.ID = types.StringValue(
-		fmt.Sprintf("%s_%s_%s", *assignment.PolicyId, *assignment.Entity.Id, entityType))
+		policyAssignmentId(*assignment.PolicyId, *assignment.Entity.Id, entityType))
 
 	return diags
 }
@@ -186,12 +186,12 @@ func (r *clumioPolicyAssignmentResource) updatePolicyAssignment(
 
 	// As setting policy assignments is an asynchronous operation, the task ID
 	// returned by the API is used to poll for the completion of the task.
-	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf("Unable to poll task after assigning policy %v to entity %v",
 			policyId, *assignment.Entity.Id)
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 		return diags
 	}
 
@@ -224,12 +224,12 @@ func (r *clumioPolicyAssignmentResource) deletePolicyAssignment(
 
 	// As setting policy assignments is an asynchronous operation, the task ID
 	// returned by the API is used to poll for the completion of the task.
-	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+	err := common.PollTask(ctx, r.sdkTasks, *res.TaskId,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf("Unable to poll task after unassigning policy %v to entity %v",
 			state.PolicyID.ValueString(), state.EntityID.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 	}
 
 	return diags
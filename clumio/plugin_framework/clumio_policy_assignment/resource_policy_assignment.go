@@ -9,13 +9,18 @@ import (
 	"context"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"time"
 )
 
 var (
-	_ resource.Resource              = &clumioPolicyAssignmentResource{}
-	_ resource.ResourceWithConfigure = &clumioPolicyAssignmentResource{}
+	_ resource.Resource                = &clumioPolicyAssignmentResource{}
+	_ resource.ResourceWithConfigure   = &clumioPolicyAssignmentResource{}
+	_ resource.ResourceWithImportState = &clumioPolicyAssignmentResource{}
+	_ resource.ResourceWithIdentity    = &clumioPolicyAssignmentResource{}
 )
 
 // clumioPolicyAssignmentResource is the struct backing the clumio_policy_assignment Terraform resource.
@@ -46,26 +51,50 @@ func (r *clumioPolicyAssignmentResource) Metadata(
 
 	r.name = req.ProviderTypeName + "_policy_assignment"
 	resp.TypeName = r.name
+	// The policy assigned to the entity is updated in place, and with it the identity.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioPolicyAssignmentResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkPolicyDefinitions = sdkclients.NewPolicyDefinitionClient(r.client.ClumioConfig)
-	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(r.client.ClumioConfig)
-	r.sdkPolicyAssignments = sdkclients.NewPolicyAssignmentClient(r.client.ClumioConfig)
-	r.sdkDynamoDBTables = sdkclients.NewDynamoDBTableClient(r.client.ClumioConfig)
-	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyDefinitions = sdkclients.NewPolicyDefinitionClient(
+		r.client.ClumioConfig, middlewares...)
+	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(
+		r.client.ClumioConfig, middlewares...)
+	r.sdkPolicyAssignments = sdkclients.NewPolicyAssignmentClient(
+		r.client.ClumioConfig, middlewares...)
+	r.sdkDynamoDBTables = sdkclients.NewDynamoDBTableClient(r.client.ClumioConfig, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig, middlewares...)
 	r.pollTimeout = 300 * time.Second
 	r.pollInterval = 5 * time.Second
 }
 
+// configureForOU creates the SDK clients of the resource, making the calls in the context of the
+// organizational unit given by its organizational_unit_context attribute, if set. The clients are
+// created for every operation after its timeout is applied to the given context, to which the
+// middlewares are bound, so that the retries, the limiter and the polls honor the timeout.
+func (r *clumioPolicyAssignmentResource) configureForOU(
+	ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		config = r.client.ClumioConfig
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyDefinitions = sdkclients.NewPolicyDefinitionClient(config, middlewares...)
+	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(config, middlewares...)
+	r.sdkPolicyAssignments = sdkclients.NewPolicyAssignmentClient(config, middlewares...)
+	r.sdkDynamoDBTables = sdkclients.NewDynamoDBTableClient(config, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(config, middlewares...)
+}
+
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
 func (r *clumioPolicyAssignmentResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -78,6 +107,19 @@ func (r *clumioPolicyAssignmentResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.createPolicyAssignment(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -90,6 +132,14 @@ func (r *clumioPolicyAssignmentResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyAssignmentResourceIdentityModel{
+		EntityType: plan.EntityType,
+		EntityID:   plan.EntityID,
+		PolicyID:   plan.PolicyID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -104,6 +154,19 @@ func (r *clumioPolicyAssignmentResource) Read(
 		return
 	}
 
+	// Bound the operation by the read timeout given in the timeouts block, if any.
+	readTimeout, diags := state.Timeouts.Read(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	remove, diags := r.readPolicyAssignment(ctx, &state)
 	if remove {
 		resp.State.RemoveResource(ctx)
@@ -120,6 +183,14 @@ func (r *clumioPolicyAssignmentResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyAssignmentResourceIdentityModel{
+		EntityType: state.EntityType,
+		EntityID:   state.EntityID,
+		PolicyID:   state.PolicyID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -134,6 +205,19 @@ func (r *clumioPolicyAssignmentResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.updatePolicyAssignment(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -146,6 +230,14 @@ func (r *clumioPolicyAssignmentResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyAssignmentResourceIdentityModel{
+		EntityType: plan.EntityType,
+		EntityID:   plan.EntityID,
+		PolicyID:   plan.PolicyID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -160,6 +252,58 @@ func (r *clumioPolicyAssignmentResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	diags = r.deletePolicyAssignment(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
+// is done either using an ID in the format <entity_type>:<entity_id>:<policy_id> or by the
+// identity of the resource, from which the attributes identifying the assignment are set before it
+// is read and validated.
+func (r *clumioPolicyAssignmentResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	var entityType, entityId, policyId string
+	if req.ID != "" {
+		var diags diag.Diagnostics
+		entityType, entityId, policyId, diags = parseImportId(req.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Retrieve the identity given in the import block.
+		var identity policyAssignmentResourceIdentityModel
+		diags := req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		entityType = identity.EntityType.ValueString()
+		entityId = identity.EntityID.ValueString()
+		policyId = identity.PolicyID.ValueString()
+	}
+
+	// Set the attributes identifying the assignment into the Terraform state.
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root(schemaId), policyAssignmentId(policyId, entityId, entityType))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root(schemaEntityType), entityType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root(schemaEntityId), entityId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root(schemaPolicyId), policyId)...)
+}
@@ -276,7 +276,7 @@ provider clumio{
 
 resource "clumio_protection_group" "test_pg_policy_assignment"{
   bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
-  name = "test_pg_policy_assignment-%s"
+  name = "acceptance-test-pg-policy-assignment-%s"
   description = "test-description"
   object_filter {
 	storage_classes = ["S3 Intelligent-Tiering", "S3 One Zone-IA", "S3 Standard", "S3 Standard-IA", "S3 Reduced Redundancy"]
@@ -285,25 +285,29 @@ resource "clumio_protection_group" "test_pg_policy_assignment"{
 
 resource "clumio_policy" "test_policy" {
   name = "acceptance-test-policy-1234"
-  operations {
+  operations = [
+  {
 	action_setting = "immediate"
 	type = "protection_group_backup"
-	slas {
-		retention_duration {
-			unit = "months"
-			value = 3
-		}
-		rpo_frequency {
-			unit = "days"
-			value = 2
-		}
-	}
-    advanced_settings {
-		protection_group_backup {
+	slas = [
+		{
+			retention_duration = {
+				unit = "months"
+				value = 3
+			}
+			rpo_frequency = {
+				unit = "days"
+				value = 2
+			}
+		},
+	]
+    advanced_settings = {
+		protection_group_backup = {
 			backup_tier = "cold"
 		}
     }
-  }
+  },
+  ]
 }
 
 resource "clumio_policy_assignment" "test_policy_assignment" {
@@ -336,20 +340,24 @@ data "clumio_dynamodb_tables" "ds_dynamodb_tables" {
 
 resource "clumio_policy" "test_policy" {
   name = "acceptance-test-policy-1234"
-  operations {
+  operations = [
+  {
 	action_setting = "immediate"
 	type = "aws_dynamodb_table_backup"
-	slas {
-		retention_duration {
-			unit = "days"
-			value = 3
-		}
-		rpo_frequency {
-			unit = "hours"
-			value = 4
-		}
-	}
-  }
+	slas = [
+		{
+			retention_duration = {
+				unit = "days"
+				value = 3
+			}
+			rpo_frequency = {
+				unit = "hours"
+				value = 4
+			}
+		},
+	]
+  },
+  ]
 }
 
 resource "clumio_policy_assignment" "test_policy_assignment" {
@@ -8,8 +8,10 @@ package clumio_policy_assignment
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	validators "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -21,9 +23,20 @@ import (
 // resource. It represents the schema of the resource and the data it holds. This schema is used by
 // customers to configure the resource and by the Clumio provider to read and write the resource.
 type policyAssignmentResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	EntityID   types.String `tfsdk:"entity_id"`
+	ID                        types.String   `tfsdk:"id"`
+	EntityID                  types.String   `tfsdk:"entity_id"`
+	EntityType                types.String   `tfsdk:"entity_type"`
+	PolicyID                  types.String   `tfsdk:"policy_id"`
+	OrganizationalUnitContext types.String   `tfsdk:"organizational_unit_context"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// policyAssignmentResourceIdentityModel is the identity model for the clumio_policy_assignment
+// Terraform resource. A policy assignment is identified by the entity and the policy assigned to
+// it.
+type policyAssignmentResourceIdentityModel struct {
 	EntityType types.String `tfsdk:"entity_type"`
+	EntityID   types.String `tfsdk:"entity_id"`
 	PolicyID   types.String `tfsdk:"policy_id"`
 }
 
@@ -31,12 +44,24 @@ type policyAssignmentResourceModel struct {
 // Schema is a method on the clumioPolicyAssignmentResource struct. It sets the schema for the
 // clumio_policy_assignment Terraform resource, which is used to assign a policy to an entity.
 func (r *clumioPolicyAssignmentResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Clumio Policy Assignment Resource used to assign (or unassign)" +
 			" policies.",
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier for the policy assignment.",
 				Computed:    true,
@@ -64,5 +89,33 @@ func (r *clumioPolicyAssignmentResource) Schema(
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_policy_assignment Terraform resource, which is
+// the entity and the policy assigned to it.
+func (r *clumioPolicyAssignmentResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaEntityType: identityschema.StringAttribute{
+				Description:       "Type of the entity to which the policy is assigned.",
+				RequiredForImport: true,
+			},
+			schemaEntityId: identityschema.StringAttribute{
+				Description:       "Identifier of the entity to which the policy is assigned.",
+				RequiredForImport: true,
+			},
+			schemaPolicyId: identityschema.StringAttribute{
+				Description:       "Identifier of the Clumio policy assigned to the entity.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
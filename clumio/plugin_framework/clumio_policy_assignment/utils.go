@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
@@ -49,6 +50,29 @@ func mapSchemaPolicyAssignmentToClumioPolicyAssignment(
 	}
 }
 
+// policyAssignmentId returns the ID of the policy assignment of the given policy to the given
+// entity.
+func policyAssignmentId(policyId string, entityId string, entityType string) string {
+	return fmt.Sprintf("%s_%s_%s", policyId, entityId, entityType)
+}
+
+// parseImportId parses the import ID of a policy assignment, given in the format
+// <entity_type>:<entity_id>:<policy_id>, into the entity type, entity ID and policy ID of the
+// assignment.
+func parseImportId(importId string) (string, string, string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	parts := strings.Split(importId, importIdSeparator)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		summary := "Invalid import ID"
+		detail := fmt.Sprintf("The import ID %q is not in the format"+
+			" <entity_type>:<entity_id>:<policy_id>.", importId)
+		diags.AddError(summary, detail)
+		return "", "", "", diags
+	}
+	return parts[0], parts[1], parts[2], diags
+}
+
 // readAndValidateDynamoDBTable reads the Protection Group and validates that the given policy is
 // assigned to the Protection Group.
 func (r *clumioPolicyAssignmentResource) readAndValidateProtectionGroup(ctx context.Context,
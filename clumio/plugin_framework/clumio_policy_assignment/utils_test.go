@@ -116,3 +116,32 @@ func TestIsOperationsSupported(t *testing.T) {
 		assert.True(t, diags.HasError())
 	})
 }
+
+// Unit test for the following import ID cases:
+//   - Import ID in the <entity_type>:<entity_id>:<policy_id> format.
+//   - Import ID with a missing part.
+//   - Import ID with an empty part.
+func TestParseImportId(t *testing.T) {
+
+	// Tests that the entity type, entity ID and policy ID are parsed from a valid import ID.
+	t.Run("valid import id", func(t *testing.T) {
+		importId := entityTypeProtectionGroup + ":" + entityId + ":" + policyId
+		parsedEntityType, parsedEntityId, parsedPolicyId, diags := parseImportId(importId)
+		assert.Nil(t, diags)
+		assert.Equal(t, entityTypeProtectionGroup, parsedEntityType)
+		assert.Equal(t, entityId, parsedEntityId)
+		assert.Equal(t, policyId, parsedPolicyId)
+	})
+
+	// Tests that Diagnostics is returned if a part of the import ID is missing.
+	t.Run("import id with a missing part", func(t *testing.T) {
+		_, _, _, diags := parseImportId(entityTypeProtectionGroup + ":" + entityId)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned if a part of the import ID is empty.
+	t.Run("import id with an empty part", func(t *testing.T) {
+		_, _, _, diags := parseImportId(entityTypeProtectionGroup + "::" + policyId)
+		assert.True(t, diags.HasError())
+	})
+}
@@ -5,10 +5,14 @@ package clumio_policy_rule
 const (
 	// Constants used by the resource model for the clumio_policy_rule Terraform resource. These
 	// values should match the schema tfsdk tags on the resource model struct in schema.go.
-	schemaName         = "name"
-	schemaId           = "id"
-	schemaCondition    = "condition"
-	schemaBeforeRuleId = "before_rule_id"
-	schemaPolicyId     = "policy_id"
-	schemaPolicyRules  = "policy_rules"
+	schemaName                      = "name"
+	schemaId                        = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
+	schemaCondition                 = "condition"
+	schemaBeforeRuleId              = "before_rule_id"
+	schemaPolicyId                  = "policy_id"
+	schemaPolicyRules               = "policy_rules"
+	schemaTimeouts                  = "timeouts"
+	schemaMaxResults                = "max_results"
+	schemaPageSize                  = "page_size"
 )
@@ -7,10 +7,10 @@ package clumio_policy_rule
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -21,9 +21,12 @@ import (
 // readPolicyRule invokes the API to read the policyRuleClient and from the response populates the
 // attributes of the policy rule.
 func (r *clumioPolicyRuleDataSource) readPolicyRule(
-	_ context.Context, model *clumioPolicyRuleDataSourceModel) diag.Diagnostics {
+	ctx context.Context, model *clumioPolicyRuleDataSourceModel) diag.Diagnostics {
 
-	items, diags := r.listPolicyRules()
+	// The policy rules are filtered after being listed, so only the page size is given to the
+	// paginator and max_results is applied to the filtered rules.
+	options := common.ListOptions{PageSize: model.PageSize.ValueInt64()}
+	items, diags := r.listPolicyRules(ctx, options)
 	if diags.HasError() {
 		return diags
 	}
@@ -80,6 +83,8 @@ func (r *clumioPolicyRuleDataSource) readPolicyRule(
 		diags.Append(conversionDiags...)
 		attrVals = append(attrVals, obj)
 	}
+	attrVals, truncateDiags := common.TruncateResults(r.name, attrVals, model.MaxResults)
+	diags.Append(truncateDiags...)
 	if len(attrVals) > 0 {
 		setObj, listdiag := types.SetValue(objtype, attrVals)
 		diags.Append(listdiag...)
@@ -89,39 +94,24 @@ func (r *clumioPolicyRuleDataSource) readPolicyRule(
 	return diags
 }
 
-// listPolicyRules invokes the SDK API and returns all the policy rules.
-func (r *clumioPolicyRuleDataSource) listPolicyRules() (
-	[]*models.Rule, diag.Diagnostics) {
+// listPolicyRules invokes the SDK API and returns all the policy rules, reading every page.
+func (r *clumioPolicyRuleDataSource) listPolicyRules(
+	ctx context.Context, options common.ListOptions) ([]*models.Rule, diag.Diagnostics) {
 
-	var diags diag.Diagnostics
-	items := make([]*models.Rule, 0)
 	// Call the Clumio API to list the policy rules.
-	limit := int64(1000)
-	var start *string
-	for {
-		res, apiErr := r.sdkPolicyRules.ListPolicyRules(&limit, start, nil, nil, nil)
-		if apiErr != nil {
-			summary := fmt.Sprintf("Unable to read %s", r.name)
-			detail := common.ParseMessageFromApiError(apiErr)
-			diags.AddError(summary, detail)
-			return nil, diags
-		}
-		if res == nil {
-			summary := common.NilErrorMessageSummary
-			detail := common.NilErrorMessageDetail
-			diags.AddError(summary, detail)
-			return nil, diags
-		}
-
-		if res.Embedded != nil && res.Embedded.Items != nil && len(res.Embedded.Items) > 0 {
-			items = append(items, res.Embedded.Items...)
-		}
-		if res.Links == nil || res.Links.Next == nil {
-			break
-		} else {
-			start = res.Links.Next.Href
-		}
-	}
-
-	return items, nil
+	return common.ListAll(ctx, r.name, options,
+		func(limit *int64, start *string) (*common.Page[*models.Rule], *apiutils.APIError) {
+			res, apiErr := r.sdkPolicyRules.ListPolicyRules(limit, start, nil, nil, nil)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[*models.Rule]{}
+			if res.Embedded != nil {
+				page.Items = res.Embedded.Items
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
 }
@@ -13,6 +13,7 @@ import (
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -48,12 +49,24 @@ func (r *clumioPolicyRuleDataSource) Metadata(
 // Configure sets up the datasource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioPolicyRuleDataSource) Configure(
-	_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkPolicyRules = sdkclients.NewPolicyRuleClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyRules = sdkclients.NewPolicyRuleClient(r.client.ClumioConfig, middlewares...)
+}
+
+// configureForOU makes the calls of the data source in the context of the organizational unit
+// given by its organizational_unit_context attribute, if set.
+func (r *clumioPolicyRuleDataSource) configureForOU(ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		return
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyRules = sdkclients.NewPolicyRuleClient(config, middlewares...)
 }
 
 // Read retrieves the datasource from the Clumio API and sets the Terraform state.
@@ -68,6 +81,9 @@ func (r *clumioPolicyRuleDataSource) Read(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the data source, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	diags = r.readPolicyRule(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
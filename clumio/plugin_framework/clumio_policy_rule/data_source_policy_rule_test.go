@@ -60,7 +60,7 @@ func getTestDataSourceClumioPolicyRule(baseUrl string, includeName, includePolic
 	var policyId, datasourceName string
 
 	if includeName {
-		datasourceName = `name = "ds-acceptance-test-policy-rule1"`
+		datasourceName = `name = "acceptance-test-ds-policy-rule1"`
 	}
 	if includePolicyId {
 		policyId = `policy_id = clumio_policy.policy-rule-ds-test.id`
@@ -76,33 +76,37 @@ provider clumio{
 }
 
 resource "clumio_policy" "policy-rule-ds-test" {
-	name = "policy-rule-ds-test"
+	name = "acceptance-test-policy-rule-ds"
 	timezone = "UTC"
-	operations {
-		action_setting = "immediate"
-		type = "aws_ebs_volume_backup"
-		slas {
-			retention_duration {
-				unit = "days"
-				value = 5
-			}
-			rpo_frequency {
-				unit = "days"
-				value = 1
-			}
-		}
-	}
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 5
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+	]
 }
 
 resource "clumio_policy_rule" "ds_test_policy_rule1" {
-  name = "ds-acceptance-test-policy-rule1"
+  name = "acceptance-test-ds-policy-rule1"
   policy_id = clumio_policy.policy-rule-ds-test.id
   before_rule_id = clumio_policy_rule.ds_test_policy_rule2.id
   condition = "{\"entity_type\":{\"$in\":[\"aws_ebs_volume\",\"aws_ec2_instance\"]}, \"aws_tag\":{\"$eq\":{\"key\":\"Foo\", \"value\":\"Bar\"}}}"
 }
 
 resource "clumio_policy_rule" "ds_test_policy_rule2" {
-  name = "ds-acceptance-test-policy-rule2"
+  name = "acceptance-test-ds-policy-rule2"
   policy_id = clumio_policy.policy-rule-ds-test.id
   before_rule_id = ""
   condition = "{\"entity_type\":{\"$in\":[\"aws_ebs_volume\",\"aws_ec2_instance\"]}, \"aws_tag\":{\"$eq\":{\"key\":\"Foo\", \"value\":\"Bar\"}}}"
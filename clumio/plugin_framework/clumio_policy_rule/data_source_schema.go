@@ -8,8 +8,11 @@ package clumio_policy_rule
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -18,9 +21,12 @@ import (
 // by customers to configure the datasource and by the Clumio provider to read and write the
 // datasource.
 type clumioPolicyRuleDataSourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	PolicyId    types.String `tfsdk:"policy_id"`
-	PolicyRules types.Set    `tfsdk:"policy_rules"`
+	Name                      types.String `tfsdk:"name"`
+	PolicyId                  types.String `tfsdk:"policy_id"`
+	PolicyRules               types.Set    `tfsdk:"policy_rules"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+	MaxResults                types.Int64  `tfsdk:"max_results"`
+	PageSize                  types.Int64  `tfsdk:"page_size"`
 }
 
 // Schema defines the structure and constraints of the clumio_policy_rule Terraform datasource.
@@ -33,6 +39,27 @@ func (r *clumioPolicyRuleDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaMaxResults: schema.Int64Attribute{
+				Description: "Maximum number of results to read. If not set, every page of" +
+					" results is read.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			schemaPageSize: schema.Int64Attribute{
+				Description: "Number of results requested per page of the list API. If not set," +
+					" 1000 results are requested per page.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" data source is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaName: schema.StringAttribute{
 				Description: "The name of the policy rule to filter in the list of policy rules" +
 					" returned by the API.",
@@ -0,0 +1,63 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the logic to invoke the Clumio Policy Rule SDK API to list the policy rules
+// matching the filters of the list block of the list resource.
+
+package clumio_policy_rule
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listPolicyRules invokes the API to list the policy rules matching the filters of the given list
+// block. As the API does not support these filters, the policy rules are filtered after listing
+// them, as done by the clumio_policy_rule data source.
+func (r *policyRuleListResource) listPolicyRules(ctx context.Context,
+	model *policyRuleListResourceModel, options common.ListOptions) (
+	[]common.ListItem, diag.Diagnostics) {
+
+	name := model.Name.ValueString()
+	policyId := model.PolicyId.ValueString()
+
+	// Call the Clumio API to list the policy rules, reading every page.
+	return common.ListAll(ctx, r.resource.name, options,
+		func(limit *int64, start *string) (*common.Page[common.ListItem], *apiutils.APIError) {
+			res, apiErr := r.resource.sdkPolicyRules.ListPolicyRules(limit, start, nil, nil, nil)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[common.ListItem]{}
+			if res.Embedded != nil {
+				for _, item := range res.Embedded.Items {
+					if item.Id == nil {
+						continue
+					}
+					if name != "" && (item.Name == nil || *item.Name != name) {
+						continue
+					}
+					if policyId != "" && (item.Action == nil || item.Action.AssignPolicy == nil ||
+						item.Action.AssignPolicy.PolicyId == nil ||
+						*item.Action.AssignPolicy.PolicyId != policyId) {
+						continue
+					}
+					page.Items = append(page.Items, common.ListItem{
+						Id:          *item.Id,
+						DisplayName: types.StringPointerValue(item.Name).ValueString(),
+						Identity: policyRuleResourceIdentityModel{
+							ID: types.StringPointerValue(item.Id),
+						},
+					})
+				}
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+}
@@ -0,0 +1,57 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_policy_rule Terraform list resource.
+
+package clumio_policy_rule
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// policyRuleListResourceModel is the list resource model for the clumio_policy_rule Terraform list
+// resource. It represents the filters of the list block used by `terraform query` to select the
+// policy rules to list.
+type policyRuleListResourceModel struct {
+	Name                      types.String `tfsdk:"name"`
+	PolicyId                  types.String `tfsdk:"policy_id"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_policy_rule Terraform list resource. Its filters are the same as the ones of the
+// clumio_policy_rule data source, and every policy rule is listed if none is set.
+func (r *policyRuleListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_policy_rule list resource is used to list the existing policy rules" +
+			" with `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" policy rules are listed. If not set, the" +
+					" clumio_organizational_unit_context of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaName: schema.StringAttribute{
+				Description: "Lists the policy rules with the given name.",
+				Optional:    true,
+			},
+			schemaPolicyId: schema.StringAttribute{
+				Description: "Lists the policy rules assigning the policy with the given" +
+					" identifier.",
+				Optional: true,
+			},
+		},
+	}
+}
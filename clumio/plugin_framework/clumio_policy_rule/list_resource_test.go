@@ -0,0 +1,156 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in list_resource.go
+
+//go:build unit
+
+package clumio_policy_rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Unit test for the following cases:
+//   - List policy rules success scenario.
+//   - SDK API for list policy rules returns an error.
+//   - SDK API for list policy rules returns an empty response.
+func TestListPolicyRules(t *testing.T) {
+
+	ctx := context.Background()
+	mockClient := sdkclients.NewMockPolicyRuleClient(t)
+	id := "test-id"
+	name := "test-name"
+	policyId := "test-policy-id"
+	testError := "Test Error"
+
+	lr := &policyRuleListResource{
+		resource: &policyRuleResource{
+			name:           "clumio_policy_rule",
+			sdkPolicyRules: mockClient,
+		},
+	}
+	model := &policyRuleListResourceModel{
+		Name:     basetypes.NewStringValue(name),
+		PolicyId: basetypes.NewStringValue(policyId),
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests the success scenario for listing the policy rules. It should not return Diagnostics.
+	t.Run("Basic success scenario for list policy rules", func(t *testing.T) {
+
+		listResponse := &models.ListRulesResponse{
+			Embedded: &models.RuleListEmbedded{
+				Items: []*models.Rule{
+					{
+						Id:   &id,
+						Name: &name,
+						Action: &models.RuleAction{
+							AssignPolicy: &models.AssignPolicyAction{
+								PolicyId: &policyId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyRules(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listPolicyRules(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Equal(t, []common.ListItem{
+			{
+				Id:          id,
+				DisplayName: name,
+				Identity: policyRuleResourceIdentityModel{
+					ID: basetypes.NewStringValue(id),
+				},
+			},
+		}, items)
+	})
+
+	// Tests that the policy rules which do not match the filters are not listed.
+	t.Run("list policy rules filters the policy rules", func(t *testing.T) {
+
+		otherName := "other-name"
+		otherPolicyId := "other-policy-id"
+		listResponse := &models.ListRulesResponse{
+			Embedded: &models.RuleListEmbedded{
+				Items: []*models.Rule{
+					{
+						Id:   &id,
+						Name: &otherName,
+						Action: &models.RuleAction{
+							AssignPolicy: &models.AssignPolicyAction{
+								PolicyId: &policyId,
+							},
+						},
+					},
+					{
+						Id:   &id,
+						Name: &name,
+						Action: &models.RuleAction{
+							AssignPolicy: &models.AssignPolicyAction{
+								PolicyId: &otherPolicyId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyRules(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listPolicyRules(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Empty(t, items)
+	})
+
+	// Tests that Diagnostics is returned in case the list policy rules API call returns an error.
+	t.Run("list policy rules returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyRules(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := lr.listPolicyRules(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list policy rules API call returns an empty
+	// response.
+	t.Run("list policy rules returns an empty response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListPolicyRules(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := lr.listPolicyRules(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
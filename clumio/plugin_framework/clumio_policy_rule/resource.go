@@ -13,10 +13,20 @@ import (
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// apiFieldPaths maps the fields of the policy rule requests to the attributes they are set from, so
+// that the field errors returned by the Clumio API are reported on those attributes.
+var apiFieldPaths = common.ApiFieldPaths{
+	schemaName:                               path.MatchRoot(schemaName),
+	schemaCondition:                          path.MatchRoot(schemaCondition),
+	"priority." + schemaBeforeRuleId:         path.MatchRoot(schemaBeforeRuleId),
+	"action.assign_policy." + schemaPolicyId: path.MatchRoot(schemaPolicyId),
+}
+
 // createPolicyRule invokes the API to create the policy rule and from the response populates the
 // computed attributes of the policy rule.
 func (r *policyRuleResource) createPolicyRule(
@@ -45,8 +55,7 @@ func (r *policyRuleResource) createPolicyRule(
 	res, apiErr := sdkPolicyRules.CreatePolicyRule(prRequest)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to create %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 
 	}
@@ -57,12 +66,11 @@ func (r *policyRuleResource) createPolicyRule(
 		return diags
 	}
 	err := common.PollTask(
-		ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+		ctx, r.sdkTasks, *res.TaskId, common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf(
 			"Unable to poll %s (Name: %v) for creation", r.name, plan.Name.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 		return diags
 	}
 
@@ -146,8 +154,7 @@ func (r *policyRuleResource) updatePolicyRule(
 	res, apiErr := sdkPolicyRules.UpdatePolicyRule(plan.ID.ValueString(), prRequest)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to update %s (ID: %v)", r.name, plan.ID.ValueString())
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -160,12 +167,11 @@ func (r *policyRuleResource) updatePolicyRule(
 	// As the update of a policy rule is an asynchronous operation, the task ID
 	// returned by the API is used to poll for the completion of the task.
 	err := common.PollTask(
-		ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+		ctx, r.sdkTasks, *res.TaskId, common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf(
 			"Unable to poll %s (ID: %v) for update", r.name, plan.ID.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 		return diags
 	}
 
@@ -198,12 +204,11 @@ func (r *policyRuleResource) deletePolicyRule(
 	// As the delete of a policy rule is an asynchronous operation, the task ID
 	// returned by the API is used to poll for the completion of the task.
 	err := common.PollTask(
-		ctx, r.sdkTasks, *res.TaskId, r.pollTimeout, r.pollInterval)
+		ctx, r.sdkTasks, *res.TaskId, common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf(
 			"Unable to poll %s (ID: %v) for deletion", r.name, state.ID.ValueString())
-		detail := err.Error()
-		diags.AddError(summary, detail)
+		diags.Append(common.TaskErrorDiagnostic(summary, err))
 	}
 	return diags
 }
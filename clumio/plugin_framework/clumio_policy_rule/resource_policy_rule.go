@@ -15,12 +15,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
 	_ resource.Resource                = &policyRuleResource{}
 	_ resource.ResourceWithConfigure   = &policyRuleResource{}
 	_ resource.ResourceWithImportState = &policyRuleResource{}
+	_ resource.ResourceWithIdentity    = &policyRuleResource{}
 )
 
 // policyRuleResource is the struct backing the clumio_policy_rule Terraform resource. It holds the
@@ -52,26 +54,41 @@ func (r *policyRuleResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *policyRuleResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkPolicyRules = sdkclients.NewPolicyRuleClient(r.client.ClumioConfig)
-	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyRules = sdkclients.NewPolicyRuleClient(r.client.ClumioConfig, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(r.client.ClumioConfig, middlewares...)
 	r.pollTimeout = 3600 * time.Second
 	r.pollInterval = 5 * time.Second
 }
 
+// configureForOU creates the SDK clients of the resource, making the calls in the context of the
+// organizational unit given by its organizational_unit_context attribute, if set. The clients are
+// created for every operation after its timeout is applied to the given context, to which the
+// middlewares are bound, so that the retries, the limiter and the polls honor the timeout.
+func (r *policyRuleResource) configureForOU(ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		config = r.client.ClumioConfig
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPolicyRules = sdkclients.NewPolicyRuleClient(config, middlewares...)
+	r.sdkTasks = sdkclients.NewTaskClient(config, middlewares...)
+}
+
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done by the ID of the resource, given either as the import ID or in the resource identity.
 func (r *policyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
 
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve the ID from the import ID or identity and save it to the id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -86,6 +103,19 @@ func (r *policyRuleResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.createPolicyRule(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -98,6 +128,10 @@ func (r *policyRuleResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyRuleResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -112,6 +146,19 @@ func (r *policyRuleResource) Read(
 		return
 	}
 
+	// Bound the operation by the read timeout given in the timeouts block, if any.
+	readTimeout, diags := state.Timeouts.Read(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	remove, diags := r.readPolicyRule(ctx, &state)
 	if remove {
 		resp.State.RemoveResource(ctx)
@@ -127,6 +174,10 @@ func (r *policyRuleResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyRuleResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -141,6 +192,19 @@ func (r *policyRuleResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.updatePolicyRule(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -152,6 +216,10 @@ func (r *policyRuleResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, policyRuleResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes it from the Terraform state.
@@ -166,6 +234,19 @@ func (r *policyRuleResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	diags = r.deletePolicyRule(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,158 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the acceptance tests of the clumio_policy_rule Terraform resource which run
+// against the fake Clumio API. Please view the README.md file for more information on how to run
+// these tests.
+
+//go:build fake
+
+package clumio_policy_rule_test
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	clumiopf "github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test of the clumio_policy_rule resource against the fake Clumio API. It tests the following
+// scenarios:
+//   - Creates a policy rule and verifies that the plan was applied properly.
+//   - Updates the policy rule and verifies that the update is applied once its task completes.
+//   - Imports the policy rule by ID.
+//   - Changes the policy rule outside of Terraform and verifies that the drift is planned to be
+//     undone.
+//   - Deletes the policy rule outside of Terraform and verifies that it is planned to be
+//     recreated.
+func TestAccFakeResourceClumioPolicyRule(t *testing.T) {
+
+	server := clumiopf.UtilTestAccStartFakeApi(t)
+	resourceName := "clumio_policy_rule.test_policy_rule"
+	var id string
+	updatedConfig := getTestAccFakeResourceClumioPolicyRule(
+		"acceptance-test-policy-rule-updated")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: clumiopf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccFakeResourceClumioPolicyRule("acceptance-test-policy-rule"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", "acceptance-test-policy-rule"),
+					resource.TestCheckResourceAttrPair(
+						resourceName, "policy_id", "clumio_policy.test_policy", "id"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: getTestAccFakeResourceClumioPolicyRule(
+					"acceptance-test-policy-rule-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "name", "acceptance-test-policy-rule-updated"),
+					checkFakePolicyRuleName(server, &id, "acceptance-test-policy-rule-updated"),
+				),
+			},
+			clumiopf.UtilTestAccFakeImportStep(resourceName, updatedConfig, &id),
+			clumiopf.UtilTestAccFakeDriftStep(server, fakeapi.PolicyRules, &id,
+				map[string]any{"name": "changed-outside-of-terraform"}, resourceName,
+				updatedConfig,
+				checkFakePolicyRuleName(server, &id, "acceptance-test-policy-rule-updated")),
+			clumiopf.UtilTestAccFakeDeleteStep(
+				server, fakeapi.PolicyRules, &id, resourceName, updatedConfig),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if rules := server.Objects(fakeapi.PolicyRules); len(rules) > 0 {
+				return fmt.Errorf("expected no policy rules after destroy, found %d", len(rules))
+			}
+			if policies := server.Objects(fakeapi.Policies); len(policies) > 0 {
+				return fmt.Errorf("expected no policies after destroy, found %d", len(policies))
+			}
+			return nil
+		},
+	})
+}
+
+// checkFakePolicyRuleName returns the check verifying that the policy rule with the given ID is
+// named as given in the fake Clumio API.
+func checkFakePolicyRuleName(
+	server *fakeapi.Server, id *string, name string) resource.TestCheckFunc {
+
+	return func(_ *terraform.State) error {
+		rule, ok := server.Object(fakeapi.PolicyRules, *id)
+		if !ok {
+			return fmt.Errorf("policy rule %s not found in the fake Clumio API", *id)
+		}
+		if rule["name"] != name {
+			return fmt.Errorf("expected policy rule name %q, found %q", name, rule["name"])
+		}
+		return nil
+	}
+}
+
+// getTestAccFakeResourceClumioPolicyRule returns the Terraform configuration of a policy rule with
+// the given name for the acceptance tests which run against the fake Clumio API.
+func getTestAccFakeResourceClumioPolicyRule(name string) string {
+	return fmt.Sprintf(testAccFakeResourceClumioPolicyRule, name)
+}
+
+// testAccFakeResourceClumioPolicyRule is the Terraform configuration of a policy rule assigning a
+// policy. The provider reads the base URL and token of the fake Clumio API from the environment.
+const testAccFakeResourceClumioPolicyRule = `
+resource "clumio_policy" "test_policy" {
+	name = "acceptance-test-policy"
+	timezone = "UTC"
+	operations = [
+		{
+			action_setting = "immediate"
+			type = "aws_ebs_volume_backup"
+			slas = [
+				{
+					retention_duration = {
+						unit = "days"
+						value = 1
+					}
+					rpo_frequency = {
+						unit = "days"
+						value = 1
+					}
+				},
+			]
+		},
+	]
+}
+
+resource "clumio_policy_rule" "test_policy_rule" {
+	name = "%s"
+	policy_id = clumio_policy.test_policy.id
+	before_rule_id = ""
+	condition = "{\"entity_type\":{\"$eq\":\"aws_ebs_volume\"}, \"aws_tag\":{\"$eq\":{\"key\":\"Foo\", \"value\":\"Bar\"}}}"
+}
+`
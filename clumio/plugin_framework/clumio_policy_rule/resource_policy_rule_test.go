@@ -33,8 +33,8 @@ import (
 func TestAccResourceClumioPolicyRule(t *testing.T) {
 
 	// Define the policy and policy rule names
-	policyName := "test_policy"
-	policyTwoName := "test_policy_2"
+	policyName := "acceptance-test-policy-rule-policy"
+	policyTwoName := "acceptance-test-policy-rule-policy-2"
 	policyRuleName := "acceptance-test-policy-rule"
 	policyRuleTwoName := "acceptance-test-policy-rule-2"
 
@@ -254,24 +254,28 @@ provider clumio{
 resource "clumio_policy" "%s" {
  name = "%s"
  activation_status = "activated"
- operations {
+ operations = [
+ {
 	action_setting = "window"
 	type = "aws_ebs_volume_backup"
-	backup_window_tz {
+	backup_window_tz = {
 		start_time = "08:00"
 		end_time = "20:00"
 	}
-	slas {
-		retention_duration {
-			unit = "days"
-			value = 1
-		}
-		rpo_frequency {
-			unit = "days"
-			value = 1
-		}
-	}
- }
+	slas = [
+		{
+			retention_duration = {
+				unit = "days"
+				value = 1
+			}
+			rpo_frequency = {
+				unit = "days"
+				value = 1
+			}
+		},
+	]
+ },
+ ]
 }
 
 resource "clumio_policy_rule" "test_policy_rule" {
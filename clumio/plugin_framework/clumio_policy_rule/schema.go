@@ -8,10 +8,14 @@ package clumio_policy_rule
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,24 +23,45 @@ import (
 // resource. It represents the schema of the resource and the data it holds. This schema is used by
 // customers to configure the resource and by the Clumio provider to read and write the resource.
 type policyRuleResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Condition    types.String `tfsdk:"condition"`
-	BeforeRuleID types.String `tfsdk:"before_rule_id"`
-	PolicyID     types.String `tfsdk:"policy_id"`
+	ID                        types.String   `tfsdk:"id"`
+	Name                      types.String   `tfsdk:"name"`
+	Condition                 types.String   `tfsdk:"condition"`
+	BeforeRuleID              types.String   `tfsdk:"before_rule_id"`
+	PolicyID                  types.String   `tfsdk:"policy_id"`
+	OrganizationalUnitContext types.String   `tfsdk:"organizational_unit_context"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// policyRuleResourceIdentityModel is the identity model for the clumio_policy_rule Terraform
+// resource. It identifies the policy rule when importing it and when listing policy rules with
+// `terraform query`.
+type policyRuleResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 // Schema defines the structure and constraints of the clumio_policy_rule Terraform resource.
 // Schema is a method on the policyRuleResource struct. It sets the schema for the
 // clumio_policy_rule Terraform resource.
 func (r *policyRuleResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		Description: "Clumio Policy Rule Resource used to determine how" +
 			" a policy should be assigned to assets.",
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier of the policy rule.",
 				Computed:    true,
@@ -78,5 +103,25 @@ func (r *policyRuleResource) Schema(
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_policy_rule Terraform resource, which is the ID
+// of the policy rule.
+func (r *policyRuleResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the policy rule.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
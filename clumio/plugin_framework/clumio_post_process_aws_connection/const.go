@@ -8,6 +8,8 @@ const (
 	// schema.go.
 	schemaId                              = "id"
 	schemaToken                           = "token"
+	schemaTokenWo                         = "token_wo"
+	schemaTokenWoVersion                  = "token_wo_version"
 	schemaRoleExternalId                  = "role_external_id"
 	schemaAccountId                       = "account_id"
 	schemaRegion                          = "region"
@@ -29,6 +31,7 @@ const (
 	schemaIntermediateRoleArn             = "intermediate_role_arn"
 	schemaWaitForIngestion                = "wait_for_ingestion"
 	schemaWaitForDataPlaneResources       = "wait_for_data_plane_resources"
+	schemaTimeouts                        = "timeouts"
 
 	eventTypeCreate = "Create"
 	eventTypeUpdate = "Update"
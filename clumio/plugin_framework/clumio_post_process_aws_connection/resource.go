@@ -73,7 +73,7 @@ func (r *postProcessAWSConnectionResource) clumioPostProcessAWSConnectionCommon(
 		RequestType:         &eventType,
 		RoleArn:             model.RoleArn.ValueStringPointer(),
 		RoleExternalId:      model.RoleExternalID.ValueStringPointer(),
-		Token:               model.Token.ValueStringPointer(),
+		Token:               common.GetWriteOnlyStringPtr(model.Token, model.TokenWo),
 		ClumioEventPubId:    model.ClumioEventPubID.ValueStringPointer(),
 		Properties:          propertiesMap,
 		IntermediateRoleArn: model.IntermediateRoleArn.ValueStringPointer(),
@@ -89,7 +89,7 @@ func (r *postProcessAWSConnectionResource) clumioPostProcessAWSConnectionCommon(
 		(model.WaitForIngestion.ValueBool() || model.WaitForDataPlaneResources.ValueBool()) {
 
 		targetSetupErr, err := pollForConnectionIngestionAndTargetStatus(
-			ctx, r.sdkAWSConnection, model, r.pollTimeout, r.pollInterval)
+			ctx, r.sdkAWSConnection, model, common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 		if err != nil {
 			if targetSetupErr {
 				summary := "Error in polling for connection ingestion and/or data plane resources" +
@@ -105,3 +105,29 @@ func (r *postProcessAWSConnectionResource) clumioPostProcessAWSConnectionCommon(
 	}
 	return diags
 }
+
+// readConnectionToken reads the token of the AWS connection being post-processed. It is used when
+// the token was given using the write-only token_wo attribute, in which case the token is not
+// available in the state when the resource is deleted.
+func (r *postProcessAWSConnectionResource) readConnectionToken(
+	_ context.Context, model postProcessAWSConnectionResourceModel) (*string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+
+	readExtId := "false"
+	connId := fmt.Sprintf("%s_%s", model.AccountID.ValueString(), model.Region.ValueString())
+	connRes, apiErr := r.sdkAWSConnection.ReadAwsConnection(connId, &readExtId)
+	if apiErr != nil {
+		summary := fmt.Sprintf("Unable to read Clumio AWS connection with id: %s", connId)
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return nil, diags
+	}
+	if connRes == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return nil, diags
+	}
+	return connRes.Token, diags
+}
@@ -9,12 +9,13 @@ package clumio_post_process_aws_connection
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -26,8 +27,10 @@ type sourceConfigInfo struct {
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &postProcessAWSConnectionResource{}
-	_ resource.ResourceWithConfigure = &postProcessAWSConnectionResource{}
+	_ resource.Resource                = &postProcessAWSConnectionResource{}
+	_ resource.ResourceWithConfigure   = &postProcessAWSConnectionResource{}
+	_ resource.ResourceWithImportState = &postProcessAWSConnectionResource{}
+	_ resource.ResourceWithIdentity    = &postProcessAWSConnectionResource{}
 )
 
 // postProcessAWSConnectionResource is the resource implementation.
@@ -51,24 +54,35 @@ func NewPostProcessAWSConnectionResource() resource.Resource {
 func (r *postProcessAWSConnectionResource) Metadata(
 	_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_post_process_aws_connection"
+	// The account ID and region are updated in place, and with them the identity.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *postProcessAWSConnectionResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkPostProcessConn = sdkclients.NewPostProcessAWSConnectionClient(r.client.ClumioConfig)
-	r.sdkAWSConnection = sdkclients.NewAWSConnectionClient(r.client.ClumioConfig)
+	r.configureClients(ctx)
 	r.pollInterval = 5 * time.Second
 	r.pollTimeout = 3600 * time.Second
 }
 
+// configureClients creates the SDK clients of the resource. The clients are created for every
+// operation after its timeout is applied to the given context, to which the middlewares are bound,
+// so that the retries, the limiter and the polls honor the timeout.
+func (r *postProcessAWSConnectionResource) configureClients(ctx context.Context) {
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPostProcessConn = sdkclients.NewPostProcessAWSConnectionClient(
+		r.client.ClumioConfig, middlewares...)
+	r.sdkAWSConnection = sdkclients.NewAWSConnectionClient(r.client.ClumioConfig, middlewares...)
+}
+
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
 func (r *postProcessAWSConnectionResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -81,16 +95,32 @@ func (r *postProcessAWSConnectionResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
+	// Write-only attributes are not part of the plan and have to be retrieved from the config.
+	diags = req.Config.GetAttribute(ctx, path.Root(schemaTokenWo), &plan.TokenWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = r.clumioPostProcessAWSConnectionCommon(ctx, plan, "Create")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	accountId := plan.AccountID.ValueString()
-	awsRegion := plan.Region.ValueString()
-	token := plan.Token.ValueString()
-	plan.ID = types.StringValue(fmt.Sprintf("%v/%v/%v", accountId, awsRegion, token))
+	plan.ID = types.StringValue(resourceId(plan.AccountID.ValueString(), plan.Region.ValueString()))
 
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
@@ -98,12 +128,45 @@ func (r *postProcessAWSConnectionResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessAWSConnectionResourceIdentityModel{
+		AccountID: plan.AccountID,
+		Region:    plan.Region,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
-// Read does not have an implementation as there is no API to read for post process aws connection.
+// Read does not call the Clumio API as there is no API to read for post process aws connection. It
+// only replaces the ID of the resources created by earlier versions of the provider, which held the
+// token, with the ID made of the account ID and region, and sets the identity of the resource.
 func (r *postProcessAWSConnectionResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No implementation needed.
+
+	// Retrieve the schema from the current Terraform state.
+	var state postProcessAWSConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := resourceId(state.AccountID.ValueString(), state.Region.ValueString())
+	if state.ID.ValueString() != id {
+		state.ID = types.StringValue(id)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessAWSConnectionResourceIdentityModel{
+		AccountID: state.AccountID,
+		Region:    state.Region,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and removes the Terraform state.
@@ -118,18 +181,47 @@ func (r *postProcessAWSConnectionResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
+	// Write-only attributes are not part of the plan and have to be retrieved from the config.
+	diags = req.Config.GetAttribute(ctx, path.Root(schemaTokenWo), &plan.TokenWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = r.clumioPostProcessAWSConnectionCommon(ctx, plan, "Update")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// The ID is set again as the account ID and region can be updated.
+	plan.ID = types.StringValue(resourceId(plan.AccountID.ValueString(), plan.Region.ValueString()))
+
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessAWSConnectionResourceIdentityModel{
+		AccountID: plan.AccountID,
+		Region:    plan.Region,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -144,9 +236,65 @@ func (r *postProcessAWSConnectionResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout.
+	r.configureClients(ctx)
+
+	// If the token was given using token_wo it is not available in the state and is instead read
+	// from the AWS connection it was issued for.
+	if state.Token.IsNull() {
+		token, diags := r.readConnectionToken(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.TokenWo = types.StringPointerValue(token)
+	}
+
 	diags = r.clumioPostProcessAWSConnectionCommon(ctx, state, "Delete")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// ImportState retrieves the account ID and region of the post-processed AWS connection from the
+// import ID or the identity of the resource. As there is no API to read the post-processed AWS
+// connection, the remaining attributes, including the token, are set by the next apply, which
+// post-processes the connection again.
+func (r *postProcessAWSConnectionResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	var accountId, awsRegion string
+	if req.ID != "" {
+		var diags diag.Diagnostics
+		accountId, awsRegion, diags = parseImportId(req.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Retrieve the identity given in the import block.
+		var identity postProcessAWSConnectionResourceIdentityModel
+		diags := req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		accountId = identity.AccountID.ValueString()
+		awsRegion = identity.Region.ValueString()
+	}
+
+	id := resourceId(accountId, awsRegion)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaId), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaAccountId), accountId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaRegion), awsRegion)...)
+}
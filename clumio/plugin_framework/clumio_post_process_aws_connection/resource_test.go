@@ -220,4 +220,87 @@ func TestClumioPostProcessAWSConnectionCommon(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 
+	// Tests that the write-only token is sent to the API when the token is not persisted.
+	t.Run("Write-only token is used when set", func(t *testing.T) {
+
+		tokenWo := "test-token-wo"
+		prmWo := prm
+		prmWo.Token = basetypes.NewStringNull()
+		prmWo.TokenWo = basetypes.NewStringValue(tokenWo)
+
+		// Setup Expectations
+		mockPostProcessConn.EXPECT().PostProcessAwsConnection(mock.MatchedBy(
+			func(req *models.PostProcessAwsConnectionV1Request) bool {
+				return *req.Token == tokenWo
+			})).Times(1).Return(nil, nil)
+
+		diags = pr.clumioPostProcessAWSConnectionCommon(ctx, prmWo, eventType)
+		assert.Nil(t, diags)
+	})
+}
+
+// Unit test for the following cases:
+//   - Read connection token success scenario.
+//   - SDK API for read AWS connection returns an error.
+//   - SDK API for read AWS connection returns an empty response.
+func TestReadConnectionToken(t *testing.T) {
+
+	ctx := context.Background()
+	mockAWSConn := sdkclients.NewMockAWSConnectionClient(t)
+
+	pr := postProcessAWSConnectionResource{
+		client: &common.ApiClient{
+			ClumioConfig: sdkconfig.Config{},
+		},
+		sdkAWSConnection: mockAWSConn,
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	prm := postProcessAWSConnectionResourceModel{
+		AccountID: basetypes.NewStringValue(accountId),
+		Region:    basetypes.NewStringValue(region),
+	}
+
+	// Tests that the token of the AWS connection is returned.
+	t.Run("Success scenario for read connection token", func(t *testing.T) {
+
+		// Setup Expectations
+		mockAWSConn.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).Return(
+			&models.ReadAWSConnectionResponse{
+				Token: &token,
+			}, nil)
+
+		res, diags := pr.readConnectionToken(ctx, prm)
+		assert.Nil(t, diags)
+		assert.Equal(t, token, *res)
+	})
+
+	// Tests that Diagnostics is returned in case the read AWS connection API call returns an
+	// error.
+	t.Run("ReadAwsConnection returns an error", func(t *testing.T) {
+
+		// Setup Expectations
+		mockAWSConn.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).Return(nil, apiError)
+
+		res, diags := pr.readConnectionToken(ctx, prm)
+		assert.NotNil(t, diags)
+		assert.Nil(t, res)
+	})
+
+	// Tests that Diagnostics is returned in case the read AWS connection API call returns an
+	// empty response.
+	t.Run("ReadAwsConnection returns an empty response", func(t *testing.T) {
+
+		// Setup Expectations
+		mockAWSConn.EXPECT().ReadAwsConnection(id, mock.Anything).Times(1).Return(nil, nil)
+
+		res, diags := pr.readConnectionToken(ctx, prm)
+		assert.NotNil(t, diags)
+		assert.Nil(t, res)
+	})
 }
@@ -8,10 +8,16 @@ package clumio_post_process_aws_connection
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,36 +26,47 @@ import (
 // and the data it holds. This schema is used by customers to configure the resource and by the
 // Clumio provider to read and write the resource.
 type postProcessAWSConnectionResourceModel struct {
-	ID                              types.String `tfsdk:"id"`
-	AccountID                       types.String `tfsdk:"account_id"`
-	Token                           types.String `tfsdk:"token"`
-	RoleExternalID                  types.String `tfsdk:"role_external_id"`
-	Region                          types.String `tfsdk:"region"`
-	ClumioEventPubID                types.String `tfsdk:"clumio_event_pub_id"`
-	RoleArn                         types.String `tfsdk:"role_arn"`
-	ConfigVersion                   types.String `tfsdk:"config_version"`
-	DiscoverVersion                 types.String `tfsdk:"discover_version"`
-	ProtectConfigVersion            types.String `tfsdk:"protect_config_version"`
-	ProtectEBSVersion               types.String `tfsdk:"protect_ebs_version"`
-	ProtectRDSVersion               types.String `tfsdk:"protect_rds_version"`
-	ProtectS3Version                types.String `tfsdk:"protect_s3_version"`
-	ProtectDynamoDBVersion          types.String `tfsdk:"protect_dynamodb_version"`
-	ProtectEC2MssqlVersion          types.String `tfsdk:"protect_ec2_mssql_version"`
-	ProtectWarmTierVersion          types.String `tfsdk:"protect_warm_tier_version"`
-	ProtectWarmTierDynamoDBVersion  types.String `tfsdk:"protect_warm_tier_dynamodb_version"`
-	ProtectIcebergOnGlueVersion     types.String `tfsdk:"protect_iceberg_on_glue_version"`
-	ProtectIcebergOnS3TablesVersion types.String `tfsdk:"protect_iceberg_on_s3_tables_version"`
-	Properties                      types.Map    `tfsdk:"properties"`
-	IntermediateRoleArn             types.String `tfsdk:"intermediate_role_arn"`
-	WaitForIngestion                types.Bool   `tfsdk:"wait_for_ingestion"`
-	WaitForDataPlaneResources       types.Bool   `tfsdk:"wait_for_data_plane_resources"`
+	ID                              types.String   `tfsdk:"id"`
+	AccountID                       types.String   `tfsdk:"account_id"`
+	Token                           types.String   `tfsdk:"token"`
+	TokenWo                         types.String   `tfsdk:"token_wo"`
+	TokenWoVersion                  types.Int64    `tfsdk:"token_wo_version"`
+	RoleExternalID                  types.String   `tfsdk:"role_external_id"`
+	Region                          types.String   `tfsdk:"region"`
+	ClumioEventPubID                types.String   `tfsdk:"clumio_event_pub_id"`
+	RoleArn                         types.String   `tfsdk:"role_arn"`
+	ConfigVersion                   types.String   `tfsdk:"config_version"`
+	DiscoverVersion                 types.String   `tfsdk:"discover_version"`
+	ProtectConfigVersion            types.String   `tfsdk:"protect_config_version"`
+	ProtectEBSVersion               types.String   `tfsdk:"protect_ebs_version"`
+	ProtectRDSVersion               types.String   `tfsdk:"protect_rds_version"`
+	ProtectS3Version                types.String   `tfsdk:"protect_s3_version"`
+	ProtectDynamoDBVersion          types.String   `tfsdk:"protect_dynamodb_version"`
+	ProtectEC2MssqlVersion          types.String   `tfsdk:"protect_ec2_mssql_version"`
+	ProtectWarmTierVersion          types.String   `tfsdk:"protect_warm_tier_version"`
+	ProtectWarmTierDynamoDBVersion  types.String   `tfsdk:"protect_warm_tier_dynamodb_version"`
+	ProtectIcebergOnGlueVersion     types.String   `tfsdk:"protect_iceberg_on_glue_version"`
+	ProtectIcebergOnS3TablesVersion types.String   `tfsdk:"protect_iceberg_on_s3_tables_version"`
+	Properties                      types.Map      `tfsdk:"properties"`
+	IntermediateRoleArn             types.String   `tfsdk:"intermediate_role_arn"`
+	WaitForIngestion                types.Bool     `tfsdk:"wait_for_ingestion"`
+	WaitForDataPlaneResources       types.Bool     `tfsdk:"wait_for_data_plane_resources"`
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// postProcessAWSConnectionResourceIdentityModel is the identity model for the
+// clumio_post_process_aws_connection Terraform resource. The post-processed AWS connection is
+// identified by its AWS account and region.
+type postProcessAWSConnectionResourceIdentityModel struct {
+	AccountID types.String `tfsdk:"account_id"`
+	Region    types.String `tfsdk:"region"`
 }
 
 // Schema defines the structure and constraints of the clumio_post_process_aws_connection Terraform
 // resource. Schema is a method on the postProcessAWSConnectionResource struct. It sets the schema
 // for the clumio_post_process_aws_connection Terraform resource.
 func (r *postProcessAWSConnectionResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Post-Process Clumio AWS Connection Resource used to" +
 			" post-process AWS connection to Clumio.",
@@ -63,8 +80,29 @@ func (r *postProcessAWSConnectionResource) Schema(
 			},
 			schemaToken: schema.StringAttribute{
 				Description: "Distinct 36-character token used to identify resources set up by " +
-					"the Clumio AWS template installation on the account being connected.",
-				Required: true,
+					"the Clumio AWS template installation on the account being connected. " +
+					"Exactly one of token or token_wo must be set.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(schemaTokenWo)),
+				},
+			},
+			schemaTokenWo: schema.StringAttribute{
+				Description: "Write-only alternative to token which is never persisted to the " +
+					"Terraform state. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			schemaTokenWoVersion: schema.Int64Attribute{
+				Description: "Version of the value given in token_wo. As changes to write-only " +
+					"attributes are not detected by Terraform, this must be changed whenever " +
+					"token_wo is changed in order for the new token to be applied.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot(schemaTokenWo)),
+				},
 			},
 			schemaRoleExternalId: schema.StringAttribute{
 				Description: "Unique identifier Clumio uses to access the service role within " +
@@ -156,5 +194,29 @@ func (r *postProcessAWSConnectionResource) Schema(
 				Optional:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_post_process_aws_connection Terraform resource,
+// which is the AWS account and region of the connection.
+func (r *postProcessAWSConnectionResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaAccountId: identityschema.StringAttribute{
+				Description:       "Identifier of the AWS account linked with Clumio.",
+				RequiredForImport: true,
+			},
+			schemaRegion: identityschema.StringAttribute{
+				Description:       "Region of the AWS account linked with Clumio.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
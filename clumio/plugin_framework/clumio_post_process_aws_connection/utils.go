@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
 	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -261,3 +263,25 @@ func isTargetSetupComplete(waitForTargetSetup bool, targetSetupStatus string) (b
 	}
 	return true, false
 }
+
+// resourceId returns the ID of the post-processed AWS connection of the given account and region.
+// The token is not part of the ID as it is sensitive while the ID is not.
+func resourceId(accountId string, region string) string {
+	return fmt.Sprintf("%v/%v", accountId, region)
+}
+
+// parseImportId returns the account ID and region of the post-processed AWS connection from the
+// given import ID, in the format <account_id>/<region> used by the ID of the resource.
+func parseImportId(importId string) (string, string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	parts := strings.Split(importId, "/")
+	if len(parts) != 2 || slices.Contains(parts, "") {
+		summary := "Invalid import ID"
+		detail := fmt.Sprintf("The import ID %q is not in the format <account_id>/<region>.",
+			importId)
+		diags.AddError(summary, detail)
+		return "", "", diags
+	}
+	return parts[0], parts[1], diags
+}
@@ -181,6 +181,28 @@ func TestParseVersion(t *testing.T) {
 	})
 }
 
+// Unit test for the following cases:
+//   - Parse import ID with the account ID and region.
+//   - Parse invalid import IDs, including IDs holding the token, returns an error.
+func TestParseImportId(t *testing.T) {
+
+	t.Run("Parse import ID with the account ID and region", func(t *testing.T) {
+		importAccountId, importRegion, diags := parseImportId(
+			resourceId("test-account", "test-region"))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "test-account", importAccountId)
+		assert.Equal(t, "test-region", importRegion)
+	})
+
+	t.Run("Parse invalid import IDs", func(t *testing.T) {
+		for _, importId := range []string{"test-account", "test-account/", "/test-region",
+			"test-account/test-region/test-token", "a//c"} {
+			_, _, diags := parseImportId(importId)
+			assert.True(t, diags.HasError(), importId)
+		}
+	})
+}
+
 // Unit test for the utility function PollForConnectionIngestionAndTargetStatus.
 // Tests the following scenarios:
 //   - Success scenario for connection ingestion and target status polling.
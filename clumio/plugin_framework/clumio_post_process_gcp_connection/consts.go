@@ -10,6 +10,8 @@ const (
 	schemaProjectName         = "project_name"
 	schemaProjectNumber       = "project_number"
 	schemaToken               = "token"
+	schemaTokenWo             = "token_wo"
+	schemaTokenWoVersion      = "token_wo_version"
 	schemaServiceAccountEmail = "service_account_email"
 	schemaWifPoolId           = "wif_pool_id"
 	schemaWifProviderId       = "wif_provider_id"
@@ -52,7 +52,7 @@ func (r *clumioPostProcessGCPConnectionResource) createUpdatePostProcessGcpConne
 		RequestType:         &requestType,
 		ResourceProperties:  propertiesMap,
 		ServiceAccountEmail: model.ServiceAccountEmail.ValueStringPointer(),
-		Token:               model.Token.ValueStringPointer(),
+		Token:               common.GetWriteOnlyStringPtr(model.Token, model.TokenWo),
 		WifPoolId:           model.WifPoolId.ValueStringPointer(),
 		WifProviderId:       model.WifProviderId.ValueStringPointer(),
 	}
@@ -66,8 +66,9 @@ func (r *clumioPostProcessGCPConnectionResource) createUpdatePostProcessGcpConne
 		return diags
 	}
 
-	// ID needs to be a value which is used by our backend to uniquely identify connection
-	model.ID = types.StringPointerValue(model.Token.ValueStringPointer())
+	// ID needs to be a value which is used by our backend to uniquely identify connection. The
+	// project ID is used rather than the token, as the token is sensitive while the ID is not.
+	model.ID = types.StringPointerValue(model.ProjectID.ValueStringPointer())
 	return diags
 }
 
@@ -80,7 +81,7 @@ func (r *clumioPostProcessGCPConnectionResource) deletePostProcessGcpConnection(
 		ProjectName:   model.ProjectName.ValueStringPointer(),
 		ProjectNumber: model.ProjectNumber.ValueStringPointer(),
 		RequestType:   types.StringValue(deleteRequestType).ValueStringPointer(),
-		Token:         model.Token.ValueStringPointer(),
+		Token:         common.GetWriteOnlyStringPtr(model.Token, model.TokenWo),
 	}
 
 	_, apiErr := r.sdkConnections.PostProcessGcpConnection(postprocessRequest)
@@ -94,3 +95,25 @@ func (r *clumioPostProcessGCPConnectionResource) deletePostProcessGcpConnection(
 
 	return diags
 }
+
+// readConnectionToken reads the token of the GCP connection being post-processed. It is used when
+// the token was given using the write-only token_wo attribute, in which case the token is not
+// available in the state when the resource is deleted.
+func (r *clumioPostProcessGCPConnectionResource) readConnectionToken(_ context.Context, model *clumioPostProcessGCPConnectionResourceModel) (*string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	res, apiErr := r.sdkConnections.ReadGcpConnection(model.ProjectID.ValueString())
+	if apiErr != nil {
+		summary := fmt.Sprintf("Unable to read Clumio GCP connection (project id: %v)", model.ProjectID.ValueString())
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return nil, diags
+	}
+	if res == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return nil, diags
+	}
+	return res.Token, diags
+}
@@ -7,11 +7,15 @@ import (
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &clumioPostProcessGCPConnectionResource{}
 var _ resource.ResourceWithConfigure = &clumioPostProcessGCPConnectionResource{}
+var _ resource.ResourceWithImportState = &clumioPostProcessGCPConnectionResource{}
+var _ resource.ResourceWithIdentity = &clumioPostProcessGCPConnectionResource{}
 
 type clumioPostProcessGCPConnectionResource struct {
 	name           string
@@ -31,21 +35,48 @@ func NewClumioPostProcessGCPConnectionResource() resource.Resource {
 func (r *clumioPostProcessGCPConnectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	r.name = req.ProviderTypeName + "_post_process_gcp_connection"
 	resp.TypeName = r.name
+	// The project ID is updated in place, and with it the identity.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
-func (r *clumioPostProcessGCPConnectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *clumioPostProcessGCPConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkConnections = sdkclients.NewGcpConnectionClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkConnections = sdkclients.NewGcpConnectionClient(r.client.ClumioConfig, middlewares...)
 }
 
-// Read does not have an implementation as there is no API to read for post process gcp connection.
-func (r *clumioPostProcessGCPConnectionResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
-	// Not supported
+// Read does not call the Clumio API as there is no API to read for post process gcp connection. It
+// only replaces the ID of the resources created by earlier versions of the provider, which held the
+// token, with the project ID, and sets the identity of the resource.
+func (r *clumioPostProcessGCPConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	// Retrieve the schema from the current Terraform state.
+	var state clumioPostProcessGCPConnectionResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ID.Equal(state.ProjectID) {
+		state.ID = state.ProjectID
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessGCPConnectionResourceIdentityModel{
+		ProjectID: state.ProjectID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Create creates a resource via Clumio API and sets initial Terraform state
@@ -58,6 +89,13 @@ func (r *clumioPostProcessGCPConnectionResource) Create(ctx context.Context, req
 		return
 	}
 
+	// Write-only attributes are not part of the plan and have to be retrieved from the config.
+	diags = req.Config.GetAttribute(ctx, path.Root(schemaTokenWo), &plan.TokenWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Call the Clumio API to process the GCP connection.
 	diags = r.createUpdatePostProcessGcpConnection(ctx, &plan, createRequestType)
 	resp.Diagnostics.Append(diags...)
@@ -71,6 +109,12 @@ func (r *clumioPostProcessGCPConnectionResource) Create(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessGCPConnectionResourceIdentityModel{
+		ProjectID: plan.ProjectID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates a resource via Clumio API and sets the updated Terraform state.
@@ -83,6 +127,13 @@ func (r *clumioPostProcessGCPConnectionResource) Update(ctx context.Context, req
 		return
 	}
 
+	// Write-only attributes are not part of the plan and have to be retrieved from the config.
+	diags = req.Config.GetAttribute(ctx, path.Root(schemaTokenWo), &plan.TokenWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Call the Clumio API to process the GCP connection.
 	diags = r.createUpdatePostProcessGcpConnection(ctx, &plan, updateRequestType)
 	resp.Diagnostics.Append(diags...)
@@ -96,6 +147,12 @@ func (r *clumioPostProcessGCPConnectionResource) Update(ctx context.Context, req
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessGCPConnectionResourceIdentityModel{
+		ProjectID: plan.ProjectID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via API
@@ -108,6 +165,17 @@ func (r *clumioPostProcessGCPConnectionResource) Delete(ctx context.Context, req
 		return
 	}
 
+	// If the token was given using token_wo it is not available in the state and is instead read
+	// from the GCP connection it was issued for.
+	if state.Token.IsNull() {
+		token, diags := r.readConnectionToken(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.TokenWo = types.StringPointerValue(token)
+	}
+
 	// Call Clumio API to delete GCP connection
 	diags = r.deletePostProcessGcpConnection(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -115,3 +183,19 @@ func (r *clumioPostProcessGCPConnectionResource) Delete(ctx context.Context, req
 		return
 	}
 }
+
+// ImportState retrieves the project ID of the post-processed GCP connection from the import ID or
+// the identity of the resource. As there is no API to read the post-processed GCP connection, the
+// remaining attributes are set by the next apply, which post-processes the connection again.
+func (r *clumioPostProcessGCPConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root(schemaProjectID), path.Root(schemaProjectID), req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ID of the connection is its project ID.
+	var projectID types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(schemaProjectID), &projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaID), projectID)...)
+}
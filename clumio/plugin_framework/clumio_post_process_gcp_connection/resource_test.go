@@ -99,6 +99,7 @@ func TestCreateUpdatePostProcessGcpConnection(t *testing.T) {
 
 		diags := r.createUpdatePostProcessGcpConnection(ctx, model, createRequestType)
 		assert.Nil(t, diags)
+		assert.Equal(t, model.ProjectID.ValueString(), model.ID.ValueString())
 	})
 
 	t.Run("Success scenario for post-process update", func(t *testing.T) {
@@ -192,4 +193,97 @@ func TestDeletePostProcessGcpConnection(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 
+	t.Run("Write-only token is used when set", func(t *testing.T) {
+		modelWo := setupTestModel(t)
+		modelWo.Token = basetypes.NewStringNull()
+		modelWo.TokenWo = basetypes.NewStringValue("TokenWo")
+
+		// Setup expectations
+		mockSdkConnection.EXPECT().PostProcessGcpConnection(mock.MatchedBy(
+			func(req *models.PostProcessGcpConnectionV1Request) bool {
+				return *req.Token == "TokenWo"
+			})).Times(1).Return(nil, nil)
+
+		diags := r.deletePostProcessGcpConnection(ctx, modelWo)
+		assert.Nil(t, diags)
+	})
+}
+
+// Unit test for the following cases:
+//   - Post-process GCP connection uses the project ID rather than the token as the ID.
+func TestCreateUpdatePostProcessGcpConnectionWriteOnlyToken(t *testing.T) {
+	ctx := context.Background()
+	mockSdkConnection := sdkclients.NewMockGcpConnectionClient(t)
+	r := &clumioPostProcessGCPConnectionResource{
+		name: "test_clumio_post_process_gcp_connection",
+		client: &common.ApiClient{
+			ClumioConfig: sdkconfig.Config{},
+		},
+		sdkConnections: mockSdkConnection,
+	}
+
+	model := setupTestModel(t)
+	model.Token = basetypes.NewStringNull()
+	model.TokenWo = basetypes.NewStringValue("TokenWo")
+
+	t.Run("Success scenario for post-process create with write-only token", func(t *testing.T) {
+		// Setup expectations
+		mockSdkConnection.EXPECT().PostProcessGcpConnection(mock.MatchedBy(
+			func(req *models.PostProcessGcpConnectionV1Request) bool {
+				return *req.Token == "TokenWo"
+			})).Times(1).Return(nil, nil)
+
+		diags := r.createUpdatePostProcessGcpConnection(ctx, model, createRequestType)
+		assert.Nil(t, diags)
+		assert.Equal(t, model.ProjectID.ValueString(), model.ID.ValueString())
+	})
+}
+
+// Unit test for the following cases:
+//   - Read connection token success scenario.
+//   - SDK API for read GCP connection returns an error.
+//   - SDK API for read GCP connection returns an empty response.
+func TestReadConnectionToken(t *testing.T) {
+	ctx := context.Background()
+	mockSdkConnection := sdkclients.NewMockGcpConnectionClient(t)
+	r := &clumioPostProcessGCPConnectionResource{
+		name: "test_clumio_post_process_gcp_connection",
+		client: &common.ApiClient{
+			ClumioConfig: sdkconfig.Config{},
+		},
+		sdkConnections: mockSdkConnection,
+	}
+
+	model := setupTestModel(t)
+	token := "Token"
+
+	t.Run("Success scenario for read connection token", func(t *testing.T) {
+		// Setup expectations
+		mockSdkConnection.EXPECT().ReadGcpConnection(model.ProjectID.ValueString()).Times(1).
+			Return(&models.ReadGCPConnectionResponse{Token: &token}, nil)
+
+		res, diags := r.readConnectionToken(ctx, model)
+		assert.Nil(t, diags)
+		assert.Equal(t, token, *res)
+	})
+
+	t.Run("ReadGcpConnection returns an error", func(t *testing.T) {
+		// Setup expectations
+		mockSdkConnection.EXPECT().ReadGcpConnection(mock.Anything).Times(1).
+			Return(nil, apiError)
+
+		res, diags := r.readConnectionToken(ctx, model)
+		assert.NotNil(t, diags)
+		assert.Nil(t, res)
+	})
+
+	t.Run("ReadGcpConnection returns an empty response", func(t *testing.T) {
+		// Setup expectations
+		mockSdkConnection.EXPECT().ReadGcpConnection(mock.Anything).Times(1).
+			Return(nil, nil)
+
+		res, diags := r.readConnectionToken(ctx, model)
+		assert.NotNil(t, diags)
+		assert.Nil(t, res)
+	})
 }
@@ -5,10 +5,15 @@ package clumio_post_process_gcp_connection
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +26,8 @@ type clumioPostProcessGCPConnectionResourceModel struct {
 	ProjectName         types.String `tfsdk:"project_name"`
 	ProjectNumber       types.String `tfsdk:"project_number"`
 	Token               types.String `tfsdk:"token"`
+	TokenWo             types.String `tfsdk:"token_wo"`
+	TokenWoVersion      types.Int64  `tfsdk:"token_wo_version"`
 	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
 	WifPoolId           types.String `tfsdk:"wif_pool_id"`
 	WifProviderId       types.String `tfsdk:"wif_provider_id"`
@@ -29,6 +36,13 @@ type clumioPostProcessGCPConnectionResourceModel struct {
 	Properties          types.Map    `tfsdk:"properties"`
 }
 
+// postProcessGCPConnectionResourceIdentityModel is the identity model for the
+// clumio_post_process_gcp_connection Terraform resource. The post-processed GCP connection is
+// identified by its GCP project.
+type postProcessGCPConnectionResourceIdentityModel struct {
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
 // Schema defines the structure and constraints of the clumio_post_process_gcp_connection Terraform
 // resource. Schema is a method on the clumioPostProcessGCPConnectionResource struct. It sets the schema
 // for the clumio_post_process_gcp_connection Terraform resource.
@@ -38,8 +52,9 @@ func (r *clumioPostProcessGCPConnectionResource) Schema(_ context.Context, _ res
 		MarkdownDescription: "> ⚠️ **Beta Resource**\n>\n> This resource handles post-processing for connections between GCP projects and Clumio.\n> It is currently in **beta** and available only to select customers.\n> Behavior, schema, and APIs may change in future releases.\n>",
 		Attributes: map[string]schema.Attribute{
 			schemaID: schema.StringAttribute{
-				Description: "Unique identifier of the connection",
-				Computed:    true,
+				Description: "Unique identifier of the connection, which is the ID of its GCP" +
+					" project.",
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -58,8 +73,28 @@ func (r *clumioPostProcessGCPConnectionResource) Schema(_ context.Context, _ res
 			},
 			schemaToken: schema.StringAttribute{
 				Description: "The 36-character Clumio GCP integration token used to identify the installation " +
-					"of the Clumio GCP integration resources in the project.",
-				Required: true,
+					"of the Clumio GCP integration resources in the project. Exactly one of token or token_wo must be set.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(schemaTokenWo)),
+				},
+			},
+			schemaTokenWo: schema.StringAttribute{
+				Description: "Write-only alternative to token which is never persisted to the Terraform state. " +
+					"Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			schemaTokenWoVersion: schema.Int64Attribute{
+				Description: "Version of the value given in token_wo. As changes to write-only attributes are not " +
+					"detected by Terraform, this must be changed whenever token_wo is changed in order for the new " +
+					"token to be applied.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot(schemaTokenWo)),
+				},
 			},
 			schemaServiceAccountEmail: schema.StringAttribute{
 				Description: "The email address of the GCP service account created for this connection.",
@@ -90,3 +125,18 @@ func (r *clumioPostProcessGCPConnectionResource) Schema(_ context.Context, _ res
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_post_process_gcp_connection Terraform resource,
+// which is the GCP project of the connection.
+func (r *clumioPostProcessGCPConnectionResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaProjectID: identityschema.StringAttribute{
+				Description:       "The user-assigned ID of the GCP project associated with the connection.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -7,6 +7,8 @@ const (
 	// These values should match the schema tfsdk tags on the resource model struct in schema.go.
 	schemaId                     = "id"
 	schemaToken                  = "token"
+	schemaTokenWo                = "token_wo"
+	schemaTokenWoVersion         = "token_wo_version"
 	schemaAccountId              = "account_id"
 	schemaRegion                 = "region"
 	schemaRoleId                 = "role_id"
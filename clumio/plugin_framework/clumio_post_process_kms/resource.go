@@ -7,9 +7,13 @@ package clumio_post_process_kms
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
@@ -29,7 +33,7 @@ func (r *clumioPostProcessKmsResource) clumioPostProcessKmsCommon(
 			AccountNativeId:       state.AccountId.ValueStringPointer(),
 			AwsRegion:             state.Region.ValueStringPointer(),
 			RequestType:           &eventType,
-			Token:                 state.Token.ValueStringPointer(),
+			Token:                 common.GetWriteOnlyStringPtr(state.Token, state.TokenWo),
 			MultiRegionCmkKeyId:   state.MultiRegionCMKKeyId.ValueStringPointer(),
 			RoleId:                state.RoleId.ValueStringPointer(),
 			RoleArn:               state.RoleArn.ValueStringPointer(),
@@ -45,3 +49,64 @@ func (r *clumioPostProcessKmsResource) clumioPostProcessKmsCommon(
 	}
 	return diags
 }
+
+// readWalletToken reads the token of the wallet for the account and region of the post process kms.
+// It is used when the token was given using the write-only token_wo attribute, in which case the
+// token is not available in the state when the resource is deleted. Every page of wallets is read
+// and only a wallet of the exact account and region matches.
+func (r *clumioPostProcessKmsResource) readWalletToken(
+	ctx context.Context, state clumioPostProcessKmsResourceModel) (*string, diag.Diagnostics) {
+
+	accountId := state.AccountId.ValueString()
+	region := state.Region.ValueString()
+	wallets, diags := common.ListAll(ctx, "the Clumio wallets", common.ListOptions{},
+		func(limit *int64, start *string) (*common.Page[*models.Wallet], *apiutils.APIError) {
+			res, apiErr := r.sdkWallets.ListWallets(limit, start)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[*models.Wallet]{}
+			if res.Embedded != nil {
+				page.Items = res.Embedded.Items
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, wallet := range wallets {
+		if wallet.AccountNativeId != nil && *wallet.AccountNativeId == accountId &&
+			wallet.AwsRegion != nil && *wallet.AwsRegion == region {
+			return wallet.Token, diags
+		}
+	}
+	summary := "Unable to read the token of the Clumio wallet."
+	detail := fmt.Sprintf("No wallet found for account %s in region %s.", accountId, region)
+	diags.AddError(summary, detail)
+	return nil, diags
+}
+
+// resourceId returns the ID of the post-processed BYOK of the given account and region. The token
+// is not part of the ID as it is sensitive while the ID is not.
+func resourceId(accountId string, region string) string {
+	return fmt.Sprintf("%v/%v", accountId, region)
+}
+
+// parseImportId returns the account ID and region of the post-processed BYOK from the given import
+// ID, in the format <account_id>/<region> used by the ID of the resource.
+func parseImportId(importId string) (string, string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	parts := strings.Split(importId, "/")
+	if len(parts) != 2 || slices.Contains(parts, "") {
+		summary := "Invalid import ID"
+		detail := fmt.Sprintf("The import ID %q is not in the format <account_id>/<region>.",
+			importId)
+		diags.AddError(summary, detail)
+		return "", "", diags
+	}
+	return parts[0], parts[1], diags
+}
@@ -9,18 +9,21 @@ package clumio_post_process_kms
 
 import (
 	"context"
-	"fmt"
 
-	sdkPostProcessKms "github.com/clumio-code/clumio-go-sdk/controllers/post_process_kms"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &clumioPostProcessKmsResource{}
-	_ resource.ResourceWithConfigure = &clumioPostProcessKmsResource{}
+	_ resource.Resource                = &clumioPostProcessKmsResource{}
+	_ resource.ResourceWithConfigure   = &clumioPostProcessKmsResource{}
+	_ resource.ResourceWithImportState = &clumioPostProcessKmsResource{}
+	_ resource.ResourceWithIdentity    = &clumioPostProcessKmsResource{}
 )
 
 // clumioPostProcessKmsResource is the struct backing the clumio_post_process_kms Terraform resource.
@@ -29,7 +32,8 @@ var (
 // byok-template module.
 type clumioPostProcessKmsResource struct {
 	client            *common.ApiClient
-	sdkPostProcessKMS sdkPostProcessKms.PostProcessKmsV1Client
+	sdkPostProcessKMS sdkclients.PostProcessKMSClient
+	sdkWallets        sdkclients.WalletClient
 }
 
 // NewClumioPostProcessKmsResource creates a new instance of clumioPostProcessKmsResource. Its
@@ -44,19 +48,23 @@ func NewClumioPostProcessKmsResource() resource.Resource {
 func (r *clumioPostProcessKmsResource) Metadata(
 	_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_post_process_kms"
+	// The account ID and region are updated in place, and with them the identity.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioPostProcessKmsResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkPostProcessKMS = sdkPostProcessKms.NewPostProcessKmsV1(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkPostProcessKMS = sdkclients.NewPostProcessKMSClient(r.client.ClumioConfig, middlewares...)
+	r.sdkWallets = sdkclients.NewWalletClient(r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -71,6 +79,13 @@ func (r *clumioPostProcessKmsResource) Create(
 		return
 	}
 
+	// Write-only attributes are not part of the plan and have to be retrieved from the config.
+	diags = req.Config.GetAttribute(ctx, path.Root(schemaTokenWo), &plan.TokenWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = r.clumioPostProcessKmsCommon(ctx, plan, "Create")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -78,21 +93,51 @@ func (r *clumioPostProcessKmsResource) Create(
 	}
 
 	// Set the schema into the Terraform state.
-	accountId := plan.AccountId.ValueString()
-	awsRegion := plan.Region.ValueString()
-	token := plan.Token.ValueString()
-	plan.Id = types.StringValue(fmt.Sprintf("%v/%v/%v", accountId, awsRegion, token))
+	plan.Id = types.StringValue(resourceId(plan.AccountId.ValueString(), plan.Region.ValueString()))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessKmsResourceIdentityModel{
+		AccountId: plan.AccountId,
+		Region:    plan.Region,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
-// Read does not have an implementation as there is no API to read for post process kms.
+// Read does not call the Clumio API as there is no API to read for post process kms. It only
+// replaces the ID of the resources created by earlier versions of the provider, which held the
+// token, with the ID made of the account ID and region, and sets the identity of the resource.
 func (r *clumioPostProcessKmsResource) Read(
 	ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// No implementation needed.
+
+	// Retrieve the schema from the current Terraform state.
+	var state clumioPostProcessKmsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := resourceId(state.AccountId.ValueString(), state.Region.ValueString())
+	if state.Id.ValueString() != id {
+		state.Id = types.StringValue(id)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessKmsResourceIdentityModel{
+		AccountId: state.AccountId,
+		Region:    state.Region,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and removes the Terraform state.
@@ -107,18 +152,34 @@ func (r *clumioPostProcessKmsResource) Update(
 		return
 	}
 
+	// Write-only attributes are not part of the plan and have to be retrieved from the config.
+	diags = req.Config.GetAttribute(ctx, path.Root(schemaTokenWo), &plan.TokenWo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = r.clumioPostProcessKmsCommon(ctx, plan, "Update")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Set the schema into the Terraform state.
+	// Set the schema into the Terraform state. The ID is set again as the account ID and region
+	// can be updated.
+	plan.Id = types.StringValue(resourceId(plan.AccountId.ValueString(), plan.Region.ValueString()))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, postProcessKmsResourceIdentityModel{
+		AccountId: plan.AccountId,
+		Region:    plan.Region,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -133,9 +194,52 @@ func (r *clumioPostProcessKmsResource) Delete(
 		return
 	}
 
+	// If the token was given using token_wo it is not available in the state and is instead read
+	// from the wallet it was issued for.
+	if state.Token.IsNull() {
+		token, diags := r.readWalletToken(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.TokenWo = types.StringPointerValue(token)
+	}
+
 	diags = r.clumioPostProcessKmsCommon(ctx, state, "Delete")
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
+
+// ImportState retrieves the account ID and region of the post-processed BYOK from the import ID or
+// the identity of the resource. As there is no API to read the post-processed BYOK, the remaining
+// attributes, including the token, are set by the next apply, which post-processes the BYOK again.
+func (r *clumioPostProcessKmsResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	var accountId, awsRegion string
+	if req.ID != "" {
+		var diags diag.Diagnostics
+		accountId, awsRegion, diags = parseImportId(req.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Retrieve the identity given in the import block.
+		var identity postProcessKmsResourceIdentityModel
+		diags := req.Identity.Get(ctx, &identity)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		accountId = identity.AccountId.ValueString()
+		awsRegion = identity.Region.ValueString()
+	}
+
+	id := resourceId(accountId, awsRegion)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaId), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaAccountId), accountId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(schemaRegion), awsRegion)...)
+}
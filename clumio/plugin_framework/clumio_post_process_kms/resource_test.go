@@ -16,6 +16,7 @@ import (
 
 	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -94,4 +95,141 @@ func TestClumioPostProcessAWSConnectionCommon(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 
+	// Tests that the write-only token is sent to the API when the token is not persisted.
+	t.Run("Write-only token is used when set", func(t *testing.T) {
+
+		tokenWo := "test-token-wo"
+		prmWo := prm
+		prmWo.Token = basetypes.NewStringNull()
+		prmWo.TokenWo = basetypes.NewStringValue(tokenWo)
+
+		//Setup expectations.
+		mockPostProcessKms.EXPECT().PostProcessKms(mock.MatchedBy(
+			func(req *models.PostProcessKmsV1Request) bool {
+				return *req.Token == tokenWo
+			})).Times(1).Return(nil, nil)
+
+		diags := pr.clumioPostProcessKmsCommon(ctx, prmWo, eventType)
+		assert.Nil(t, diags)
+	})
+}
+
+// Unit test for the following cases:
+//   - Read wallet token success scenario.
+//   - No wallet found for the account and region, including wallets without a region.
+//   - SDK API for list wallets returns error.
+func TestReadWalletToken(t *testing.T) {
+
+	ctx := context.Background()
+	mockWallet := sdkclients.NewMockWalletClient(t)
+
+	pr := clumioPostProcessKmsResource{
+		client: &common.ApiClient{
+			ClumioConfig: sdkconfig.Config{},
+		},
+		sdkWallets: mockWallet,
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	prm := clumioPostProcessKmsResourceModel{
+		AccountId: basetypes.NewStringValue(accountId),
+		Region:    basetypes.NewStringValue(region),
+	}
+
+	otherAccountId := "test-other-account"
+	otherRegion := "test-other-region"
+	otherToken := "test-other-token"
+	listResponse := &models.ListWalletsResponse{
+		Embedded: &models.WalletListEmbedded{
+			Items: []*models.Wallet{
+				{
+					AccountNativeId: &otherAccountId,
+					AwsRegion:       &region,
+					Token:           &otherToken,
+				},
+				{
+					AccountNativeId: &accountId,
+					AwsRegion:       &otherRegion,
+					Token:           &otherToken,
+				},
+				{
+					AccountNativeId: &accountId,
+					Token:           &otherToken,
+				},
+				{
+					AccountNativeId: &accountId,
+					AwsRegion:       &region,
+					Token:           &token,
+				},
+			},
+		},
+	}
+
+	// Tests that the token of the wallet matching the account and region is returned.
+	t.Run("Success scenario for read wallet token", func(t *testing.T) {
+
+		// Setup Expectations
+		mockWallet.EXPECT().ListWallets(mock.Anything, mock.Anything).Times(1).
+			Return(listResponse, nil)
+
+		res, diags := pr.readWalletToken(ctx, prm)
+		assert.Nil(t, diags)
+		assert.Equal(t, token, *res)
+	})
+
+	// Tests that Diagnostics is returned in case no wallet matches the account and region. A
+	// wallet without a region does not match any region.
+	t.Run("No wallet found for account and region", func(t *testing.T) {
+
+		// Setup Expectations
+		mockWallet.EXPECT().ListWallets(mock.Anything, mock.Anything).Times(1).
+			Return(&models.ListWalletsResponse{
+				Embedded: &models.WalletListEmbedded{
+					Items: listResponse.Embedded.Items[:3],
+				},
+			}, nil)
+
+		res, diags := pr.readWalletToken(ctx, prm)
+		assert.NotNil(t, diags)
+		assert.Nil(t, res)
+	})
+
+	// Tests that Diagnostics is returned in case the list wallets API call returns an error.
+	t.Run("ListWallets returns an error", func(t *testing.T) {
+
+		// Setup Expectations
+		mockWallet.EXPECT().ListWallets(mock.Anything, mock.Anything).Times(1).
+			Return(nil, apiError)
+
+		res, diags := pr.readWalletToken(ctx, prm)
+		assert.NotNil(t, diags)
+		assert.Nil(t, res)
+	})
+}
+
+// Unit test for the following cases:
+//   - Parse import ID with the account ID and region.
+//   - Parse invalid import IDs, including IDs holding the token, returns an error.
+func TestParseImportId(t *testing.T) {
+
+	t.Run("Parse import ID with the account ID and region", func(t *testing.T) {
+		importId := resourceId(accountId, region)
+		importAccountId, importRegion, diags := parseImportId(importId)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, accountId, importAccountId)
+		assert.Equal(t, region, importRegion)
+	})
+
+	t.Run("Parse invalid import IDs", func(t *testing.T) {
+		for _, importId := range []string{accountId, accountId + "/", "/" + region,
+			fmt.Sprintf("%s/%s/%s", accountId, region, token), "a//c"} {
+			_, _, diags := parseImportId(importId)
+			assert.True(t, diags.HasError(), importId)
+		}
+	})
 }
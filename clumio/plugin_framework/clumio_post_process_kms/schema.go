@@ -8,10 +8,15 @@ package clumio_post_process_kms
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +26,8 @@ import (
 type clumioPostProcessKmsResourceModel struct {
 	Id                     types.String `tfsdk:"id"`
 	Token                  types.String `tfsdk:"token"`
+	TokenWo                types.String `tfsdk:"token_wo"`
+	TokenWoVersion         types.Int64  `tfsdk:"token_wo_version"`
 	AccountId              types.String `tfsdk:"account_id"`
 	Region                 types.String `tfsdk:"region"`
 	RoleId                 types.String `tfsdk:"role_id"`
@@ -32,6 +39,14 @@ type clumioPostProcessKmsResourceModel struct {
 	ClumioIAMRolePrincipal types.String `tfsdk:"clumio_iam_role_principal"`
 }
 
+// postProcessKmsResourceIdentityModel is the identity model for the clumio_post_process_kms
+// Terraform resource. The post-processed BYOK is identified by the AWS account and region of the
+// wallet.
+type postProcessKmsResourceIdentityModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+	Region    types.String `tfsdk:"region"`
+}
+
 // Schema defines the structure and constraints of the clumio_post_process_kms Terraform resource.
 // Schema is a method on the clumioPostProcessKmsResource struct. It sets the schema for the
 // clumio_post_process_kms Terraform resource.
@@ -50,8 +65,29 @@ func (r *clumioPostProcessKmsResource) Schema(
 			},
 			schemaToken: schema.StringAttribute{
 				Description: "Distinct 36-character token used to identify resources set up by " +
-					"the Clumio BYOK template installation on the account being connected.",
-				Required: true,
+					"the Clumio BYOK template installation on the account being connected. " +
+					"Exactly one of token or token_wo must be set.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot(schemaTokenWo)),
+				},
+			},
+			schemaTokenWo: schema.StringAttribute{
+				Description: "Write-only alternative to token which is never persisted to the " +
+					"Terraform state. Requires Terraform 1.11 or later.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			schemaTokenWoVersion: schema.Int64Attribute{
+				Description: "Version of the value given in token_wo. As changes to write-only " +
+					"attributes are not detected by Terraform, this must be changed whenever " +
+					"token_wo is changed in order for the new token to be applied.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot(schemaTokenWo)),
+				},
 			},
 			schemaAccountId: schema.StringAttribute{
 				Description: "Identifier of the AWS account linked with Clumio.",
@@ -93,3 +129,22 @@ func (r *clumioPostProcessKmsResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_post_process_kms Terraform resource, which is
+// the AWS account and region of the wallet.
+func (r *clumioPostProcessKmsResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaAccountId: identityschema.StringAttribute{
+				Description:       "Identifier of the AWS account linked with Clumio.",
+				RequiredForImport: true,
+			},
+			schemaRegion: identityschema.StringAttribute{
+				Description:       "Region of the AWS account linked with Clumio.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
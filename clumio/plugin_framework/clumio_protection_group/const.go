@@ -6,6 +6,8 @@ const (
 	// Constants used by the resource model for the clumio_protection_group Terraform resource. These
 	// values should match the schema tfsdk tags on the resource model struct in schema.go.
 	schemaId                            = "id"
+	schemaOrganizationalUnitContext     = "organizational_unit_context"
+	schemaDeletionProtection            = "deletion_protection"
 	schemaBucketRule                    = "bucket_rule"
 	schemaDescription                   = "description"
 	schemaName                          = "name"
@@ -21,4 +23,5 @@ const (
 	schemaInheritingEntityType          = "inheriting_entity_type"
 	schemaProtectionStatus              = "protection_status"
 	schemaEarliestLastModifiedTimestamp = "earliest_last_modified_timestamp"
+	schemaTimeouts                      = "timeouts"
 )
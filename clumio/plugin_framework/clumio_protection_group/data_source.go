@@ -23,7 +23,7 @@ func (r *clumioProtectionGroupDataSource) readProtectionGroup(
 
 	// Prepare the query filter.
 	name := model.Name.ValueString()
-	filter := fmt.Sprintf(`{"name": {"$eq":"%s"}}`, name)
+	filter := common.NewFilter().Eq("name", name).String()
 
 	// Call the Clumio API to list the protection groups.
 	res, apiErr := r.protectionGroupClient.ListProtectionGroups(nil, nil, &filter, nil)
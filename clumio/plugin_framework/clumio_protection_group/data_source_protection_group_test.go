@@ -61,7 +61,7 @@ func TestAccDataSourceClumioEmptyProtectionGroup(t *testing.T) {
 func getTestDataSourceClumioProtectionGroup(
 	baseUrl string, invalidName bool, emptyName bool) string {
 
-	name := "ds_test_pg"
+	name := "acceptance-test-ds-pg"
 
 	if invalidName {
 		name = "some-name"
@@ -81,7 +81,7 @@ provider clumio{
 
 resource "clumio_protection_group" "ds_test_pg"{
   bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
-  name = "ds_test_pg"
+  name = "acceptance-test-ds-pg"
   description = "Acceptance test protection group for protection group data source."
   object_filter {
 	storage_classes = ["S3 Intelligent-Tiering", "S3 One Zone-IA", "S3 Standard", "S3 Standard-IA", "S3 Reduced Redundancy"]
@@ -20,8 +20,9 @@ import (
 // represents the schema of the datasource and the data it holds. This schema is used by customers
 // to configure the datasource and by the Clumio provider to read and write the datasource.
 type clumioProtectionGroupDataSourceModel struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	Id                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
 }
 
 // Schema defines the structure and constraints of the clumio_protection_group Terraform datasource.
@@ -33,6 +34,15 @@ func (r *clumioProtectionGroupDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" data source is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier of the protection group.",
 				Computed:    true,
@@ -0,0 +1,54 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_protection_group Terraform list resource.
+
+package clumio_protection_group
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clumioProtectionGroupListResourceModel is the list resource model for the clumio_protection_group
+// Terraform list resource. It represents the filters of the list block used by `terraform query`
+// to select the protection groups to list.
+type clumioProtectionGroupListResourceModel struct {
+	Name                      types.String `tfsdk:"name"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_protection_group Terraform list resource. Its filters are the same as the ones of the
+// clumio_protection_group data source, and every protection group is listed if none is set.
+func (r *clumioProtectionGroupListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_protection_group list resource is used to list the existing" +
+			" protection groups with `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" protection groups are listed. If not set, the" +
+					" clumio_organizational_unit_context of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaName: schema.StringAttribute{
+				Description: "Lists the protection group with the given name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in list_resource.go
+
+//go:build unit
+
+package clumio_protection_group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Unit test for the following cases:
+//   - List protection groups success scenario.
+//   - SDK API for list protection groups returns an error.
+//   - SDK API for list protection groups returns an empty response.
+func TestListProtectionGroups(t *testing.T) {
+
+	ctx := context.Background()
+	mockClient := sdkclients.NewMockProtectionGroupClient(t)
+	id := "test-id"
+	name := "test-name"
+	testError := "Test Error"
+
+	lr := &clumioProtectionGroupListResource{
+		resource: &clumioProtectionGroupResource{
+			name:                "clumio_protection_group",
+			sdkProtectionGroups: mockClient,
+		},
+	}
+	model := &clumioProtectionGroupListResourceModel{
+		Name: basetypes.NewStringValue(name),
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests the success scenario for listing the protection groups. It should not return
+	// Diagnostics.
+	t.Run("Basic success scenario for list protection groups", func(t *testing.T) {
+
+		listResponse := &models.ListProtectionGroupsResponse{
+			Embedded: &models.ProtectionGroupListEmbedded{
+				Items: []*models.ProtectionGroup{
+					{
+						Id:   &id,
+						Name: &name,
+					},
+				},
+			},
+		}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListProtectionGroups(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listProtectionGroups(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Equal(t, []common.ListItem{
+			{
+				Id:          id,
+				DisplayName: name,
+				Identity: protectionGroupResourceIdentityModel{
+					ID: basetypes.NewStringValue(id),
+				},
+			},
+		}, items)
+	})
+
+	// Tests that Diagnostics is returned in case the list protection groups API call returns an
+	// error.
+	t.Run("list protection groups returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListProtectionGroups(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := lr.listProtectionGroups(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list protection groups API call returns an
+	// empty response.
+	t.Run("list protection groups returns an empty response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListProtectionGroups(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := lr.listProtectionGroups(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
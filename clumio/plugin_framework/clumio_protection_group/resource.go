@@ -13,10 +13,20 @@ import (
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// apiFieldPaths maps the fields of the protection group requests to the attributes they are set
+// from, so that the field errors returned by the Clumio API are reported on those attributes.
+var apiFieldPaths = common.ApiFieldPaths{
+	schemaName:         path.MatchRoot(schemaName),
+	schemaDescription:  path.MatchRoot(schemaDescription),
+	schemaBucketRule:   path.MatchRoot(schemaBucketRule),
+	schemaObjectFilter: path.MatchRoot(schemaObjectFilter),
+}
+
 // createProtectionGroup invokes the API to create the protection group and from the response
 // populates the computed attributes of the protection group.
 func (r *clumioProtectionGroupResource) createProtectionGroup(
@@ -36,8 +46,7 @@ func (r *clumioProtectionGroupResource) createProtectionGroup(
 		})
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to create %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if response == nil {
@@ -49,7 +58,8 @@ func (r *clumioProtectionGroupResource) createProtectionGroup(
 
 	// Poll to read the protection group till it becomes available
 	readResponse, err := common.PollForProtectionGroup(
-		ctx, *response.Id, sdkProtectionGroups, r.pollTimeout, r.pollInterval)
+		ctx, *response.Id, sdkProtectionGroups, common.PollTimeout(ctx, r.pollTimeout),
+		r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf("Unable to poll %s (ID: %v) for creation",
 			r.name, plan.Name.ValueString())
@@ -166,8 +176,7 @@ func (r *clumioProtectionGroupResource) updateProtectionGroup(
 		updateReq)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to update %s (ID: %v)", r.name, plan.ID.ValueString())
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if response == nil {
@@ -179,7 +188,8 @@ func (r *clumioProtectionGroupResource) updateProtectionGroup(
 
 	// Poll to read the protection group till it is updated
 	readResponse, err := common.PollForProtectionGroupUpdate(
-		ctx, *response.Id, version, updateReq, sdkProtectionGroups, r.pollTimeout, r.pollInterval)
+		ctx, *response.Id, version, updateReq, sdkProtectionGroups,
+		common.PollTimeout(ctx, r.pollTimeout), r.pollInterval)
 	if err != nil {
 		summary := fmt.Sprintf(
 			"Unable to poll %s (ID: %v) for update", r.name, plan.ID.ValueString())
@@ -205,7 +215,13 @@ func (r *clumioProtectionGroupResource) updateProtectionGroup(
 func (r *clumioProtectionGroupResource) deleteProtectionGroup(
 	_ context.Context, state *clumioProtectionGroupResourceModel) diag.Diagnostics {
 
-	var diags diag.Diagnostics
+	// Refuse to delete the protection group if its deletion protection is enabled.
+	diags := common.CheckDeletionProtection(
+		r.client, state.DeletionProtection, r.name, state.ID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
 	sdkProtectionGroups := r.sdkProtectionGroups
 
 	// Call the Clumio API to delete the protection group
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -21,6 +22,7 @@ var (
 	_ resource.Resource                = &clumioProtectionGroupResource{}
 	_ resource.ResourceWithConfigure   = &clumioProtectionGroupResource{}
 	_ resource.ResourceWithImportState = &clumioProtectionGroupResource{}
+	_ resource.ResourceWithIdentity    = &clumioProtectionGroupResource{}
 )
 
 // clumioProtectionGroupResource is the struct backing the clumio_protection_group Terraform
@@ -53,17 +55,33 @@ func (r *clumioProtectionGroupResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioProtectionGroupResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(
+		r.client.ClumioConfig, middlewares...)
 	r.pollInterval = 5 * time.Second
 	r.pollTimeout = 300 * time.Second
 }
 
+// configureForOU creates the SDK clients of the resource, making the calls in the context of the
+// organizational unit given by its organizational_unit_context attribute, if set. The clients are
+// created for every operation after its timeout is applied to the given context, to which the
+// middlewares are bound, so that the retries, the limiter and the polls honor the timeout.
+func (r *clumioProtectionGroupResource) configureForOU(
+	ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		config = r.client.ClumioConfig
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(config, middlewares...)
+}
+
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
 func (r *clumioProtectionGroupResource) Create(
 	ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -76,6 +94,19 @@ func (r *clumioProtectionGroupResource) Create(
 		return
 	}
 
+	// Bound the operation by the create timeout given in the timeouts block, if any.
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.createProtectionGroup(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -88,6 +119,10 @@ func (r *clumioProtectionGroupResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, protectionGroupResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -102,6 +137,19 @@ func (r *clumioProtectionGroupResource) Read(
 		return
 	}
 
+	// Bound the operation by the read timeout given in the timeouts block, if any.
+	readTimeout, diags := state.Timeouts.Read(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	remove, diags := r.readProtectionGroup(ctx, &state)
 	if remove {
 		resp.State.RemoveResource(ctx)
@@ -118,6 +166,10 @@ func (r *clumioProtectionGroupResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, protectionGroupResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -132,6 +184,19 @@ func (r *clumioProtectionGroupResource) Update(
 		return
 	}
 
+	// Bound the operation by the update timeout given in the timeouts block, if any.
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.updateProtectionGroup(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -144,6 +209,10 @@ func (r *clumioProtectionGroupResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, protectionGroupResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -158,14 +227,27 @@ func (r *clumioProtectionGroupResource) Delete(
 		return
 	}
 
+	// Bound the operation by the delete timeout given in the timeouts block, if any.
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.pollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Create the clients bound to the timeout, making the calls in the context of the
+	// organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	diags = r.deleteProtectionGroup(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done by the ID of the resource, given either as the import ID or in the resource identity.
 func (r *clumioProtectionGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve the ID from the import ID or identity and save it to the id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,134 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the acceptance tests of the clumio_protection_group Terraform resource which run
+// against the fake Clumio API. Please view the README.md file for more information on how to run
+// these tests.
+
+//go:build fake
+
+package clumio_protection_group_test
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	clumiopf "github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Test of the clumio_protection_group resource against the fake Clumio API. It tests the following
+// scenarios:
+//   - Creates a protection group and verifies that the plan was applied properly.
+//   - Updates the protection group and verifies that the update is applied.
+//   - Imports the protection group by ID.
+//   - Changes the protection group outside of Terraform and verifies that the drift is planned to
+//     be undone.
+//   - Deletes the protection group outside of Terraform and verifies that it is planned to be
+//     recreated.
+func TestAccFakeResourceClumioProtectionGroup(t *testing.T) {
+
+	server := clumiopf.UtilTestAccStartFakeApi(t)
+	resourceName := "clumio_protection_group.test_pg"
+	var id string
+	updatedConfig := getTestAccFakeResourceClumioProtectionGroup("test-pg-updated")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: clumiopf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccFakeResourceClumioProtectionGroup("test-pg"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "test-pg"),
+					resource.TestCheckResourceAttr(
+						resourceName, "protection_status", "unprotected"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: getTestAccFakeResourceClumioProtectionGroup("test-pg-updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "test-pg-updated"),
+					checkFakeProtectionGroupDescription(server, &id, "test-pg-updated"),
+				),
+			},
+			clumiopf.UtilTestAccFakeImportStep(resourceName, updatedConfig, &id),
+			clumiopf.UtilTestAccFakeDriftStep(server, fakeapi.ProtectionGroups, &id,
+				map[string]any{"description": "changed-outside-of-terraform"}, resourceName,
+				updatedConfig, checkFakeProtectionGroupDescription(server, &id, "test-pg-updated")),
+			// The Clumio API keeps the deleted protection groups and marks them deleted.
+			clumiopf.UtilTestAccFakeSoftDeleteStep(
+				server, fakeapi.ProtectionGroups, &id, resourceName, updatedConfig),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			for _, group := range server.Objects(fakeapi.ProtectionGroups) {
+				if group["is_deleted"] != true {
+					return fmt.Errorf(
+						"expected protection group %v to be deleted after destroy", group["id"])
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// checkFakeProtectionGroupDescription returns the check verifying that the protection group with
+// the given ID has the given description in the fake Clumio API.
+func checkFakeProtectionGroupDescription(
+	server *fakeapi.Server, id *string, description string) resource.TestCheckFunc {
+
+	return func(_ *terraform.State) error {
+		group, ok := server.Object(fakeapi.ProtectionGroups, *id)
+		if !ok {
+			return fmt.Errorf("protection group %s not found in the fake Clumio API", *id)
+		}
+		if group["description"] != description {
+			return fmt.Errorf("expected protection group description %q, found %q",
+				description, group["description"])
+		}
+		return nil
+	}
+}
+
+// getTestAccFakeResourceClumioProtectionGroup returns the Terraform configuration of a protection
+// group with the given description for the acceptance tests which run against the fake Clumio API.
+func getTestAccFakeResourceClumioProtectionGroup(description string) string {
+	return fmt.Sprintf(testAccFakeResourceClumioProtectionGroup, description)
+}
+
+// testAccFakeResourceClumioProtectionGroup is the Terraform configuration of a protection group.
+// The provider reads the base URL and token of the fake Clumio API from the environment.
+const testAccFakeResourceClumioProtectionGroup = `
+resource "clumio_protection_group" "test_pg"{
+  bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
+  name = "acceptance-test-pg"
+  description = "%s"
+  object_filter {
+	storage_classes = ["S3 Standard", "S3 Standard-IA"]
+  }
+}
+`
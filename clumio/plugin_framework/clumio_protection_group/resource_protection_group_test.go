@@ -232,7 +232,7 @@ func getTestAccResourceClumioProtectionGroup(description bool, prefixFilter bool
 // createProtectionGroupUsingSDK creates a protection group using Clumio SDK for testing purpose
 func createProtectionGroupUsingSDK() (string, error) {
 
-	name := "test_pg_1"
+	name := "acceptance-test-pg-1"
 	bucket_rule := "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
 	description := "test_description"
 	clumioApiToken := os.Getenv(common.ClumioApiToken)
@@ -284,7 +284,7 @@ provider clumio{
 
 resource "clumio_protection_group" "test_pg"{
   bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
-  name = "test_pg_1"
+  name = "acceptance-test-pg-1"
   %s
   object_filter {
 	%s
@@ -302,7 +302,7 @@ provider clumio{
 
 resource "clumio_protection_group" "test_pg"{
   bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
-  name = "test_pg_1"
+  name = "acceptance-test-pg-1"
   description = "test_pg_1"
   object_filter {
 	storage_classes = ["S3 Intelligent-Tiering", "S3 One Zone-IA", "S3 Standard", "S3 Standard-IA", "S3 Reduced Redundancy"]
@@ -311,7 +311,7 @@ resource "clumio_protection_group" "test_pg"{
 
 resource "clumio_protection_group" "test_pg2"{
   bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
-  name = "test_pg_1"
+  name = "acceptance-test-pg-1"
   description = "test_pg_1"
   object_filter {
 	storage_classes = ["S3 Intelligent-Tiering", "S3 One Zone-IA", "S3 Standard", "S3 Standard-IA", "S3 Reduced Redundancy"]
@@ -327,7 +327,7 @@ provider clumio{
 }
 
 resource "clumio_protection_group" "test_pg"{
-  name = "test_pg_1"
+  name = "acceptance-test-pg-1"
   object_filter {
 	storage_classes = ["S3 Intelligent-Tiering", "S3 One Zone-IA", "S3 Standard", "S3 Standard-IA", "S3 Reduced Redundancy"]
   }
@@ -17,6 +17,7 @@ import (
 	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
@@ -498,6 +499,7 @@ func TestUpdateProtectionGroup(t *testing.T) {
 //   - Delete protection group success scenario.
 //   - Delete protection group should not return an error if protection group is not found.
 //   - SDK API for delete protection group returns an error.
+//   - Deletion protection prevents the deletion of the protection group.
 func TestDeleteProtectionGroup(t *testing.T) {
 
 	mockProtectionGroup := sdkclients.NewMockProtectionGroupClient(t)
@@ -575,4 +577,30 @@ func TestDeleteProtectionGroup(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 
+	// Tests that Diagnostics is returned without calling the API when deletion protection is
+	// enabled by the resource or by the provider.
+	t.Run("Deletion protection prevents the deletion", func(t *testing.T) {
+		protected := *pgrm
+		protected.DeletionProtection = basetypes.NewBoolValue(true)
+		diags := pr.deleteProtectionGroup(ctx, &protected)
+		assert.True(t, diags.HasError())
+
+		providerProtected := pr
+		providerProtected.client = &common.ApiClient{DeletionProtection: true}
+		diags = providerProtected.deleteProtectionGroup(ctx, pgrm)
+		assert.True(t, diags.HasError())
+	})
+}
+
+// Unit test for apiFieldPaths for the following cases:
+//   - Field errors for the fields of the protection group requests are reported on the attributes
+//     they are set from.
+//   - Field errors for unknown fields are reported without an attribute.
+func TestApiFieldPaths(t *testing.T) {
+
+	common.RunApiFieldPathsTests(t, apiFieldPaths, map[string]path.Path{
+		"bucket_rule":                            path.Root(schemaBucketRule),
+		"object_filter.prefix_filters[0].prefix": path.Root(schemaObjectFilter),
+		"unknown_field":                          path.Empty(),
+	})
 }
@@ -10,8 +10,11 @@ import (
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,13 +28,23 @@ import (
 // is used by customers to configure the resource and by the Clumio provider to read and write the
 // resource.
 type clumioProtectionGroupResourceModel struct {
-	ID               types.String         `tfsdk:"id"`
-	Name             types.String         `tfsdk:"name"`
-	Description      types.String         `tfsdk:"description"`
-	BucketRule       types.String         `tfsdk:"bucket_rule"`
-	ObjectFilter     []*objectFilterModel `tfsdk:"object_filter"`
-	ProtectionStatus types.String         `tfsdk:"protection_status"`
-	ProtectionInfo   types.List           `tfsdk:"protection_info"`
+	ID                        types.String         `tfsdk:"id"`
+	Name                      types.String         `tfsdk:"name"`
+	Description               types.String         `tfsdk:"description"`
+	BucketRule                types.String         `tfsdk:"bucket_rule"`
+	ObjectFilter              []*objectFilterModel `tfsdk:"object_filter"`
+	ProtectionStatus          types.String         `tfsdk:"protection_status"`
+	ProtectionInfo            types.List           `tfsdk:"protection_info"`
+	OrganizationalUnitContext types.String         `tfsdk:"organizational_unit_context"`
+	DeletionProtection        types.Bool           `tfsdk:"deletion_protection"`
+	Timeouts                  timeouts.Value       `tfsdk:"timeouts"`
+}
+
+// protectionGroupResourceIdentityModel is the identity model for the clumio_protection_group
+// Terraform resource. It identifies the protection group when importing it and when listing
+// protection groups with `terraform query`.
+type protectionGroupResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
 }
 
 // objectFilterModel maps to the 'object_filter' field in clumioProtectionGroupResourceModel and
@@ -57,7 +70,7 @@ type prefixFilterModel struct {
 // etc. Some of these attributes are computed, meaning they are determined by Clumio at runtime,
 // while others are required or optional inputs from the user.
 func (r *clumioProtectionGroupResource) Schema(
-	_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	prefixFilterSchemaAttributes := map[string]schema.Attribute{
 		schemaExcludedSubPrefixes: schema.SetAttribute{
 			Description: "List of subprefixes to exclude from the prefix.",
@@ -110,6 +123,24 @@ func (r *clumioProtectionGroupResource) Schema(
 		// This description is used by the documentation generator and the language server.
 		Description: "Clumio S3 Protection Group Resource used to create and manage Protection Groups.",
 		Attributes: map[string]schema.Attribute{
+			schemaDeletionProtection: schema.BoolAttribute{
+				Description: "Whether to prevent the deletion of the protection group. If not" +
+					" set, the deletion_protection of the provider is used. To delete the" +
+					" protection group, set it to false and apply the change first.",
+				Optional: true,
+			},
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier for the Clumio Protection Group.",
 				Computed:    true,
@@ -180,6 +211,24 @@ func (r *clumioProtectionGroupResource) Schema(
 					common.WrapSetValidator(setvalidator.SizeAtMost(1)),
 				},
 			},
+			schemaTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true, Read: true, Update: true, Delete: true,
+			}),
+		},
+	}
+}
+
+// IdentitySchema defines the identity of the clumio_protection_group Terraform resource, which is
+// the ID of the protection group.
+func (r *clumioProtectionGroupResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the protection group.",
+				RequiredForImport: true,
+			},
 		},
 	}
 }
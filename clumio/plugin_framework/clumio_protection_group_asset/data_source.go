@@ -11,7 +11,6 @@ import (
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
-	"strings"
 )
 
 // readProtectionGroupAsset invokes the API to read the s3BucketClient and from the response
@@ -20,14 +19,12 @@ func (r *clumioProtectionGroupAssetDataSource) readProtectionGroupAsset(
 	ctx context.Context, model *clumioProtectionGroupAssetDataSourceModel) diag.Diagnostics {
 
 	var diags diag.Diagnostics
-	filters := make([]string, 0)
 	pgId := model.ProtectionGroupID.ValueString()
-	pgFilter := fmt.Sprintf(`"protection_group_id": {"$eq":"%s"}`, pgId)
-	filters = append(filters, pgFilter)
 	bucketId := model.BucketID.ValueString()
-	bucketFilter := fmt.Sprintf(`"bucket_id": {"$eq":"%s"}`, bucketId)
-	filters = append(filters, bucketFilter)
-	filter := fmt.Sprintf("{%s}", strings.Join(filters, ","))
+	filter := common.NewFilter().
+		Eq("protection_group_id", pgId).
+		Eq("bucket_id", bucketId).
+		String()
 
 	// Call the Clumio API to list the S3 Assets for the protection group.
 	readResponse, apiErr := r.s3AssetsClient.ListProtectionGroupS3Assets(nil, nil, &filter, nil)
@@ -47,12 +47,14 @@ func (r *clumioProtectionGroupAssetDataSource) Metadata(
 // Configure sets up the datasource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioProtectionGroupAssetDataSource) Configure(
-	_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.s3AssetsClient = sdkclients.NewProtectionGroupS3AssetsClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.s3AssetsClient = sdkclients.NewProtectionGroupS3AssetsClient(
+		r.client.ClumioConfig, middlewares...)
 }
 
 // Read retrieves the datasource from the Clumio API and sets the Terraform state.
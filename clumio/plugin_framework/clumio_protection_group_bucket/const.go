@@ -6,7 +6,8 @@ const (
 	// Constants used by the resource model for the clumio_protection_group_bucket Terraform
 	// resource. These values should match the schema tfsdk tags on the resource model struct in
 	// schema.go.
-	schemaId                = "id"
-	schemaBucketId          = "bucket_id"
-	schemaProtectionGroupId = "protection_group_id"
+	schemaId                        = "id"
+	schemaOrganizationalUnitContext = "organizational_unit_context"
+	schemaBucketId                  = "bucket_id"
+	schemaProtectionGroupId         = "protection_group_id"
 )
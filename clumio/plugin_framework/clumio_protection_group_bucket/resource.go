@@ -115,3 +115,40 @@ func (r *clumioProtectionGroupBucketResource) deleteProtectionGroupBucket(
 	}
 	return diags
 }
+
+// findProtectionGroupBucketId invokes the API to list the protection group assets and returns the
+// ID of the asset of the protection group and bucket of the given identity.
+func (r *clumioProtectionGroupBucketResource) findProtectionGroupBucketId(_ context.Context,
+	identity protectionGroupBucketResourceIdentityModel) (string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+
+	// Call the Clumio API to list the asset of the bucket in the protection group.
+	pgId := identity.ProtectionGroupID.ValueString()
+	bucketId := identity.BucketID.ValueString()
+	filter := common.NewFilter().
+		Eq("protection_group_id", pgId).
+		Eq("bucket_id", bucketId).
+		String()
+	res, apiErr := r.sdkS3Assets.ListProtectionGroupS3Assets(nil, nil, &filter, nil)
+	if apiErr != nil {
+		summary := fmt.Sprintf("Unable to import %s", r.name)
+		detail := common.ParseMessageFromApiError(apiErr)
+		diags.AddError(summary, detail)
+		return "", diags
+	}
+	if res == nil {
+		summary := common.NilErrorMessageSummary
+		detail := common.NilErrorMessageDetail
+		diags.AddError(summary, detail)
+		return "", diags
+	}
+	if res.Embedded == nil || len(res.Embedded.Items) == 0 || res.Embedded.Items[0].Id == nil {
+		summary := "Protection group bucket not found"
+		detail := fmt.Sprintf("Bucket with ID %s is not part of Protection Group with ID %s.",
+			bucketId, pgId)
+		diags.AddError(summary, detail)
+		return "", diags
+	}
+	return *res.Embedded.Items[0].Id, diags
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -20,6 +21,7 @@ var (
 	_ resource.Resource                = &clumioProtectionGroupBucketResource{}
 	_ resource.ResourceWithConfigure   = &clumioProtectionGroupBucketResource{}
 	_ resource.ResourceWithImportState = &clumioProtectionGroupBucketResource{}
+	_ resource.ResourceWithIdentity    = &clumioProtectionGroupBucketResource{}
 )
 
 // clumioProtectionGroupBucketResource is the struct backing the clumio_protection_group_bucket
@@ -51,14 +53,30 @@ func (r *clumioProtectionGroupBucketResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioProtectionGroupBucketResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(r.client.ClumioConfig)
-	r.sdkS3Assets = sdkclients.NewProtectionGroupS3AssetsClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(
+		r.client.ClumioConfig, middlewares...)
+	r.sdkS3Assets = sdkclients.NewProtectionGroupS3AssetsClient(
+		r.client.ClumioConfig, middlewares...)
+}
+
+// configureForOU makes the calls of the resource in the context of the organizational unit
+// given by its organizational_unit_context attribute, if set.
+func (r *clumioProtectionGroupBucketResource) configureForOU(
+	ctx context.Context, ouContext types.String) {
+	config, ok := common.GetSDKConfigForOUContext(r.client, ouContext)
+	if !ok {
+		return
+	}
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkProtectionGroups = sdkclients.NewProtectionGroupClient(config, middlewares...)
+	r.sdkS3Assets = sdkclients.NewProtectionGroupS3AssetsClient(config, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -73,6 +91,9 @@ func (r *clumioProtectionGroupBucketResource) Create(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, plan.OrganizationalUnitContext)
+
 	diags = r.createProtectionGroupBucket(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -85,6 +106,13 @@ func (r *clumioProtectionGroupBucketResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, protectionGroupBucketResourceIdentityModel{
+		ProtectionGroupID: plan.ProtectionGroupID,
+		BucketID:          plan.BucketID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -99,6 +127,9 @@ func (r *clumioProtectionGroupBucketResource) Read(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	remove, diags := r.readProtectionGroupBucket(ctx, &state)
 	if remove {
 		resp.State.RemoveResource(ctx)
@@ -115,6 +146,13 @@ func (r *clumioProtectionGroupBucketResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, protectionGroupBucketResourceIdentityModel{
+		ProtectionGroupID: state.ProtectionGroupID,
+		BucketID:          state.BucketID,
+	})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -135,14 +173,38 @@ func (r *clumioProtectionGroupBucketResource) Delete(
 		return
 	}
 
+	// Make the calls in the context of the organizational unit of the resource, if set.
+	r.configureForOU(ctx, state.OrganizationalUnitContext)
+
 	diags = r.deleteProtectionGroupBucket(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
-func (r *clumioProtectionGroupBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
-	resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// is done either by the ID of the resource or by its identity, in which case the ID is looked up
+// from the protection group and bucket of the identity.
+func (r *clumioProtectionGroupBucketResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	if req.ID != "" {
+		resource.ImportStatePassthroughID(ctx, path.Root(schemaId), req, resp)
+		return
+	}
+
+	// Retrieve the identity given in the import block.
+	var identity protectionGroupBucketResourceIdentityModel
+	diags := req.Identity.Get(ctx, &identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up the ID of the asset of the bucket in the protection group of the identity.
+	id, diags := r.findProtectionGroupBucketId(ctx, identity)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.SetAttribute(ctx, path.Root(schemaId), id)
+	resp.Diagnostics.Append(diags...)
 }
@@ -165,7 +165,7 @@ provider clumio{
 
 resource "clumio_protection_group" "test_pg_assignment"{
   bucket_rule = "{\"aws_tag\":{\"$eq\":{\"key\":\"Environment\", \"value\":\"Prod\"}}}"
-  name = "test_pg_assignment"
+  name = "acceptance-test-pg-assignment"
   description = "test_pg_assignment"
   object_filter {
 	storage_classes = ["S3 Intelligent-Tiering", "S3 One Zone-IA", "S3 Standard", "S3 Standard-IA", "S3 Reduced Redundancy"]
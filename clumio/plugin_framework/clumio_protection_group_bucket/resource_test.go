@@ -285,3 +285,103 @@ func TestDeleteProtectionGroupBucket(t *testing.T) {
 	})
 
 }
+
+// Unit test for the following cases:
+//   - Find protection group bucket ID success scenario.
+//   - SDK API for list protection group s3 assets returns no asset.
+//   - SDK API for list protection group s3 assets returns an error.
+//   - SDK API for list protection group s3 assets returns an empty response.
+func TestFindProtectionGroupBucketId(t *testing.T) {
+
+	mockSDKProtectionGroupS3Assets := sdkclients.NewMockProtectionGroupS3AssetsClient(t)
+	ctx := context.Background()
+	pr := clumioProtectionGroupBucketResource{
+		name:        resourceName,
+		sdkS3Assets: mockSDKProtectionGroupS3Assets,
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	identity := protectionGroupBucketResourceIdentityModel{
+		ProtectionGroupID: basetypes.NewStringValue(pgId),
+		BucketID:          basetypes.NewStringValue(bucketId),
+	}
+
+	// Tests the success scenario for finding the ID of the protection group bucket. It should not
+	// return Diagnostics.
+	t.Run("Basic success scenario for find protection group bucket id", func(t *testing.T) {
+
+		listResponse := &models.ListProtectionGroupS3AssetsResponse{
+			Embedded: &models.ProtectionGroupBucketListEmbedded{
+				Items: []*models.ProtectionGroupBucket{
+					{
+						Id:       &id,
+						BucketId: &bucketId,
+						GroupId:  &pgId,
+					},
+				},
+			},
+		}
+
+		// Setup Expectations
+		mockSDKProtectionGroupS3Assets.EXPECT().ListProtectionGroupS3Assets(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(1).
+			Return(listResponse, nil)
+
+		assetId, diags := pr.findProtectionGroupBucketId(ctx, identity)
+		assert.Nil(t, diags)
+		assert.Equal(t, id, assetId)
+	})
+
+	// Tests that Diagnostics is returned in case the bucket of the identity is not part of its
+	// protection group.
+	t.Run("list protection group s3 assets returns no asset", func(t *testing.T) {
+
+		listResponse := &models.ListProtectionGroupS3AssetsResponse{
+			Embedded: &models.ProtectionGroupBucketListEmbedded{
+				Items: []*models.ProtectionGroupBucket{},
+			},
+		}
+
+		// Setup Expectations
+		mockSDKProtectionGroupS3Assets.EXPECT().ListProtectionGroupS3Assets(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(1).
+			Return(listResponse, nil)
+
+		_, diags := pr.findProtectionGroupBucketId(ctx, identity)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list protection group s3 assets API call
+	// returns an error.
+	t.Run("list protection group s3 assets returns an error", func(t *testing.T) {
+
+		// Setup Expectations
+		mockSDKProtectionGroupS3Assets.EXPECT().ListProtectionGroupS3Assets(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(1).
+			Return(nil, apiError)
+
+		_, diags := pr.findProtectionGroupBucketId(ctx, identity)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list protection group s3 assets API call
+	// returns an empty response.
+	t.Run("list protection group s3 assets returns an empty response", func(t *testing.T) {
+
+		// Setup Expectations
+		mockSDKProtectionGroupS3Assets.EXPECT().ListProtectionGroupS3Assets(
+			mock.Anything, mock.Anything, mock.Anything, mock.Anything).Times(1).
+			Return(nil, nil)
+
+		_, diags := pr.findProtectionGroupBucketId(ctx, identity)
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,9 +23,19 @@ import (
 // the data it holds. This schema is used by customers to configure the resource and by the Clumio
 // provider to read and write the resource.
 type clumioProtectionGroupBucketResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	BucketID          types.String `tfsdk:"bucket_id"`
+	ID                        types.String `tfsdk:"id"`
+	BucketID                  types.String `tfsdk:"bucket_id"`
+	ProtectionGroupID         types.String `tfsdk:"protection_group_id"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+}
+
+// protectionGroupBucketResourceIdentityModel is the identity model for the
+// clumio_protection_group_bucket Terraform resource. A protection group bucket is identified by the
+// protection group and the bucket it assigns, as a bucket can be assigned only once to a protection
+// group.
+type protectionGroupBucketResourceIdentityModel struct {
 	ProtectionGroupID types.String `tfsdk:"protection_group_id"`
+	BucketID          types.String `tfsdk:"bucket_id"`
 }
 
 // Schema defines the structure and constraints of the clumio_protection_group_bucket Terraform
@@ -41,6 +52,18 @@ func (r *clumioProtectionGroupBucketResource) Schema(
 		Description: "Clumio S3 Protection Group Bucket Resource used to assign a bucket to a " +
 			"Protection Group.",
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" resource is managed. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used. Changing it forces the resource to be replaced.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			schemaId: schema.StringAttribute{
 				Description: "Unique identifier for the Clumio Protection Group bucket association.",
 				Computed:    true,
@@ -71,3 +94,22 @@ func (r *clumioProtectionGroupBucketResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_protection_group_bucket Terraform resource,
+// which is the protection group and the bucket it assigns.
+func (r *clumioProtectionGroupBucketResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaProtectionGroupId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the Protection Group.",
+				RequiredForImport: true,
+			},
+			schemaBucketId: identityschema.StringAttribute{
+				Description:       "Clumio assigned unique identifier of the AWS S3 bucket.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
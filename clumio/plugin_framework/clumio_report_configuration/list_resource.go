@@ -0,0 +1,57 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the logic to invoke the Clumio Report Configuration SDK API to list the report
+// configurations matching the filters of the list block of the list resource.
+
+package clumio_report_configuration
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listReportConfigurations invokes the API to list the report configurations matching the filters
+// of the given list block. The report configurations are filtered by name after listing them.
+func (r *clumioReportConfigurationListResource) listReportConfigurations(ctx context.Context,
+	model *clumioReportConfigurationListResourceModel, options common.ListOptions) (
+	[]common.ListItem, diag.Diagnostics) {
+
+	name := model.Name.ValueString()
+
+	// Call the Clumio API to list the report configurations, reading every page.
+	return common.ListAll(ctx, r.resource.name, options,
+		func(limit *int64, start *string) (*common.Page[common.ListItem], *apiutils.APIError) {
+			res, apiErr := r.resource.sdkReportConfigurations.ListComplianceReportConfigurations(
+				limit, start, nil)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[common.ListItem]{}
+			if res.Embedded != nil {
+				for _, item := range res.Embedded.Items {
+					if item.Id == nil {
+						continue
+					}
+					if name != "" && (item.Name == nil || *item.Name != name) {
+						continue
+					}
+					page.Items = append(page.Items, common.ListItem{
+						Id:          *item.Id,
+						DisplayName: types.StringPointerValue(item.Name).ValueString(),
+						Identity: reportConfigurationResourceIdentityModel{
+							ID: types.StringPointerValue(item.Id),
+						},
+					})
+				}
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+}
@@ -0,0 +1,78 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the list resource implementation for the clumio_report_configuration Terraform
+// list resource. This list resource is used by `terraform query` to list the existing report
+// configurations within Clumio.
+
+package clumio_report_configuration
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &clumioReportConfigurationListResource{}
+	_ list.ListResourceWithConfigure = &clumioReportConfigurationListResource{}
+)
+
+// clumioReportConfigurationListResource is the struct backing the clumio_report_configuration
+// Terraform list resource. It wraps the clumio_report_configuration resource, whose Clumio API
+// clients are used to list the report configurations and whose read logic is used to populate the
+// listed resources.
+type clumioReportConfigurationListResource struct {
+	resource *clumioReportConfigurationResource
+}
+
+// NewClumioReportConfigurationListResource creates a new instance of
+// clumioReportConfigurationListResource. Its attributes are initialized later by Terraform via
+// Metadata and Configure once the Provider is initialized.
+func NewClumioReportConfigurationListResource() list.ListResource {
+	return &clumioReportConfigurationListResource{
+		resource: &clumioReportConfigurationResource{},
+	}
+}
+
+// Metadata returns the name of the list resource type, which is the name of the resource it lists.
+func (r *clumioReportConfigurationListResource) Metadata(
+	ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	r.resource.Metadata(ctx, req, resp)
+}
+
+// Configure sets up the list resource with the Clumio API client and any other required state. It
+// is called by Terraform once the Provider is initialized.
+func (r *clumioReportConfigurationListResource) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.resource.Configure(ctx, req, resp)
+}
+
+// List streams the report configurations matching the filters of the list block to Terraform.
+func (r *clumioReportConfigurationListResource) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+
+	// Retrieve the filters from the list block.
+	var config clumioReportConfigurationListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := r.listReportConfigurations(ctx, &config, common.ListLimit(req))
+	stream.Results = common.ListResults(ctx, req, items, diags,
+		func(ctx context.Context, id string, model *reportConfigurationResourceModel) (
+			bool, diag.Diagnostics) {
+
+			model.ID = types.StringValue(id)
+			return r.resource.readReportConfiguration(ctx, model)
+		})
+}
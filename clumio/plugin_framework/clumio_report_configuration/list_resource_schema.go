@@ -0,0 +1,44 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_report_configuration Terraform list resource.
+
+package clumio_report_configuration
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clumioReportConfigurationListResourceModel is the list resource model for the
+// clumio_report_configuration Terraform list resource. It represents the filters of the list block
+// used by `terraform query` to select the report configurations to list.
+type clumioReportConfigurationListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_report_configuration Terraform list resource. Every report configuration is listed if no
+// filter is set.
+func (r *clumioReportConfigurationListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_report_configuration list resource is used to list the existing" +
+			" report configurations with `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaName: schema.StringAttribute{
+				Description: "Lists the report configurations with the given name.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in list_resource.go
+
+//go:build unit
+
+package clumio_report_configuration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Unit test for the following cases:
+//   - List report configurations success scenario.
+//   - SDK API for list report configurations returns an error.
+//   - SDK API for list report configurations returns an empty response.
+func TestListReportConfigurations(t *testing.T) {
+
+	ctx := context.Background()
+	mockClient := sdkclients.NewMockReportConfigurationClient(t)
+	name := "test-name"
+	testError := "Test Error"
+
+	lr := &clumioReportConfigurationListResource{
+		resource: &clumioReportConfigurationResource{
+			name:                    "clumio_report_configuration",
+			sdkReportConfigurations: mockClient,
+		},
+	}
+	model := &clumioReportConfigurationListResourceModel{
+		Name: basetypes.NewStringValue(name),
+	}
+
+	apiError := &apiutils.APIError{
+		ResponseCode: 500,
+		Reason:       "test",
+		Response:     []byte(testError),
+	}
+
+	// Tests the success scenario for listing the report configurations. It should not return
+	// Diagnostics.
+	t.Run("Basic success scenario for list report configurations", func(t *testing.T) {
+
+		listResponse := &models.ListComplianceConfigurationsResponse{}
+
+		// Setup expectations.
+		mockClient.EXPECT().ListComplianceReportConfigurations(
+			mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(listResponse, nil)
+
+		items, diags := lr.listReportConfigurations(ctx, model, common.ListOptions{})
+		assert.Nil(t, diags)
+		assert.Empty(t, items)
+	})
+
+	// Tests that Diagnostics is returned in case the list report configurations API call returns an
+	// error.
+	t.Run("list report configurations returns an error", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListComplianceReportConfigurations(
+			mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiError)
+
+		_, diags := lr.listReportConfigurations(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+
+	// Tests that Diagnostics is returned in case the list report configurations API call returns an
+	// empty response.
+	t.Run("list report configurations returns an empty response", func(t *testing.T) {
+
+		// Setup expectations.
+		mockClient.EXPECT().ListComplianceReportConfigurations(
+			mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, nil)
+
+		_, diags := lr.listReportConfigurations(ctx, model, common.ListOptions{})
+		assert.NotNil(t, diags)
+		assert.True(t, diags.HasError())
+	})
+}
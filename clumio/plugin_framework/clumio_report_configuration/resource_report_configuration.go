@@ -10,12 +10,15 @@ import (
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
 var (
-	_ resource.Resource              = &clumioReportConfigurationResource{}
-	_ resource.ResourceWithConfigure = &clumioReportConfigurationResource{}
+	_ resource.Resource                = &clumioReportConfigurationResource{}
+	_ resource.ResourceWithConfigure   = &clumioReportConfigurationResource{}
+	_ resource.ResourceWithImportState = &clumioReportConfigurationResource{}
+	_ resource.ResourceWithIdentity    = &clumioReportConfigurationResource{}
 )
 
 // clumioReportConfigurationResource is the struct backing the clumio_report_configuration Terraform resource.
@@ -45,13 +48,15 @@ func (r *clumioReportConfigurationResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioReportConfigurationResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkReportConfigurations = sdkclients.NewReportConfigurationClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkReportConfigurations = sdkclients.NewReportConfigurationClient(
+		r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -78,6 +83,10 @@ func (r *clumioReportConfigurationResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, reportConfigurationResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -108,6 +117,10 @@ func (r *clumioReportConfigurationResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, reportConfigurationResourceIdentityModel{ID: state.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -134,6 +147,10 @@ func (r *clumioReportConfigurationResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, reportConfigurationResourceIdentityModel{ID: plan.ID})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes the Terraform state.
@@ -151,3 +168,12 @@ func (r *clumioReportConfigurationResource) Delete(
 	diags = r.deleteReportConfiguration(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
+
+// ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
+// is done using the ID of the resource, given either as the import ID or in the resource identity.
+func (r *clumioReportConfigurationResource) ImportState(
+	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root(schemaID), path.Root(schemaID), req, resp)
+}
@@ -162,7 +162,7 @@ provider clumio{
 }
 
 resource "clumio_report_configuration" "test_report_configuration" {
-  name = "test-report-configuration"
+  name = "acceptance-test-report-configuration"
   description = "%s"
   notification {
 	email_list = ["email1@clumio.com", "email2@clumio.com"]
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,6 +34,13 @@ type reportConfigurationResourceModel struct {
 	Schedule     []*scheduleModel     `tfsdk:"schedule"`
 }
 
+// reportConfigurationResourceIdentityModel is the identity model for the
+// clumio_report_configuration Terraform resource. It identifies the report configuration when
+// importing it and when listing report configurations with `terraform query`.
+type reportConfigurationResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // notificationModel maps to the 'notification' field in reportConfigurationResourceModel and
 // refers to the list of notification targets in a report configuration.
 type notificationModel struct {
@@ -468,3 +476,18 @@ func (r *clumioReportConfigurationResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_report_configuration Terraform resource, which
+// is the ID of the report configuration.
+func (r *clumioReportConfigurationResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaID: identityschema.StringAttribute{
+				Description:       "Unique identifier of the report configuration.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -45,12 +45,13 @@ func (r *clumioRoleDataSource) Metadata(
 // Configure sets up the datasource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioRoleDataSource) Configure(
-	_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.roles = sdkclients.NewRoleClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.roles = sdkclients.NewRoleClient(r.client.ClumioConfig, middlewares...)
 }
 
 // Read retrieves the datasource from the Clumio API and sets the Terraform state.
@@ -6,6 +6,7 @@ const (
 	// Constants used by the resource model for the clumio_s3_bucket Terraform resource.
 	// These values should match the schema tfsdk tags on the resource model struct in schema.go.
 	schemaId                            = "id"
+	schemaOrganizationalUnitContext     = "organizational_unit_context"
 	schemaName                          = "name"
 	schemaBucketNames                   = "bucket_names"
 	schemaRegion                        = "aws_region"
@@ -14,4 +15,6 @@ const (
 	schemaLastBackupTimestamp           = "last_backup_timestamp"
 	schemaLastContinuousBackupTimestamp = "last_continuous_backup_timestamp"
 	schemaS3Buckets                     = "s3_buckets"
+	schemaMaxResults                    = "max_results"
+	schemaPageSize                      = "page_size"
 )
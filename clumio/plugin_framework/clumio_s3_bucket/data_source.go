@@ -8,9 +8,10 @@ package clumio_s3_bucket
 import (
 	"context"
 	"fmt"
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"strings"
 )
 
 // readS3Bucket invokes the API to read the s3BucketClient and from the response
@@ -24,27 +25,35 @@ func (r *clumioS3BucketDataSource) readS3Bucket(
 	if diags.HasError() {
 		return diags
 	}
-	nameFilter := fmt.Sprintf(`{"name": {"$in":["%s"]}}`, strings.Join(bucketNames, "\", \""))
-
-	// Call the Clumio API to list the s3 buckets.
-	limit := int64(10000)
-	res, apiErr := r.s3BucketClient.ListAwsS3Buckets(&limit, nil, &nameFilter)
-	if apiErr != nil {
-		summary := fmt.Sprintf("Unable to read %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
-		return diags
-	}
-	if res == nil {
-		summary := common.NilErrorMessageSummary
-		detail := common.NilErrorMessageDetail
-		diags.AddError(summary, detail)
+	nameFilter := common.NewFilter().In("name", bucketNames).String()
+
+	// Call the Clumio API to list the s3 buckets, reading every page.
+	options := common.DataSourceListOptions(model.MaxResults, model.PageSize)
+	items, listDiags := common.ListAll(ctx, r.name, options,
+		func(limit *int64, start *string) (
+			*common.Page[*models.Bucket], *apiutils.APIError) {
+
+			res, apiErr := r.s3BucketClient.ListAwsS3Buckets(limit, start, &nameFilter)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[*models.Bucket]{}
+			if res.Embedded != nil {
+				page.Items = res.Embedded.Items
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+	diags.Append(listDiags...)
+	if diags.HasError() {
 		return diags
 	}
 
 	// Convert the Clumio API response for the s3 buckets into the datasource schema model.
-	if res.Embedded != nil && res.Embedded.Items != nil && len(res.Embedded.Items) > 0 {
-		populateDiag := populateS3BucketsInDataSourceModel(ctx, model, res.Embedded.Items)
+	if len(items) > 0 {
+		populateDiag := populateS3BucketsInDataSourceModel(ctx, model, items)
 		diags.Append(populateDiag...)
 	} else {
 		summary := "S3 bucket not found."
@@ -8,7 +8,9 @@ package clumio_s3_bucket
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,8 +22,11 @@ import (
 // schema is used by customers to configure the datasource and by the Clumio provider to read and
 // write the datasource.
 type clumioS3BucketDataSourceModel struct {
-	BucketNames types.Set `tfsdk:"bucket_names"`
-	S3Buckets   types.Set `tfsdk:"s3_buckets"`
+	OrganizationalUnitContext types.String `tfsdk:"organizational_unit_context"`
+	BucketNames               types.Set    `tfsdk:"bucket_names"`
+	S3Buckets                 types.Set    `tfsdk:"s3_buckets"`
+	MaxResults                types.Int64  `tfsdk:"max_results"`
+	PageSize                  types.Int64  `tfsdk:"page_size"`
 }
 
 // Schema defines the structure and constraints of the clumio_s3_bucket Terraform datasource. Schema
@@ -33,6 +38,27 @@ func (r *clumioS3BucketDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaOrganizationalUnitContext: schema.StringAttribute{
+				Description: "Identifier of the organizational unit in whose context the" +
+					" data source is read. If not set, the clumio_organizational_unit_context" +
+					" of the provider is used.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaMaxResults: schema.Int64Attribute{
+				Description: "Maximum number of results to read. If not set, every page of" +
+					" results is read.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			schemaPageSize: schema.Int64Attribute{
+				Description: "Number of results requested per page of the list API. If not set," +
+					" 1000 results are requested per page.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
 			schemaBucketNames: schema.SetAttribute{
 				Description: "The list of s3 bucket names to be queried.",
 				Required:    true,
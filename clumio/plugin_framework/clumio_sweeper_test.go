@@ -1,13 +1,721 @@
 // Copyright 2023. Clumio, Inc.
 
+// This file holds the test sweepers which delete the objects leaked by the acceptance tests. The
+// objects of the resources with a name are selected by common.AccTestNamePrefix while the wallets
+// and connections are selected by the AWS accounts and GCP project used by the acceptance tests.
+// As those accounts may also hold wallets and connections which were not created by the tests,
+// they are only swept when opted in with -sweep-accounts. Please view the README.md file for more
+// information on how to run the sweepers.
+
 package clumio_pf
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	clumioConfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+const (
+	// Names of the sweepers, which are also used in their dependencies.
+	sweeperPolicy                   = "clumio_policy"
+	sweeperPolicyRule               = "clumio_policy_rule"
+	sweeperPolicyAssignment         = "clumio_policy_assignment"
+	sweeperProtectionGroup          = "clumio_protection_group"
+	sweeperOrganizationalUnit       = "clumio_organizational_unit"
+	sweeperUser                     = "clumio_user"
+	sweeperReportConfiguration      = "clumio_report_configuration"
+	sweeperAutoUserProvisioningRule = "clumio_auto_user_provisioning_rule"
+	sweeperWallet                   = "clumio_wallet"
+	sweeperAwsConnection            = "clumio_aws_connection"
+	sweeperGcpConnection            = "clumio_gcp_connection"
+	sweeperTaskTimeout              = 300 * time.Second
+	sweeperTaskPollInterval         = 5 * time.Second
+	sweeperUserAgent                = "Clumio-Terraform-Provider-Sweeper"
+	sweeperPolicyAssignmentAction   = "unassign"
+	sweeperPolicyAssignmentEntity   = "protection_group"
+	sweeperAccTestUserEmail         = "test@clumio.com"
+)
+
+var (
+	// sweepDryRun makes the sweepers only log the objects they would delete.
+	sweepDryRun = flag.Bool("sweep-dry-run", false,
+		"List the objects the sweepers would delete without deleting them.")
+
+	// sweepAccounts opts in to the sweepers deleting every wallet and connection of the AWS
+	// accounts and GCP project used by the acceptance tests.
+	sweepAccounts = flag.Bool("sweep-accounts", false,
+		"Delete every wallet and connection of the AWS accounts and GCP project of the tests.")
+)
+
 func TestMain(m *testing.M) {
 	resource.TestMain(m)
 }
+
+func init() {
+	resource.AddTestSweepers(sweeperPolicyRule, &resource.Sweeper{
+		Name: sweeperPolicyRule,
+		F:    sweepPolicyRules,
+	})
+	resource.AddTestSweepers(sweeperPolicyAssignment, &resource.Sweeper{
+		Name: sweeperPolicyAssignment,
+		F:    sweepPolicyAssignments,
+	})
+	resource.AddTestSweepers(sweeperPolicy, &resource.Sweeper{
+		Name:         sweeperPolicy,
+		Dependencies: []string{sweeperPolicyRule, sweeperPolicyAssignment},
+		F:            sweepPolicies,
+	})
+	resource.AddTestSweepers(sweeperProtectionGroup, &resource.Sweeper{
+		Name:         sweeperProtectionGroup,
+		Dependencies: []string{sweeperPolicyAssignment},
+		F:            sweepProtectionGroups,
+	})
+	resource.AddTestSweepers(sweeperUser, &resource.Sweeper{
+		Name: sweeperUser,
+		F:    sweepUsers,
+	})
+	resource.AddTestSweepers(sweeperReportConfiguration, &resource.Sweeper{
+		Name: sweeperReportConfiguration,
+		F:    sweepReportConfigurations,
+	})
+	resource.AddTestSweepers(sweeperAutoUserProvisioningRule, &resource.Sweeper{
+		Name: sweeperAutoUserProvisioningRule,
+		F:    sweepAutoUserProvisioningRules,
+	})
+	resource.AddTestSweepers(sweeperOrganizationalUnit, &resource.Sweeper{
+		Name: sweeperOrganizationalUnit,
+		Dependencies: []string{sweeperPolicy, sweeperPolicyRule, sweeperProtectionGroup,
+			sweeperUser, sweeperReportConfiguration, sweeperAutoUserProvisioningRule},
+		F: sweepOrganizationalUnits,
+	})
+	resource.AddTestSweepers(sweeperWallet, &resource.Sweeper{
+		Name: sweeperWallet,
+		F:    sweepWallets,
+	})
+	resource.AddTestSweepers(sweeperAwsConnection, &resource.Sweeper{
+		Name:         sweeperAwsConnection,
+		Dependencies: []string{sweeperProtectionGroup},
+		F:            sweepAwsConnections,
+	})
+	resource.AddTestSweepers(sweeperGcpConnection, &resource.Sweeper{
+		Name: sweeperGcpConnection,
+		F:    sweepGcpConnections,
+	})
+}
+
+// sweepTarget is an object selected for deletion by a sweeper.
+type sweepTarget struct {
+	// Id is the identifier used to delete the object.
+	Id string
+	// Name is a human readable name of the object used in the logs.
+	Name string
+	// depth orders the organizational units so that the children are deleted before their parents.
+	depth int
+}
+
+// sweeperConfig returns the Clumio API configuration used by the sweepers. It is read from the
+// same environment variables as the acceptance tests. The region given to the sweepers is not
+// used since the Clumio API to sweep is set by CLUMIO_API_BASE_URL.
+func sweeperConfig() (clumioConfig.Config, error) {
+	config := clumioConfig.Config{
+		Token:                     os.Getenv(common.ClumioApiToken),
+		BaseUrl:                   os.Getenv(common.ClumioApiBaseUrl),
+		OrganizationalUnitContext: os.Getenv(common.ClumioOrganizationalUnitContext),
+		CustomHeaders: map[string]string{
+			"User-Agent": sweeperUserAgent,
+		},
+	}
+	if config.Token == "" || config.BaseUrl == "" {
+		return config, fmt.Errorf("%s and %s must be set to run the sweepers",
+			common.ClumioApiToken, common.ClumioApiBaseUrl)
+	}
+	return config, nil
+}
+
+// isAccTestName returns true if the given name is the name of an object created by the acceptance
+// tests.
+func isAccTestName(name *string) bool {
+	return name != nil && strings.HasPrefix(*name, common.AccTestNamePrefix)
+}
+
+// skipAccountSweeper returns true and logs why if the given sweeper of the wallets or connections
+// of the accounts used by the acceptance tests was not opted in with -sweep-accounts.
+func skipAccountSweeper(sweeper string) bool {
+	if *sweepAccounts {
+		return false
+	}
+	log.Printf("[INFO] %s: skipped as -sweep-accounts is not set", sweeper)
+	return true
+}
+
+// accTestAwsAccountIds returns the AWS accounts used by the acceptance tests.
+func accTestAwsAccountIds() []string {
+	var accountIds []string
+	for _, envVar := range []string{common.ClumioTestAwsAccountId, common.ClumioTestAwsAccountId2} {
+		if accountId := os.Getenv(envVar); accountId != "" {
+			accountIds = append(accountIds, accountId)
+		}
+	}
+	return accountIds
+}
+
+// listSweepTargets reads every page of a Clumio list API with the given function, which returns
+// the objects of a page selected for deletion.
+func listSweepTargets(sweeper string,
+	listPage func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError)) ([]sweepTarget, error) {
+
+	targets, diags := common.ListAll(
+		context.Background(), sweeper, common.ListOptions{}, listPage)
+	if diags.HasError() {
+		err := diags.Errors()[0]
+		return nil, fmt.Errorf("%s: %s", err.Summary(), err.Detail())
+	}
+	return targets, nil
+}
+
+// sweep deletes the given objects with the given function, or only logs them in a dry run. Every
+// object is attempted and the errors are returned together.
+func sweep(sweeper string, targets []sweepTarget,
+	deleteTarget func(target sweepTarget) error) error {
+
+	log.Printf("[INFO] %s: found %d objects to sweep", sweeper, len(targets))
+	var errs []string
+	for _, target := range targets {
+		if *sweepDryRun {
+			log.Printf("[INFO] %s: would delete %s (%s)", sweeper, target.Id, target.Name)
+			continue
+		}
+		log.Printf("[INFO] %s: deleting %s (%s)", sweeper, target.Id, target.Name)
+		if err := deleteTarget(target); err != nil {
+			errs = append(errs, fmt.Sprintf("%s (%s): %v", target.Id, target.Name, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: unable to delete %d objects:\n%s",
+			sweeper, len(errs), strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// pollSweeperTask waits for the task returned when deleting an object to complete.
+func pollSweeperTask(config clumioConfig.Config, taskId *string) error {
+	if taskId == nil {
+		return fmt.Errorf("expected task ID in the response")
+	}
+	return common.PollTask(context.Background(), sdkclients.NewTaskClient(config), *taskId,
+		sweeperTaskTimeout, sweeperTaskPollInterval)
+}
+
+// sweepPolicyRules deletes the policy rules created by the acceptance tests.
+func sweepPolicyRules(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewPolicyRuleClient(config)
+	targets, err := listSweepTargets(sweeperPolicyRule, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListPolicyRules(limit, start, nil, nil, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if isAccTestName(item.Name) {
+					page.Items = append(page.Items, sweepTarget{Id: *item.Id, Name: *item.Name})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperPolicyRule, targets, func(target sweepTarget) error {
+		res, apiErr := client.DeletePolicyRule(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return pollSweeperTask(config, res.TaskId)
+	})
+}
+
+// sweepPolicyAssignments unassigns the policies directly assigned to the protection groups created
+// by the acceptance tests.
+func sweepPolicyAssignments(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	pgClient := sdkclients.NewProtectionGroupClient(config)
+	targets, err := listSweepTargets(sweeperPolicyAssignment, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := pgClient.ListProtectionGroups(limit, start, nil, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				info := item.ProtectionInfo
+				// Assignments inherited from a policy rule are removed with the rule.
+				if !isAccTestName(item.Name) || info == nil || info.PolicyId == nil ||
+					*info.PolicyId == "" ||
+					(info.InheritingEntityId != nil && *info.InheritingEntityId != "") {
+					continue
+				}
+				page.Items = append(page.Items, sweepTarget{
+					Id:   *item.Id,
+					Name: fmt.Sprintf("%s assigned to policy %s", *item.Name, *info.PolicyId),
+				})
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	assignmentClient := sdkclients.NewPolicyAssignmentClient(config)
+	return sweep(sweeperPolicyAssignment, targets, func(target sweepTarget) error {
+		entityId := target.Id
+		entityType := sweeperPolicyAssignmentEntity
+		action := sweeperPolicyAssignmentAction
+		policyId := ""
+		res, apiErr := assignmentClient.SetPolicyAssignments(&models.SetPolicyAssignmentsV1Request{
+			Items: []*models.AssignmentInputModel{
+				{
+					Action:   &action,
+					Entity:   &models.AssignmentEntity{Id: &entityId, ClumioType: &entityType},
+					PolicyId: &policyId,
+				},
+			},
+		})
+		if apiErr != nil {
+			return apiErr
+		}
+		return pollSweeperTask(config, res.TaskId)
+	})
+}
+
+// sweepPolicies deletes the policies created by the acceptance tests.
+func sweepPolicies(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewPolicyDefinitionClient(config)
+
+	// The policies are not paginated.
+	res, apiErr := client.ListPolicyDefinitions(nil, nil)
+	if apiErr != nil {
+		return fmt.Errorf("%s: %s", sweeperPolicy, common.ParseMessageFromApiError(apiErr))
+	}
+	var targets []sweepTarget
+	if res != nil && res.Embedded != nil {
+		for _, item := range res.Embedded.Items {
+			if isAccTestName(item.Name) {
+				targets = append(targets, sweepTarget{Id: *item.Id, Name: *item.Name})
+			}
+		}
+	}
+	return sweep(sweeperPolicy, targets, func(target sweepTarget) error {
+		res, apiErr := client.DeletePolicyDefinition(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return pollSweeperTask(config, res.TaskId)
+	})
+}
+
+// sweepProtectionGroups deletes the protection groups created by the acceptance tests.
+func sweepProtectionGroups(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewProtectionGroupClient(config)
+	targets, err := listSweepTargets(sweeperProtectionGroup, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListProtectionGroups(limit, start, nil, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if isAccTestName(item.Name) {
+					page.Items = append(page.Items, sweepTarget{Id: *item.Id, Name: *item.Name})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperProtectionGroup, targets, func(target sweepTarget) error {
+		_, apiErr := client.DeleteProtectionGroup(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
+
+// sweepUsers deletes the users created by the acceptance tests.
+func sweepUsers(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewUserClient(config)
+	targets, err := listSweepTargets(sweeperUser, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListUsers(limit, start, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				isAccTestEmail := item.Email != nil && *item.Email == sweeperAccTestUserEmail
+				if isAccTestName(item.FullName) || isAccTestEmail {
+					page.Items = append(page.Items,
+						sweepTarget{Id: *item.Id, Name: *item.FullName})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperUser, targets, func(target sweepTarget) error {
+		userId, err := strconv.ParseInt(target.Id, 10, 64)
+		if err != nil {
+			return err
+		}
+		_, apiErr := client.DeleteUser(userId)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
+
+// sweepReportConfigurations deletes the report configurations created by the acceptance tests.
+func sweepReportConfigurations(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewReportConfigurationClient(config)
+	targets, err := listSweepTargets(sweeperReportConfiguration, func(
+		limit *int64, start *string) (*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListComplianceReportConfigurations(limit, start, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if isAccTestName(item.Name) {
+					page.Items = append(page.Items, sweepTarget{Id: *item.Id, Name: *item.Name})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperReportConfiguration, targets, func(target sweepTarget) error {
+		_, apiErr := client.DeleteComplianceReportConfiguration(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
+
+// sweepAutoUserProvisioningRules deletes the auto user provisioning rules created by the
+// acceptance tests.
+func sweepAutoUserProvisioningRules(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewAutoUserProvisioningRuleClient(config)
+	targets, err := listSweepTargets(sweeperAutoUserProvisioningRule, func(
+		limit *int64, start *string) (*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListAutoUserProvisioningRules(limit, start, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if isAccTestName(item.Name) {
+					page.Items = append(page.Items,
+						sweepTarget{Id: *item.RuleId, Name: *item.Name})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperAutoUserProvisioningRule, targets, func(target sweepTarget) error {
+		_, apiErr := client.DeleteAutoUserProvisioningRule(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
+
+// sweepOrganizationalUnits deletes the organizational units created by the acceptance tests. The
+// organizational units are deleted from the deepest to the shallowest since an organizational
+// unit which has children cannot be deleted.
+func sweepOrganizationalUnits(_ string) error {
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	client := sdkclients.NewOrganizationalUnitClient(config)
+	targets, err := listSweepTargets(sweeperOrganizationalUnit, func(
+		limit *int64, start *string) (*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListOrganizationalUnits(limit, start, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if isAccTestName(item.Name) {
+					page.Items = append(page.Items, sweepTarget{
+						Id:    *item.Id,
+						Name:  *item.Name,
+						depth: len(item.DescendantIds),
+					})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	// The descendants of an organizational unit are a subset of those of its parent, so sorting
+	// by the number of descendants deletes the children first.
+	slices.SortStableFunc(targets, func(a, b sweepTarget) int {
+		return a.depth - b.depth
+	})
+	return sweep(sweeperOrganizationalUnit, targets, func(target sweepTarget) error {
+		res, apiErr := client.DeleteOrganizationalUnit(target.Id, nil)
+		if apiErr != nil {
+			return apiErr
+		}
+		return pollSweeperTask(config, res.TaskId)
+	})
+}
+
+// sweepWallets deletes the wallets of the AWS accounts used by the acceptance tests. It only runs
+// if opted in with -sweep-accounts.
+func sweepWallets(_ string) error {
+	if skipAccountSweeper(sweeperWallet) {
+		return nil
+	}
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	accountIds := accTestAwsAccountIds()
+	if len(accountIds) == 0 {
+		log.Printf("[INFO] %s: skipped as %s is not set", sweeperWallet,
+			common.ClumioTestAwsAccountId)
+		return nil
+	}
+	client := sdkclients.NewWalletClient(config)
+	targets, err := listSweepTargets(sweeperWallet, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListWallets(limit, start)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if item.AccountNativeId != nil &&
+					slices.Contains(accountIds, *item.AccountNativeId) {
+					page.Items = append(page.Items,
+						sweepTarget{Id: *item.Id, Name: *item.AccountNativeId})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperWallet, targets, func(target sweepTarget) error {
+		_, apiErr := client.DeleteWallet(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
+
+// sweepAwsConnections deletes the connections of the AWS accounts used by the acceptance tests. It
+// only runs if opted in with -sweep-accounts.
+func sweepAwsConnections(_ string) error {
+	if skipAccountSweeper(sweeperAwsConnection) {
+		return nil
+	}
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	accountIds := accTestAwsAccountIds()
+	if len(accountIds) == 0 {
+		log.Printf("[INFO] %s: skipped as %s is not set", sweeperAwsConnection,
+			common.ClumioTestAwsAccountId)
+		return nil
+	}
+	client := sdkclients.NewAWSConnectionClient(config)
+	targets, err := listSweepTargets(sweeperAwsConnection, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListAwsConnections(limit, start, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if item.AccountNativeId != nil &&
+					slices.Contains(accountIds, *item.AccountNativeId) {
+					page.Items = append(page.Items, sweepTarget{
+						Id:   *item.Id,
+						Name: fmt.Sprintf("%s/%s", *item.AccountNativeId, *item.AwsRegion),
+					})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperAwsConnection, targets, func(target sweepTarget) error {
+		_, apiErr := client.DeleteAwsConnection(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
+
+// sweepGcpConnections deletes the connection of the GCP project used by the acceptance tests. It
+// only runs if opted in with -sweep-accounts.
+func sweepGcpConnections(_ string) error {
+	if skipAccountSweeper(sweeperGcpConnection) {
+		return nil
+	}
+	config, err := sweeperConfig()
+	if err != nil {
+		return err
+	}
+	projectId := os.Getenv(common.ClumioTestGcpProjectId)
+	if projectId == "" {
+		log.Printf("[INFO] %s: skipped as %s is not set", sweeperGcpConnection,
+			common.ClumioTestGcpProjectId)
+		return nil
+	}
+	client := sdkclients.NewGcpConnectionClient(config)
+	targets, err := listSweepTargets(sweeperGcpConnection, func(limit *int64, start *string) (
+		*common.Page[sweepTarget], *apiutils.APIError) {
+
+		res, apiErr := client.ListGcpConnections(limit, start, nil)
+		if apiErr != nil || res == nil {
+			return nil, apiErr
+		}
+		page := &common.Page[sweepTarget]{}
+		if res.Embedded != nil {
+			for _, item := range res.Embedded.Items {
+				if item.ProjectId != nil && *item.ProjectId == projectId {
+					page.Items = append(page.Items,
+						sweepTarget{Id: *item.ProjectId, Name: *item.ProjectId})
+				}
+			}
+		}
+		if res.Links != nil && res.Links.Next != nil {
+			page.NextHref = res.Links.Next.Href
+		}
+		return page, nil
+	})
+	if err != nil {
+		return err
+	}
+	return sweep(sweeperGcpConnection, targets, func(target sweepTarget) error {
+		_, apiErr := client.DeleteGcpConnection(target.Id)
+		if apiErr != nil {
+			return apiErr
+		}
+		return nil
+	})
+}
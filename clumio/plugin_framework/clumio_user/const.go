@@ -18,6 +18,8 @@ const (
 	schemaOrganizationalUnitCount    = "organizational_unit_count"
 	schemaName                       = "name"
 	schemaUsers                      = "users"
+	schemaMaxResults                 = "max_results"
+	schemaPageSize                   = "page_size"
 
 	// Common error messages used by the resource.
 	invalidUserMsg = "Invalid user id."
@@ -9,8 +9,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
-	"strings"
 
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
@@ -20,41 +21,47 @@ func (r *clumioUserDataSource) readUser(
 	ctx context.Context, model *clumioUserDataSourceModel) diag.Diagnostics {
 
 	var diags diag.Diagnostics
-	filters := make([]string, 0)
+	queryFilter := common.NewFilter()
 
-	// Prepare the query nameFilter.
+	// Prepare the query filter.
 	name := model.Name.ValueString()
 	if name != "" {
-		nameFilter := fmt.Sprintf(`"name": {"$contains":"%s"}`, name)
-		filters = append(filters, nameFilter)
+		queryFilter.Contains("name", name)
 	}
 
 	roleId := model.RoleId.ValueString()
 	if roleId != "" {
-		roleFilter := fmt.Sprintf(`"role_id": {"$eq":"%s"}`, roleId)
-		filters = append(filters, roleFilter)
+		queryFilter.Eq("role_id", roleId)
 	}
-	filter := fmt.Sprintf("{%s}", strings.Join(filters, ","))
+	filter := queryFilter.String()
 
-	// Call the Clumio API to list the users.
-	limit := int64(10000)
-	res, apiErr := r.userClient.ListUsers(&limit, nil, &filter)
-	if apiErr != nil {
-		summary := fmt.Sprintf("Unable to read %s", r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
-		return diags
-	}
-	if res == nil {
-		summary := common.NilErrorMessageSummary
-		detail := common.NilErrorMessageDetail
-		diags.AddError(summary, detail)
+	// Call the Clumio API to list the users, reading every page.
+	options := common.DataSourceListOptions(model.MaxResults, model.PageSize)
+	items, listDiags := common.ListAll(ctx, r.name, options,
+		func(limit *int64, start *string) (
+			*common.Page[*models.UserWithETag], *apiutils.APIError) {
+
+			res, apiErr := r.userClient.ListUsers(limit, start, &filter)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[*models.UserWithETag]{}
+			if res.Embedded != nil {
+				page.Items = res.Embedded.Items
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+	diags.Append(listDiags...)
+	if diags.HasError() {
 		return diags
 	}
 
 	// Convert the Clumio API response for the users into the datasource schema model.
-	if res.Embedded != nil && res.Embedded.Items != nil && len(res.Embedded.Items) > 0 {
-		populateDiag := populateUsersInDataSourceModel(ctx, model, res.Embedded.Items)
+	if len(items) > 0 {
+		populateDiag := populateUsersInDataSourceModel(ctx, model, items)
 		diags.Append(populateDiag...)
 	} else {
 		summary := "User not found."
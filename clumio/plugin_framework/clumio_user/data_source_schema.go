@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -22,9 +23,11 @@ import (
 // represents the schema of the datasource and the data it holds. This schema is used by customers
 // to configure the datasource and by the Clumio provider to read and write the datasource.
 type clumioUserDataSourceModel struct {
-	Name   types.String `tfsdk:"name"`
-	RoleId types.String `tfsdk:"role_id"`
-	Users  types.Set    `tfsdk:"users"`
+	Name       types.String `tfsdk:"name"`
+	RoleId     types.String `tfsdk:"role_id"`
+	Users      types.Set    `tfsdk:"users"`
+	MaxResults types.Int64  `tfsdk:"max_results"`
+	PageSize   types.Int64  `tfsdk:"page_size"`
 }
 
 // Schema defines the structure and constraints of the clumio_user Terraform datasource.
@@ -36,6 +39,18 @@ func (r *clumioUserDataSource) Schema(
 	_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			schemaMaxResults: schema.Int64Attribute{
+				Description: "Maximum number of results to read. If not set, every page of" +
+					" results is read.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
+			schemaPageSize: schema.Int64Attribute{
+				Description: "Number of results requested per page of the list API. If not set," +
+					" 1000 results are requested per page.",
+				Optional:   true,
+				Validators: []validator.Int64{int64validator.AtLeast(1)},
+			},
 			schemaName: schema.StringAttribute{
 				Description: "The name of the user.",
 				Optional:    true,
@@ -23,6 +23,7 @@ import (
 
 // Unit test for the following cases:
 //   - Read user success scenario.
+//   - Page size is used as the limit of the SDK API for list users.
 //   - SDK API for read user returns an error.
 //   - SDK API for read user returns an empty response.
 func TestDatasourceReadUser(t *testing.T) {
@@ -85,6 +86,28 @@ func TestDatasourceReadUser(t *testing.T) {
 		assert.Nil(t, diags)
 	})
 
+	// Tests that the page_size attribute is passed to the list users API as the limit.
+	t.Run("Page size is used as the limit of list users", func(t *testing.T) {
+
+		pageSizeModel := &clumioUserDataSourceModel{
+			Name:     basetypes.NewStringValue(name),
+			PageSize: basetypes.NewInt64Value(50),
+		}
+		readResponse := &models.ListUsersResponse{
+			Embedded: &models.UserListEmbedded{
+				Items: []*models.UserWithETag{{Id: &id, FullName: &name}},
+			},
+		}
+
+		// Setup expectations.
+		pgClient.EXPECT().ListUsers(
+			mock.MatchedBy(func(limit *int64) bool { return *limit == 50 }), mock.Anything,
+			mock.Anything).Times(1).Return(readResponse, nil)
+
+		diags := rds.readUser(ctx, pageSizeModel)
+		assert.Nil(t, diags)
+	})
+
 	// Tests that Diagnostics is returned in case the list users API call returns an
 	// error.
 	t.Run("list users returns an error", func(t *testing.T) {
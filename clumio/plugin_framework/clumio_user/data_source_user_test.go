@@ -71,7 +71,7 @@ func TestAccDataSourceEmptyUser(t *testing.T) {
 // datasource.
 func getTestDataSourceClumioUser(baseUrl string, invalidName bool) string {
 
-	name := "ds_test_user"
+	name := "acceptance-test-ds-user"
 
 	dsName := name
 	if invalidName {
@@ -0,0 +1,62 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the logic to invoke the Clumio User SDK API to list the users matching the
+// filters of the list block of the list resource.
+
+package clumio_user
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listUsers invokes the API to list the users matching the filters of the given list block.
+func (r *clumioUserListResource) listUsers(ctx context.Context,
+	model *clumioUserListResourceModel, options common.ListOptions) (
+	[]common.ListItem, diag.Diagnostics) {
+
+	// Prepare the query filter.
+	queryFilter := common.NewFilter()
+	name := model.Name.ValueString()
+	if name != "" {
+		queryFilter.Contains("name", name)
+	}
+	roleId := model.RoleId.ValueString()
+	if roleId != "" {
+		queryFilter.Eq("role_id", roleId)
+	}
+	filter := queryFilter.OptionalString()
+
+	// Call the Clumio API to list the users, reading every page.
+	return common.ListAll(ctx, r.resource.name, options,
+		func(limit *int64, start *string) (*common.Page[common.ListItem], *apiutils.APIError) {
+			res, apiErr := r.resource.sdkUsers.ListUsers(limit, start, filter)
+			if apiErr != nil || res == nil {
+				return nil, apiErr
+			}
+			page := &common.Page[common.ListItem]{}
+			if res.Embedded != nil {
+				for _, item := range res.Embedded.Items {
+					if item.Id == nil {
+						continue
+					}
+					page.Items = append(page.Items, common.ListItem{
+						Id:          *item.Id,
+						DisplayName: types.StringPointerValue(item.FullName).ValueString(),
+						Identity: userResourceIdentityModel{
+							ID: types.StringPointerValue(item.Id),
+						},
+					})
+				}
+			}
+			if res.Links != nil && res.Links.Next != nil {
+				page.NextHref = res.Links.Next.Href
+			}
+			return page, nil
+		})
+}
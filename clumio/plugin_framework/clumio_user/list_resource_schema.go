@@ -0,0 +1,49 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the type definition and Schema function used by the list resource model for the
+// clumio_user Terraform list resource.
+
+package clumio_user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// clumioUserListResourceModel is the list resource model for the clumio_user Terraform list
+// resource. It represents the filters of the list block used by `terraform query` to select the
+// users to list.
+type clumioUserListResourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	RoleId types.String `tfsdk:"role_id"`
+}
+
+// ListResourceConfigSchema defines the structure and constraints of the list block of the
+// clumio_user Terraform list resource. Its filters are the same as the ones of the clumio_user
+// data source, and every user is listed if none is set.
+func (r *clumioUserListResource) ListResourceConfigSchema(
+	_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+
+	resp.Schema = schema.Schema{
+		Description: "clumio_user list resource is used to list the existing users with" +
+			" `terraform query`, for instance to import them.",
+		Attributes: map[string]schema.Attribute{
+			schemaName: schema.StringAttribute{
+				Description: "Lists the users whose name contains the given value.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			schemaRoleId: schema.StringAttribute{
+				Description: "Lists the users assigned the role with the given identifier.",
+				Optional:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the list resource implementation for the clumio_user Terraform list resource.
+// This list resource is used by `terraform query` to list the existing users within Clumio.
+
+package clumio_user
+
+import (
+	"context"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ list.ListResource              = &clumioUserListResource{}
+	_ list.ListResourceWithConfigure = &clumioUserListResource{}
+)
+
+// clumioUserListResource is the struct backing the clumio_user Terraform list resource. It wraps
+// the clumio_user resource, whose Clumio API clients are used to list the users and whose read
+// logic is used to populate the listed resources.
+type clumioUserListResource struct {
+	resource *clumioUserResource
+}
+
+// NewClumioUserListResource creates a new instance of clumioUserListResource. Its attributes are
+// initialized later by Terraform via Metadata and Configure once the Provider is initialized.
+func NewClumioUserListResource() list.ListResource {
+	return &clumioUserListResource{
+		resource: &clumioUserResource{},
+	}
+}
+
+// Metadata returns the name of the list resource type, which is the name of the resource it lists.
+func (r *clumioUserListResource) Metadata(
+	ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+
+	r.resource.Metadata(ctx, req, resp)
+}
+
+// Configure sets up the list resource with the Clumio API client and any other required state. It
+// is called by Terraform once the Provider is initialized.
+func (r *clumioUserListResource) Configure(
+	ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+
+	r.resource.Configure(ctx, req, resp)
+}
+
+// List streams the users matching the filters of the list block to Terraform.
+func (r *clumioUserListResource) List(
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+
+	// Retrieve the filters from the list block.
+	var config clumioUserListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, diags := r.listUsers(ctx, &config, common.ListLimit(req))
+	stream.Results = common.ListResults(ctx, req, items, diags,
+		func(ctx context.Context, id string, model *clumioUserResourceModel) (
+			bool, diag.Diagnostics) {
+
+			model.Id = types.StringValue(id)
+			return r.resource.readUser(ctx, model)
+		})
+}
@@ -15,11 +15,21 @@ import (
 
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// apiFieldPaths maps the fields of the user requests to the attributes they are set from, so that
+// the field errors returned by the Clumio API are reported on those attributes.
+var apiFieldPaths = common.ApiFieldPaths{
+	schemaEmail:                      path.MatchRoot(schemaEmail),
+	schemaFullName:                   path.MatchRoot(schemaFullName),
+	schemaAccessControlConfiguration: path.MatchRoot(schemaAccessControlConfiguration),
+	schemaAccessControlConfiguration + "_updates": path.MatchRoot(schemaAccessControlConfiguration),
+}
+
 // createUser invokes the API to create the user and from the response populates the computed
 // attributes of the user.
 func (r *clumioUserResource) createUser(
@@ -55,8 +65,7 @@ func (r *clumioUserResource) createUser(
 	res, apiErr := r.sdkUsers.CreateUser(apiReq)
 	if apiErr != nil {
 		summary := fmt.Sprintf(createErrorFmt, r.name)
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -169,8 +178,7 @@ func (r *clumioUserResource) updateUser(ctx context.Context, plan *clumioUserRes
 	res, apiErr := r.sdkUsers.UpdateUser(userId, updateRequest)
 	if apiErr != nil {
 		summary := fmt.Sprintf("Unable to update %s (ID: %v)", r.name, state.Id.ValueString())
-		detail := common.ParseMessageFromApiError(apiErr)
-		diags.AddError(summary, detail)
+		diags.Append(common.ApiErrorDiagnostics(summary, apiErr, apiFieldPaths)...)
 		return diags
 	}
 	if res == nil {
@@ -17,6 +17,7 @@ import (
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/clumio-code/clumio-go-sdk/models"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/stretchr/testify/assert"
@@ -507,3 +508,19 @@ func TestDeleteUser(t *testing.T) {
 		assert.NotNil(t, diags)
 	})
 }
+
+// Unit test for apiFieldPaths for the following cases:
+//   - Field errors for the fields of the user requests are reported on the attributes they are set
+//     from.
+//   - Field errors for unknown fields are reported without an attribute.
+func TestApiFieldPaths(t *testing.T) {
+
+	common.RunApiFieldPathsTests(t, apiFieldPaths, map[string]path.Path{
+		"email": path.Root(schemaEmail),
+		"access_control_configuration[0].role_id": path.Root(
+			schemaAccessControlConfiguration),
+		"access_control_configuration_updates.add[0].organizational_unit_ids": path.Root(
+			schemaAccessControlConfiguration),
+		"unknown_field": path.Empty(),
+	})
+}
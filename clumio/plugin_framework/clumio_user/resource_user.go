@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &clumioUserResource{}
 	_ resource.ResourceWithConfigure   = &clumioUserResource{}
 	_ resource.ResourceWithImportState = &clumioUserResource{}
+	_ resource.ResourceWithIdentity    = &clumioUserResource{}
 )
 
 // clumioUserResource is the struct backing the clumio_user Terraform resource. It holds the Clumio
@@ -48,14 +49,15 @@ func (r *clumioUserResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioUserResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkUsers = sdkclients.NewUserClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkUsers = sdkclients.NewUserClient(r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -82,6 +84,10 @@ func (r *clumioUserResource) Create(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, userResourceIdentityModel{ID: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -112,6 +118,10 @@ func (r *clumioUserResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, userResourceIdentityModel{ID: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource via the Clumio API and updates the Terraform state.
@@ -146,6 +156,10 @@ func (r *clumioUserResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, userResourceIdentityModel{ID: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource via the Clumio API and removes it from the Terraform state.
@@ -165,10 +179,10 @@ func (r *clumioUserResource) Delete(
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done using the ID of the resource.
+// is done using the ID of the resource, given either as the import ID or in the resource identity.
 func (r *clumioUserResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Retrieve the ID from the import ID or identity and save it to the id attribute
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -0,0 +1,150 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the acceptance tests of the clumio_user Terraform resource which run against the
+// fake Clumio API. Please view the README.md file for more information on how to run these tests.
+
+//go:build fake
+
+package clumio_user_test
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	clumioPf "github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	fakeRoleBefore = "30000000-0000-0000-0000-000000000000"
+	fakeRoleAfter  = "20000000-0000-0000-0000-000000000000"
+)
+
+// Test of the clumio_user resource against the fake Clumio API. It tests the following scenarios:
+//   - Creates a user and verifies that the plan was applied properly.
+//   - Updates the role of the user and verifies that the update is applied.
+//   - Imports the user by ID.
+//   - Removes the roles of the user outside of Terraform and verifies that the drift is planned to
+//     be undone.
+//   - Deletes the user outside of Terraform and verifies that it is planned to be recreated.
+func TestAccFakeResourceClumioUser(t *testing.T) {
+
+	server := clumioPf.UtilTestAccStartFakeApi(t)
+	resourceName := "clumio_user.test_user"
+	var id string
+	updatedConfig := getTestAccFakeResourceClumioUser(fakeRoleAfter, "test_ou2")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: clumioPf.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccFakeResourceClumioUser(fakeRoleBefore, "test_ou1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionCreate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "access_control_configuration.0.role_id", fakeRoleBefore),
+					resource.TestCheckResourceAttr(resourceName, "organizational_unit_count", "1"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: getTestAccFakeResourceClumioUser(fakeRoleAfter, "test_ou2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							resourceName, plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						resourceName, "access_control_configuration.0.role_id", fakeRoleAfter),
+					checkFakeUserRole(server, &id, fakeRoleAfter),
+				),
+			},
+			clumioPf.UtilTestAccFakeImportStep(resourceName, updatedConfig, &id),
+			clumioPf.UtilTestAccFakeDriftStep(server, fakeapi.Users, &id,
+				map[string]any{"access_control_configuration": []any{}}, resourceName,
+				updatedConfig, checkFakeUserRole(server, &id, fakeRoleAfter)),
+			clumioPf.UtilTestAccFakeDeleteStep(
+				server, fakeapi.Users, &id, resourceName, updatedConfig),
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			if users := server.Objects(fakeapi.Users); len(users) > 0 {
+				return fmt.Errorf("expected no users after destroy, found %d", len(users))
+			}
+			return nil
+		},
+	})
+}
+
+// checkFakeUserRole returns the check verifying that the user with the given ID has the given role,
+// and only that role, in the fake Clumio API.
+func checkFakeUserRole(server *fakeapi.Server, id *string, roleId string) resource.TestCheckFunc {
+
+	return func(_ *terraform.State) error {
+		user, ok := server.Object(fakeapi.Users, *id)
+		if !ok {
+			return fmt.Errorf("user %s not found in the fake Clumio API", *id)
+		}
+		roles, _ := user["access_control_configuration"].([]any)
+		if len(roles) != 1 {
+			return fmt.Errorf("expected 1 role of the user, found %d", len(roles))
+		}
+		role, _ := roles[0].(map[string]any)
+		if role["role_id"] != roleId {
+			return fmt.Errorf("expected user role %q, found %q", roleId, role["role_id"])
+		}
+		return nil
+	}
+}
+
+// getTestAccFakeResourceClumioUser returns the Terraform configuration of a user with the given
+// role in the organizational unit with the given resource name for the acceptance tests which run
+// against the fake Clumio API.
+func getTestAccFakeResourceClumioUser(roleId string, ouName string) string {
+	return fmt.Sprintf(testAccFakeResourceClumioUser, roleId, ouName)
+}
+
+// testAccFakeResourceClumioUser is the Terraform configuration of a user with a role in one of two
+// organizational units. The provider reads the base URL and token of the fake Clumio API from the
+// environment.
+const testAccFakeResourceClumioUser = `
+resource "clumio_organizational_unit" "test_ou1" {
+  name = "acceptance-test-ou1"
+  description = "test-ou-1"
+}
+
+resource "clumio_organizational_unit" "test_ou2" {
+  name = "acceptance-test-ou2"
+  description = "test-ou-2"
+}
+
+resource "clumio_user" "test_user" {
+  full_name = "acceptance-test-user"
+  email = "test@clumio.com"
+  access_control_configuration = [
+	{
+		role_id = "%s"
+		organizational_unit_ids = [clumio_organizational_unit.%s.id]
+	},
+  ]
+}
+`
@@ -93,7 +93,8 @@ func TestAccResourceClumioUser(t *testing.T) {
 				},
 			},
 			{
-				Config: getTestAccResourceClumioUser(baseUrl, "updated-name", email, true),
+				Config: getTestAccResourceClumioUser(
+					baseUrl, "acceptance-test-user-updated", email, true),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
 						plancheck.ExpectResourceAction(
@@ -285,12 +286,12 @@ provider clumio{
 }
 
 resource "clumio_organizational_unit" "test_ou1" {
-  name = "test_ou1"
+  name = "acceptance-test-ou1"
   description = "test-ou-1"
 }
 
 resource "clumio_organizational_unit" "test_ou2" {
-  name = "test_ou2"
+  name = "acceptance-test-ou2"
   description = "test-ou-2"
 }
 
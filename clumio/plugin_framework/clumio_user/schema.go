@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -37,6 +38,12 @@ type clumioUserResourceModel struct {
 	OrganizationalUnitCount    types.Int64  `tfsdk:"organizational_unit_count"`
 }
 
+// userResourceIdentityModel is the identity model for the clumio_user Terraform resource. It
+// identifies the user when importing it and when listing users with `terraform query`.
+type userResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Schema defines the structure and constraints of the clumio_user Terraform resource. Schema is a
 // method on the clumioUserResource struct. It sets the schema for the clumio_user Terraform
 // resource, which is used to create and manage users within Clumio. The schema defines various
@@ -121,3 +128,18 @@ func (r *clumioUserResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_user Terraform resource, which is the ID of the
+// user.
+func (r *clumioUserResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the user.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &clumioWalletResource{}
 	_ resource.ResourceWithConfigure   = &clumioWalletResource{}
 	_ resource.ResourceWithImportState = &clumioWalletResource{}
+	_ resource.ResourceWithIdentity    = &clumioWalletResource{}
 )
 
 // clumioWalletResource is the struct backing the clumio_wallet Terraform resource. It holds the
@@ -48,14 +49,15 @@ func (r *clumioWalletResource) Metadata(
 // Configure sets up the resource with the Clumio API client and any other required state. It is
 // called by Terraform once the Provider is initialized.
 func (r *clumioWalletResource) Configure(
-	_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 
 	if req.ProviderData == nil {
 		return
 	}
 
 	r.client = req.ProviderData.(*common.ApiClient)
-	r.sdkWallets = sdkclients.NewWalletClient(r.client.ClumioConfig)
+	middlewares := r.client.CallMiddlewares(ctx)
+	r.sdkWallets = sdkclients.NewWalletClient(r.client.ClumioConfig, middlewares...)
 }
 
 // Create creates the resource via the Clumio API and sets the initial Terraform state.
@@ -79,6 +81,13 @@ func (r *clumioWalletResource) Create(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, walletResourceIdentityModel{ID: plan.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read retrieves the resource from the Clumio API and sets the Terraform state.
@@ -106,6 +115,13 @@ func (r *clumioWalletResource) Read(
 	// Set the schema into the Terraform state.
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the identity of the resource into the Terraform state.
+	diags = resp.Identity.Set(ctx, walletResourceIdentityModel{ID: state.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -134,9 +150,9 @@ func (r *clumioWalletResource) Delete(
 }
 
 // ImportState retrieves the resource via the Clumio API and sets the Terraform state. The import
-// is done by the ID of the resource.
+// is done using the ID of the resource, given either as the import ID or in the resource identity.
 func (r *clumioWalletResource) ImportState(
 	ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -9,6 +9,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -27,6 +28,12 @@ type clumioWalletResourceModel struct {
 	AwsRegion       types.String `tfsdk:"aws_region"`
 }
 
+// walletResourceIdentityModel is the identity model for the clumio_wallet Terraform resource. It
+// identifies the wallet when importing it.
+type walletResourceIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
 // Schema defines the structure and constraints of the clumio_wallet Terraform resource.
 // Schema is a method on the clumioWalletResource struct. It sets the schema for the
 // clumio_wallet Terraform resource, which is used to create a Clumio wallet.
@@ -57,7 +64,8 @@ func (r *clumioWalletResource) Schema(
 			schemaToken: schema.StringAttribute{
 				Description: "Token used to identify resources set up by the BYOK template" +
 					" installation on the account being connected.",
-				Computed: true,
+				Computed:  true,
+				Sensitive: true,
 			},
 			schemaState: schema.StringAttribute{
 				Description: "State describes the state of the wallet. Valid states are:\n" +
@@ -86,3 +94,18 @@ func (r *clumioWalletResource) Schema(
 		},
 	}
 }
+
+// IdentitySchema defines the identity of the clumio_wallet Terraform resource, which is the ID of
+// the wallet.
+func (r *clumioWalletResource) IdentitySchema(
+	_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			schemaId: identityschema.StringAttribute{
+				Description:       "Unique identifier of the Clumio Wallet.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the functions used to parse the errors returned by the Clumio API and to report them as
+// diagnostics attributed to the Terraform attributes they relate to.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// fieldIndexRegex matches the list indices in the field path of a field error.
+var fieldIndexRegex = regexp.MustCompile(`\[[^\]]*\]`)
+
+// ApiFieldPaths maps the fields of the request body of a Clumio API call to the Terraform
+// attributes they are set from. The keys are the field paths of the request without list indices,
+// such as "operations.slas.retention_duration". A field error is attributed to the attribute of the
+// longest key which is a prefix of its field, so mapping a top level field also covers the fields
+// nested within it. The attributes are given as expressions of attribute names, in which each
+// AtAnyListIndex takes the next list index of the field, so that the error of
+// "operations[0].slas[1].rpo_frequency" is attributed to the second SLA of the first operation.
+type ApiFieldPaths map[string]path.Expression
+
+// apiErrorBody is the envelope of the errors returned by the Clumio API.
+type apiErrorBody struct {
+	Errors []apiErrorEntry `json:"errors"`
+}
+
+// apiErrorEntry is a single error of the envelope of the errors returned by the Clumio API.
+type apiErrorEntry struct {
+	ErrorCode    json.Number     `json:"error_code"`
+	ErrorMessage string          `json:"error_message"`
+	FieldErrors  []apiFieldError `json:"field_errors"`
+}
+
+// apiFieldError is an error of the envelope which relates to a field of the request body.
+type apiFieldError struct {
+	Field        string `json:"field"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// message returns the message of the error followed by its error code, if any.
+func (e apiErrorEntry) message() string {
+	if e.ErrorCode == "" {
+		return e.ErrorMessage
+	}
+	return fmt.Sprintf("%s (error code %s)", e.ErrorMessage, e.ErrorCode)
+}
+
+// message returns the message of the field error prefixed with the field it relates to.
+func (e apiFieldError) message() string {
+	if e.Field == "" {
+		return e.ErrorMessage
+	}
+	return fmt.Sprintf("%s: %s", e.Field, e.ErrorMessage)
+}
+
+// parseApiErrorBody decodes the error envelope from the body of the given API error. It returns
+// false if the body is not an error envelope.
+func parseApiErrorBody(apiError *apiutils.APIError) (apiErrorBody, bool) {
+	var body apiErrorBody
+	if err := json.Unmarshal(apiError.Response, &body); err != nil || len(body.Errors) == 0 {
+		return apiErrorBody{}, false
+	}
+	for _, entry := range body.Errors {
+		if entry.ErrorMessage == "" && len(entry.FieldErrors) == 0 {
+			return apiErrorBody{}, false
+		}
+	}
+	return body, true
+}
+
+// ApiErrorDiagnostics returns the diagnostics with the given summary for the given API error. Field
+// errors which relate to a field in fieldPaths are reported as attribute errors on the attribute
+// the field is set from. Other errors are reported as a single error, falling back to the raw body
+// of the response if it is not a Clumio error envelope.
+func ApiErrorDiagnostics(
+	summary string, apiError *apiutils.APIError, fieldPaths ApiFieldPaths) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	body, ok := parseApiErrorBody(apiError)
+	if !ok || isAuthError(apiError) {
+		diags.AddError(summary, ParseMessageFromApiError(apiError))
+		return diags
+	}
+
+	var messages []string
+	for _, entry := range body.Errors {
+		if entry.ErrorMessage != "" {
+			messages = append(messages, entry.message())
+		}
+		for _, fieldError := range entry.FieldErrors {
+			if attrPath, ok := fieldPaths.resolve(fieldError.Field); ok {
+				diags.AddAttributeError(attrPath, summary, fieldError.message())
+			} else {
+				messages = append(messages, fieldError.message())
+			}
+		}
+	}
+	if len(messages) > 0 || !diags.HasError() {
+		diags.AddError(summary, strings.Join(messages, "\n"))
+	}
+	return diags
+}
+
+// resolve returns the path of the attribute the given field of the request body is set from.
+func (p ApiFieldPaths) resolve(field string) (path.Path, bool) {
+	segments := strings.Split(field, ".")
+	for n := len(segments); n > 0; n-- {
+		prefix := strings.Join(segments[:n], ".")
+		if expression, ok := p[fieldIndexRegex.ReplaceAllString(prefix, "")]; ok {
+			return attributePath(expression, fieldIndexRegex.FindAllString(prefix, -1))
+		}
+	}
+	return path.Empty(), false
+}
+
+// attributePath returns the path matched by the given expression, in which each list element step
+// takes the next of the given list indices of the field. It returns false if the expression has
+// other steps than attribute names and list elements or if there are not enough indices.
+func attributePath(expression path.Expression, indices []string) (path.Path, bool) {
+	attrPath := path.Empty()
+	for _, step := range expression.Steps() {
+		switch step := step.(type) {
+		case path.ExpressionStepAttributeNameExact:
+			attrPath = attrPath.AtName(string(step))
+		case path.ExpressionStepElementKeyIntAny:
+			if len(indices) == 0 {
+				return path.Empty(), false
+			}
+			index, err := strconv.Atoi(strings.Trim(indices[0], "[]"))
+			if err != nil {
+				return path.Empty(), false
+			}
+			attrPath = attrPath.AtListIndex(index)
+			indices = indices[1:]
+		default:
+			return path.Empty(), false
+		}
+	}
+	return attrPath, true
+}
+
+// isAuthError returns whether the given API error was returned for invalid credentials.
+func isAuthError(apiError *apiutils.APIError) bool {
+	return apiError.ResponseCode == http.StatusUnauthorized ||
+		apiError.ResponseCode == http.StatusForbidden
+}
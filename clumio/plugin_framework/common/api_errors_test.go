@@ -0,0 +1,80 @@
+// Copyright 2025. Clumio, Inc.
+
+//go:build unit
+
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for the function ApiErrorDiagnostics for the following cases:
+//   - Field errors are reported on the attribute of the longest matching field path, at the list
+//     indices of the field.
+//   - Errors without a field and field errors of unknown fields are reported as a single error.
+//   - Responses which are not an error envelope are reported as is.
+//   - Auth errors are reported with the custom auth error message.
+func TestApiErrorDiagnostics(t *testing.T) {
+
+	operationsPath := path.MatchRoot("operations").AtAnyListIndex()
+	fieldPaths := ApiFieldPaths{
+		"operations": operationsPath,
+		"operations.slas.retention_duration": operationsPath.AtName("slas").AtAnyListIndex().
+			AtName("retention_duration"),
+	}
+	summary := "Unable to create policy"
+
+	t.Run("Field errors are reported on attributes", func(t *testing.T) {
+		body := `{"errors":[{"error_code":400,"error_message":"Invalid request.","field_errors":[` +
+			`{"field":"operations[0].slas[1].retention_duration.value","error_message":"Too short."},` +
+			`{"field":"operations[0].type","error_message":"Unknown type."}]}]}`
+		apiErr := apiutils.NewAPIError("Bad Request", http.StatusBadRequest, []byte(body))
+
+		diags := ApiErrorDiagnostics(summary, apiErr, fieldPaths)
+		assert.Len(t, diags, 3)
+		retentionPath := path.Root("operations").AtListIndex(0).AtName("slas").AtListIndex(1).
+			AtName("retention_duration")
+		assert.Equal(t, diag.NewAttributeErrorDiagnostic(retentionPath, summary,
+			"operations[0].slas[1].retention_duration.value: Too short."), diags[0])
+		assert.Equal(t, diag.NewAttributeErrorDiagnostic(path.Root("operations").AtListIndex(0),
+			summary, "operations[0].type: Unknown type."), diags[1])
+		assert.Equal(t, diag.NewErrorDiagnostic(summary, "Invalid request. (error code 400)"),
+			diags[2])
+	})
+
+	t.Run("Errors without a known field are reported together", func(t *testing.T) {
+		body := `{"errors":[{"error_code":111,"error_message":"The request is invalid."},` +
+			`{"error_message":"Another error.","field_errors":[` +
+			`{"field":"name","error_message":"Name is taken."}]}]}`
+		apiErr := apiutils.NewAPIError("Bad Request", http.StatusBadRequest, []byte(body))
+
+		diags := ApiErrorDiagnostics(summary, apiErr, fieldPaths)
+		assert.Len(t, diags, 1)
+		assert.Equal(t, "The request is invalid. (error code 111)\nAnother error.\n"+
+			"name: Name is taken.", diags[0].Detail())
+	})
+
+	t.Run("Unknown shapes are reported as is", func(t *testing.T) {
+		body := `<html>Bad Gateway</html>`
+		apiErr := apiutils.NewAPIError("Bad Gateway", http.StatusBadGateway, []byte(body))
+
+		diags := ApiErrorDiagnostics(summary, apiErr, fieldPaths)
+		assert.Len(t, diags, 1)
+		assert.Equal(t, body, diags[0].Detail())
+	})
+
+	t.Run("Auth errors are reported with the auth error message", func(t *testing.T) {
+		body := `{"errors":[{"error_code":401,"error_message":"Unauthorized."}]}`
+		apiErr := apiutils.NewAPIError("Unauthorized", http.StatusUnauthorized, []byte(body))
+
+		diags := ApiErrorDiagnostics(summary, apiErr, fieldPaths)
+		assert.Len(t, diags, 1)
+		assert.Equal(t, AuthError, diags[0].Detail())
+	})
+}
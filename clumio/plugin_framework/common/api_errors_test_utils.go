@@ -0,0 +1,46 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the common test functions which are used by the unit tests of the field paths
+// of the resources.
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+)
+
+// RunApiFieldPathsTests runs a subtest per field of expectedPaths, which asserts that an error of
+// the Clumio API for the field is reported on the expected attribute path by ApiErrorDiagnostics
+// given fieldPaths. An empty expected path asserts that the error is reported without an attribute.
+func RunApiFieldPathsTests(
+	t *testing.T, fieldPaths ApiFieldPaths, expectedPaths map[string]path.Path) {
+
+	for field, expectedPath := range expectedPaths {
+		t.Run(field, func(t *testing.T) {
+			body := fmt.Sprintf(`{"errors":[{"error_code":400,"error_message":"Invalid request.",`+
+				`"field_errors":[{"field":%q,"error_message":"Invalid value."}]}]}`, field)
+			apiErr := apiutils.NewAPIError("Bad Request", http.StatusBadRequest, []byte(body))
+
+			diags := ApiErrorDiagnostics("Unable to create", apiErr, fieldPaths)
+			assert.True(t, diags.HasError())
+			var attributePaths []path.Path
+			for _, diagnostic := range diags {
+				if withPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
+					attributePaths = append(attributePaths, withPath.Path())
+				}
+			}
+			if expectedPath.Equal(path.Empty()) {
+				assert.Empty(t, attributePaths)
+			} else {
+				assert.Equal(t, []path.Path{expectedPath}, attributePaths)
+			}
+		})
+	}
+}
@@ -6,10 +6,18 @@ const (
 	ClumioApiToken                  = "CLUMIO_API_TOKEN"
 	ClumioApiBaseUrl                = "CLUMIO_API_BASE_URL"
 	ClumioOrganizationalUnitContext = "CLUMIO_ORGANIZATIONAL_UNIT_CONTEXT"
+	ClumioRegion                    = "CLUMIO_REGION"
+	ClumioProfile                   = "CLUMIO_PROFILE"
+	ClumioHttpsProxy                = "CLUMIO_HTTPS_PROXY"
+	ClumioCABundleFile              = "CLUMIO_CA_BUNDLE_FILE"
+	ClumioInsecureSkipVerify        = "CLUMIO_INSECURE_SKIP_VERIFY"
+	ClumioRequestTimeout            = "CLUMIO_REQUEST_TIMEOUT"
+	ClumioConfigFile                = "CLUMIO_CONFIG_FILE"
 	AwsRegion                       = "AWS_REGION"
 	ClumioTestAwsAccountId          = "CLUMIO_TEST_AWS_ACCOUNT_ID"
 	ClumioTestAwsAccountId2         = "CLUMIO_TEST_AWS_ACCOUNT_ID2"
 	ClumioTestIsSSOConfigured       = "CLUMIO_TEST_IS_SSO_CONFIGURED"
+	ClumioTestGcpProjectId          = "CLUMIO_TEST_GCP_PROJECT_ID"
 
 	TaskSuccess    = "completed"
 	TaskAborted    = "aborted"
@@ -30,6 +38,10 @@ const (
 	NilErrorMessageSummary = "Unexpected API response"
 	NilErrorMessageDetail  = "An empty response was returned by the API"
 
+	// AccTestNamePrefix is the prefix of the names of the objects created by the acceptance
+	// tests. The test sweepers delete the objects whose name starts with it.
+	AccTestNamePrefix = "acceptance-test"
+
 	// Testing error format
 	TestResultsNotMatchingError = "Results don't match.\nExpected: %v\nActual: %v"
 
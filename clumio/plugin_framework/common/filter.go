@@ -0,0 +1,139 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the builders of the filter and sort query parameters of the Clumio list APIs.
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// Operators supported in the filter query parameter of the Clumio list APIs. The operators
+// supported by a given field are listed in the documentation of the API.
+const (
+	FilterEq          = "$eq"
+	FilterNotEq       = "$not_eq"
+	FilterContains    = "$contains"
+	FilterNotContains = "$not_contains"
+	FilterBeginsWith  = "$begins_with"
+	FilterIn          = "$in"
+	FilterNotIn       = "$not_in"
+	FilterAll         = "$all"
+	FilterNotAll      = "$not_all"
+	FilterGt          = "$gt"
+	FilterGte         = "$gte"
+	FilterLt          = "$lt"
+	FilterLte         = "$lte"
+)
+
+// Filter builds the filter query parameter of the Clumio list APIs, such as
+// {"name":{"$contains":"prod"}}. The filter is marshalled with encoding/json so that the values are
+// escaped and can hold any character. A Filter is built by chaining its methods:
+//
+//	filter := common.NewFilter().Eq("aws_region", region).Contains("name", name).String()
+//
+// The values of the conditions are restricted to strings, lists of strings and integers, which
+// are always JSON serializable.
+type Filter map[string]map[string]any
+
+// NewFilter returns an empty Filter.
+func NewFilter() Filter {
+	return Filter{}
+}
+
+// Where adds the condition on the given field with the given operator and value. A condition with
+// the same field and operator replaces the previous one.
+func (f Filter) Where(field string, operator string, value string) Filter {
+	return f.where(field, operator, value)
+}
+
+// WhereValues adds the condition on the given field with the given operator and list of values,
+// such as FilterIn. A nil list is sent as an empty list.
+func (f Filter) WhereValues(field string, operator string, values []string) Filter {
+	if values == nil {
+		values = []string{}
+	}
+	return f.where(field, operator, values)
+}
+
+// WhereInt adds the condition on the given field with the given operator and integer value, such
+// as FilterGt.
+func (f Filter) WhereInt(field string, operator string, value int64) Filter {
+	return f.where(field, operator, value)
+}
+
+// where adds the condition on the given field with the given operator and value, replacing the
+// previous condition with the same field and operator.
+func (f Filter) where(field string, operator string, value any) Filter {
+	if _, ok := f[field]; !ok {
+		f[field] = map[string]any{}
+	}
+	f[field][operator] = value
+	return f
+}
+
+// Eq adds the condition that the given field equals the given value.
+func (f Filter) Eq(field string, value string) Filter {
+	return f.Where(field, FilterEq, value)
+}
+
+// NotEq adds the condition that the given field does not equal the given value.
+func (f Filter) NotEq(field string, value string) Filter {
+	return f.Where(field, FilterNotEq, value)
+}
+
+// Contains adds the condition that the given field contains the given value.
+func (f Filter) Contains(field string, value string) Filter {
+	return f.Where(field, FilterContains, value)
+}
+
+// BeginsWith adds the condition that the given field begins with the given prefix.
+func (f Filter) BeginsWith(field string, prefix string) Filter {
+	return f.Where(field, FilterBeginsWith, prefix)
+}
+
+// In adds the condition that the given field equals one of the given values.
+func (f Filter) In(field string, values []string) Filter {
+	return f.WhereValues(field, FilterIn, values)
+}
+
+// NotIn adds the condition that the given field equals none of the given values.
+func (f Filter) NotIn(field string, values []string) Filter {
+	return f.WhereValues(field, FilterNotIn, values)
+}
+
+// All adds the condition that the given field holds all the given values.
+func (f Filter) All(field string, values []string) Filter {
+	return f.WhereValues(field, FilterAll, values)
+}
+
+// String returns the filter query parameter. An empty Filter returns "{}".
+func (f Filter) String() string {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	// The encoding cannot fail as the values are strings, lists of strings and integers.
+	_ = encoder.Encode(map[string]map[string]any(f))
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// OptionalString returns the filter query parameter, or nil if the Filter is empty so that the
+// parameter is omitted from the request.
+func (f Filter) OptionalString() *string {
+	if len(f) == 0 {
+		return nil
+	}
+	filter := f.String()
+	return &filter
+}
+
+// Sort returns the sort query parameter of the Clumio list APIs sorting by the given field, in
+// descending order if requested.
+func Sort(field string, descending bool) string {
+	if descending {
+		return "-" + field
+	}
+	return field
+}
@@ -0,0 +1,119 @@
+// Copyright 2025. Clumio, Inc.
+
+//go:build unit
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for Filter.OptionalString. Tests that an empty filter is omitted and that a filter
+// with conditions is returned as its query parameter.
+func TestFilterOptionalString(t *testing.T) {
+
+	assert.Nil(t, NewFilter().OptionalString())
+
+	filter := NewFilter().Eq("aws_region", "us-west-2").OptionalString()
+	if assert.NotNil(t, filter) {
+		assert.Equal(t, `{"aws_region":{"$eq":"us-west-2"}}`, *filter)
+	}
+}
+
+// Unit test for the Filter builder. Tests that the filters are built with the expected operators
+// and that values holding quotes, backslashes and other special characters are escaped so that the
+// filter is valid JSON and decodes back to the given values.
+func TestFilter(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected string
+	}{
+		{
+			name:     "Empty filter",
+			filter:   NewFilter(),
+			expected: `{}`,
+		},
+		{
+			name:     "Equality",
+			filter:   NewFilter().Eq("aws_region", "us-west-2"),
+			expected: `{"aws_region":{"$eq":"us-west-2"}}`,
+		},
+		{
+			name:     "Several fields",
+			filter:   NewFilter().Eq("account_native_id", "123456789012").Contains("name", "prod"),
+			expected: `{"account_native_id":{"$eq":"123456789012"},"name":{"$contains":"prod"}}`,
+		},
+		{
+			name:     "Several operators on a field",
+			filter:   NewFilter().WhereInt("size", FilterGte, 10).WhereInt("size", FilterLt, 20),
+			expected: `{"size":{"$gte":10,"$lt":20}}`,
+		},
+		{
+			name:     "Operator given to Where",
+			filter:   NewFilter().Where("name", FilterNotContains, "test"),
+			expected: `{"name":{"$not_contains":"test"}}`,
+		},
+		{
+			name:     "Operator given to WhereValues",
+			filter:   NewFilter().WhereValues("tags", FilterNotAll, []string{"a", "b"}),
+			expected: `{"tags":{"$not_all":["a","b"]}}`,
+		},
+		{
+			name:     "In with values",
+			filter:   NewFilter().In("name", []string{"bucket-1", "bucket-2"}),
+			expected: `{"name":{"$in":["bucket-1","bucket-2"]}}`,
+		},
+		{
+			name:     "In without values",
+			filter:   NewFilter().In("name", nil),
+			expected: `{"name":{"$in":[]}}`,
+		},
+		{
+			name:     "Quote is escaped",
+			filter:   NewFilter().Contains("name", `my "table"`),
+			expected: `{"name":{"$contains":"my \"table\""}}`,
+		},
+		{
+			name:     "Backslash is escaped",
+			filter:   NewFilter().BeginsWith("name", `dir\name`),
+			expected: `{"name":{"$begins_with":"dir\\name"}}`,
+		},
+		{
+			name:     "Injection attempt stays a value",
+			filter:   NewFilter().Eq("name", `x"}, "role_id": {"$eq":"admin`),
+			expected: `{"name":{"$eq":"x\"}, \"role_id\": {\"$eq\":\"admin"}}`,
+		},
+		{
+			name:     "Control characters and HTML are kept readable",
+			filter:   NewFilter().NotEq("description", "a\nb<c>&"),
+			expected: `{"description":{"$not_eq":"a\nb<c>&"}}`,
+		},
+		{
+			name:     "Values in lists are escaped",
+			filter:   NewFilter().All("tags", []string{`"quoted"`, `back\slash`}),
+			expected: `{"tags":{"$all":["\"quoted\"","back\\slash"]}}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter := test.filter.String()
+			assert.Equal(t, test.expected, filter)
+
+			var decoded map[string]map[string]any
+			assert.Nil(t, json.Unmarshal([]byte(filter), &decoded))
+			assert.Len(t, decoded, len(test.filter))
+		})
+	}
+}
+
+// Unit test for the function Sort.
+func TestSort(t *testing.T) {
+
+	assert.Equal(t, "name", Sort("name", false))
+	assert.Equal(t, "-created_timestamp", Sort("created_timestamp", true))
+}
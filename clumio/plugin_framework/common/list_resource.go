@@ -0,0 +1,83 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the helpers used by the list resources to stream the Clumio objects they list to
+// `terraform query`.
+
+package common
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ListItem is a Clumio object listed by a list resource.
+type ListItem struct {
+	// Id is the identifier of the object used to read its resource.
+	Id string
+	// DisplayName is the human readable name of the object shown by `terraform query`.
+	DisplayName string
+	// Identity is the identity model of the resource of the object.
+	Identity any
+}
+
+// ListLimit returns the ListOptions reading at most the number of items requested by the given
+// list request.
+func ListLimit(req list.ListRequest) ListOptions {
+	return ListOptions{MaxResults: req.Limit}
+}
+
+// ListResults returns the results streamed by a list resource for the given items. If the resource
+// of the items is requested, it is read with readResource into a model of type M in which every
+// attribute is null, as the state of an imported resource. Items whose resource no longer exists,
+// as reported by readResource, are skipped. If listing the items failed, the errors in the given
+// diagnostics are streamed instead.
+func ListResults[M any](ctx context.Context, req list.ListRequest, items []ListItem,
+	listDiags diag.Diagnostics,
+	readResource func(ctx context.Context, id string, model *M) (bool, diag.Diagnostics),
+) iter.Seq[list.ListResult] {
+
+	if listDiags.HasError() {
+		return list.ListResultsStreamDiagnostics(listDiags)
+	}
+	return func(push func(list.ListResult) bool) {
+		for _, item := range items {
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.DisplayName
+			result.Diagnostics.Append(result.Identity.Set(ctx, item.Identity)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				var model M
+				result.Diagnostics.Append(NullResourceModel(ctx, result.Resource, &model)...)
+				if !result.Diagnostics.HasError() {
+					remove, diags := readResource(ctx, item.Id, &model)
+					result.Diagnostics.Append(diags...)
+					if remove {
+						continue
+					}
+				}
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// NullResourceModel sets every attribute of the given resource to null and reads it into the given
+// model.
+func NullResourceModel(ctx context.Context, resource *tfsdk.Resource, model any) diag.Diagnostics {
+	objectType := resource.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	resource.Raw = tftypes.NewValue(objectType, values)
+	return resource.Get(ctx, model)
+}
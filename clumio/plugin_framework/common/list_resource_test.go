@@ -0,0 +1,164 @@
+// Copyright 2025. Clumio, Inc.
+
+//go:build unit
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testListResourceModel is the resource model of the list requests of the unit tests.
+type testListResourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// testListIdentityModel is the identity model of the list requests of the unit tests.
+type testListIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+// testListRequest returns a list request of the unit tests for a resource with an id and a name.
+func testListRequest(includeResource bool) list.ListRequest {
+	return list.ListRequest{
+		IncludeResource: includeResource,
+		ResourceSchema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":   schema.StringAttribute{Computed: true},
+				"name": schema.StringAttribute{Optional: true},
+			},
+		},
+		ResourceIdentitySchema: identityschema.Schema{
+			Attributes: map[string]identityschema.Attribute{
+				"id": identityschema.StringAttribute{RequiredForImport: true},
+			},
+		},
+	}
+}
+
+// testListItems returns the listed items of the unit tests with the given IDs.
+func testListItems(ids ...string) []ListItem {
+	items := make([]ListItem, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, ListItem{
+			Id:          id,
+			DisplayName: "name-" + id,
+			Identity:    testListIdentityModel{Id: types.StringValue(id)},
+		})
+	}
+	return items
+}
+
+// collectListResults returns the results pushed by the given list results.
+func collectListResults(results func(func(list.ListResult) bool)) []list.ListResult {
+	var collected []list.ListResult
+	results(func(result list.ListResult) bool {
+		collected = append(collected, result)
+		return true
+	})
+	return collected
+}
+
+// Unit test for ListResults. Tests that the display name and identity of every item are streamed,
+// that the resource is read only if requested and that items whose resource no longer exists are
+// skipped.
+func TestListResults(t *testing.T) {
+
+	ctx := context.Background()
+	readResource := func(_ context.Context, id string, model *testListResourceModel) (
+		bool, diag.Diagnostics) {
+
+		if id == "removed" {
+			return true, nil
+		}
+		assert.True(t, model.Name.IsNull())
+		model.Id = types.StringValue(id)
+		model.Name = types.StringValue("name-" + id)
+		return false, nil
+	}
+
+	// Tests that only the display name and identity are streamed if the resource is not requested.
+	t.Run("Resource not requested", func(t *testing.T) {
+
+		results := collectListResults(ListResults(ctx, testListRequest(false),
+			testListItems("id-1", "id-2"), nil,
+			func(context.Context, string, *testListResourceModel) (bool, diag.Diagnostics) {
+				t.Fatal("the resource should not be read")
+				return false, nil
+			}))
+
+		assert.Len(t, results, 2)
+		for i, result := range results {
+			assert.False(t, result.Diagnostics.HasError())
+			var identity testListIdentityModel
+			assert.False(t, result.Identity.Get(ctx, &identity).HasError())
+			assert.Equal(t, testListItems("id-1", "id-2")[i].Identity, identity)
+			assert.Equal(t, testListItems("id-1", "id-2")[i].DisplayName, result.DisplayName)
+			assert.True(t, result.Resource.Raw.IsNull())
+		}
+	})
+
+	// Tests that the resource is read and set if requested and that removed resources are skipped.
+	t.Run("Resource requested", func(t *testing.T) {
+
+		results := collectListResults(ListResults(ctx, testListRequest(true),
+			testListItems("id-1", "removed", "id-2"), nil, readResource))
+
+		assert.Len(t, results, 2)
+		for i, id := range []string{"id-1", "id-2"} {
+			assert.False(t, results[i].Diagnostics.HasError())
+			var model testListResourceModel
+			assert.False(t, results[i].Resource.Get(ctx, &model).HasError())
+			assert.Equal(t, id, model.Id.ValueString())
+			assert.Equal(t, "name-"+id, model.Name.ValueString())
+		}
+	})
+
+	// Tests that the error of reading a resource is streamed with its item.
+	t.Run("Reading the resource returns an error", func(t *testing.T) {
+
+		results := collectListResults(ListResults(ctx, testListRequest(true),
+			testListItems("id-1"), nil,
+			func(context.Context, string, *testListResourceModel) (bool, diag.Diagnostics) {
+				var diags diag.Diagnostics
+				diags.AddError("Test Error", "Test Error")
+				return false, diags
+			}))
+
+		assert.Len(t, results, 1)
+		assert.True(t, results[0].Diagnostics.HasError())
+	})
+
+	// Tests that the errors of listing the items are streamed instead of the items.
+	t.Run("Listing the items returns an error", func(t *testing.T) {
+
+		var listDiags diag.Diagnostics
+		listDiags.AddError("Test Error", "Test Error")
+		results := collectListResults(ListResults(ctx, testListRequest(true),
+			testListItems("id-1"), listDiags, readResource))
+
+		assert.Len(t, results, 1)
+		assert.True(t, results[0].Diagnostics.HasError())
+	})
+
+	// Tests that no more results are streamed once Terraform stops reading them.
+	t.Run("Streaming stops early", func(t *testing.T) {
+
+		count := 0
+		ListResults(ctx, testListRequest(true), testListItems("id-1", "id-2"), nil,
+			readResource)(func(list.ListResult) bool {
+			count++
+			return false
+		})
+		assert.Equal(t, 1, count)
+	})
+}
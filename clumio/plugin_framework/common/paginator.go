@@ -0,0 +1,142 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the paginator used by the data sources to read every page of the Clumio list APIs.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultPageSize is the number of items requested per page of a Clumio list API.
+	DefaultPageSize = int64(1000)
+
+	// startParam is the query parameter of the link to the next page holding its start token.
+	startParam = "start"
+)
+
+// Page is a page of items returned by a Clumio list API.
+type Page[T any] struct {
+	// Items of the page.
+	Items []T
+	// NextHref is the href of the _links._next link of the response, or nil on the last page.
+	NextHref *string
+}
+
+// ListOptions holds the settings of ListAll.
+type ListOptions struct {
+	// PageSize is the number of items requested per page. DefaultPageSize is used if it is 0.
+	PageSize int64
+	// MaxResults is the maximum number of items to return. Every item is returned if it is 0.
+	MaxResults int64
+}
+
+// DataSourceListOptions returns the ListOptions of a data source with the given max_results and
+// page_size attributes.
+func DataSourceListOptions(maxResults types.Int64, pageSize types.Int64) ListOptions {
+	return ListOptions{MaxResults: maxResults.ValueInt64(), PageSize: pageSize.ValueInt64()}
+}
+
+// ListAll calls the given function for every page of a Clumio list API, following the start token
+// of the link to the next page until the last page, and returns the items of all the pages. The
+// function is called with the page size and the start token of the page, which is nil for the
+// first page. If options.MaxResults is set, at most that many items are returned and a warning is
+// added if there were more. The name of the data source is used in the diagnostics.
+func ListAll[T any](ctx context.Context, name string, options ListOptions,
+	listPage func(limit *int64, start *string) (*Page[T], *apiutils.APIError)) (
+	[]T, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	limit := options.PageSize
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	if options.MaxResults > 0 && options.MaxResults < limit {
+		limit = options.MaxResults
+	}
+
+	items := make([]T, 0)
+	var start *string
+	seen := map[string]bool{}
+	for {
+		page, apiErr := listPage(&limit, start)
+		if apiErr != nil {
+			summary := fmt.Sprintf("Unable to read %s", name)
+			detail := ParseMessageFromApiError(apiErr)
+			diags.AddError(summary, detail)
+			return nil, diags
+		}
+		if page == nil {
+			summary := NilErrorMessageSummary
+			detail := NilErrorMessageDetail
+			diags.AddError(summary, detail)
+			return nil, diags
+		}
+		items = append(items, page.Items...)
+		start = nextPageStart(page.NextHref)
+
+		if options.MaxResults > 0 && int64(len(items)) >= options.MaxResults {
+			if int64(len(items)) > options.MaxResults || start != nil {
+				diags.Append(truncatedResultsWarning(name, options.MaxResults))
+			}
+			return items[:options.MaxResults], diags
+		}
+		// Stop if the API returns the link to a page which was already read, so that a
+		// misbehaving API cannot make the data source loop forever.
+		if start == nil || seen[*start] {
+			break
+		}
+		seen[*start] = true
+		tflog.Debug(ctx, "Reading the next page", map[string]any{
+			"data_source": name,
+			"start":       *start,
+			"items":       len(items),
+		})
+	}
+	return items, diags
+}
+
+// TruncateResults returns at most maxResults of the given items and a warning if some were
+// dropped. It is used by the data sources which filter the listed items themselves and so cannot
+// pass max_results to ListAll. Every item is returned if maxResults is not set.
+func TruncateResults[T any](name string, items []T, maxResults types.Int64) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	limit := maxResults.ValueInt64()
+	if limit <= 0 || int64(len(items)) <= limit {
+		return items, diags
+	}
+	diags.Append(truncatedResultsWarning(name, limit))
+	return items[:limit], diags
+}
+
+// truncatedResultsWarning returns the warning added when the results of the data source with the
+// given name were truncated to maxResults items.
+func truncatedResultsWarning(name string, maxResults int64) diag.Diagnostic {
+	summary := fmt.Sprintf("Results of %s Truncated", name)
+	detail := fmt.Sprintf("Only the first %d results were read as set by max_results. Increase"+
+		" max_results or narrow down the filters of the data source to read every result.",
+		maxResults)
+	return diag.NewWarningDiagnostic(summary, detail)
+}
+
+// nextPageStart returns the start token of the page the given href of the link to the next page
+// points to, or nil if there is no next page. The href is either a URL holding the token in its
+// start query parameter or the token itself.
+func nextPageStart(nextHref *string) *string {
+	if nextHref == nil || *nextHref == "" {
+		return nil
+	}
+	if parsed, err := url.Parse(*nextHref); err == nil && parsed.Query().Has(startParam) {
+		start := parsed.Query().Get(startParam)
+		return &start
+	}
+	return nextHref
+}
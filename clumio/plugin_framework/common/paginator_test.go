@@ -0,0 +1,184 @@
+// Copyright 2025. Clumio, Inc.
+
+//go:build unit
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for the function ListAll for the following cases:
+//   - Every page is read by following the start token of the link to the next page.
+//   - Results are capped by MaxResults with a warning if there were more results.
+//   - No warning is added if the results exactly fill MaxResults on the last page.
+//   - Errors and empty responses of the list API are returned as diagnostics.
+//   - Reading stops if the API returns the link to a page which was already read.
+func TestListAll(t *testing.T) {
+
+	ctx := context.Background()
+	name := "test_data_source"
+	nextHref := func(start string) *string {
+		href := "/datasources/aws/s3-buckets?limit=2&start=" + start
+		return &href
+	}
+
+	// pagesLister returns a function listing the given pages, keyed by their start token, and
+	// recording the start tokens and limits it was called with.
+	pagesLister := func(pages map[string]*Page[string], starts *[]string, limits *[]int64) func(
+		limit *int64, start *string) (*Page[string], *apiutils.APIError) {
+
+		return func(limit *int64, start *string) (*Page[string], *apiutils.APIError) {
+			key := ""
+			if start != nil {
+				key = *start
+			}
+			*starts = append(*starts, key)
+			*limits = append(*limits, *limit)
+			return pages[key], nil
+		}
+	}
+
+	pages := map[string]*Page[string]{
+		"":  {Items: []string{"a", "b"}, NextHref: nextHref("2")},
+		"2": {Items: []string{"c", "d"}, NextHref: nextHref("3")},
+		"3": {Items: []string{"e"}},
+	}
+
+	t.Run("Every page is read", func(t *testing.T) {
+		var starts []string
+		var limits []int64
+		items, diags := ListAll(ctx, name, ListOptions{}, pagesLister(pages, &starts, &limits))
+		assert.Nil(t, diags)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, items)
+		assert.Equal(t, []string{"", "2", "3"}, starts)
+		assert.Equal(t, []int64{DefaultPageSize, DefaultPageSize, DefaultPageSize}, limits)
+	})
+
+	t.Run("Page size is used as the limit", func(t *testing.T) {
+		var starts []string
+		var limits []int64
+		options := ListOptions{PageSize: 2}
+		_, diags := ListAll(ctx, name, options, pagesLister(pages, &starts, &limits))
+		assert.Nil(t, diags)
+		assert.Equal(t, []int64{2, 2, 2}, limits)
+	})
+
+	t.Run("Results are capped by max results", func(t *testing.T) {
+		var starts []string
+		var limits []int64
+		options := ListOptions{MaxResults: 3}
+		items, diags := ListAll(ctx, name, options, pagesLister(pages, &starts, &limits))
+		assert.Equal(t, []string{"a", "b", "c"}, items)
+		assert.Equal(t, []string{"", "2"}, starts)
+		assert.Equal(t, []int64{3, 3}, limits)
+		assert.Len(t, diags, 1)
+		assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+		assert.Equal(t, "Results of test_data_source Truncated", diags[0].Summary())
+	})
+
+	t.Run("Warning is added if more pages are left", func(t *testing.T) {
+		var starts []string
+		var limits []int64
+		options := ListOptions{MaxResults: 4}
+		items, diags := ListAll(ctx, name, options, pagesLister(pages, &starts, &limits))
+		assert.Equal(t, []string{"a", "b", "c", "d"}, items)
+		assert.Len(t, diags, 1)
+		assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	})
+
+	t.Run("No warning if the last page fills max results", func(t *testing.T) {
+		var starts []string
+		var limits []int64
+		options := ListOptions{MaxResults: 5}
+		items, diags := ListAll(ctx, name, options, pagesLister(pages, &starts, &limits))
+		assert.Nil(t, diags)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, items)
+	})
+
+	t.Run("List API returns an error", func(t *testing.T) {
+		apiErr := apiutils.NewAPIError("Internal Server Error", http.StatusInternalServerError,
+			[]byte("Test Error"))
+		items, diags := ListAll(ctx, name, ListOptions{},
+			func(limit *int64, start *string) (*Page[string], *apiutils.APIError) {
+				return nil, apiErr
+			})
+		assert.Nil(t, items)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Unable to read test_data_source", diags[0].Summary())
+	})
+
+	t.Run("List API returns an empty response", func(t *testing.T) {
+		items, diags := ListAll(ctx, name, ListOptions{},
+			func(limit *int64, start *string) (*Page[string], *apiutils.APIError) {
+				return nil, nil
+			})
+		assert.Nil(t, items)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, NilErrorMessageSummary, diags[0].Summary())
+	})
+
+	t.Run("Reading stops on a page which was already read", func(t *testing.T) {
+		var starts []string
+		var limits []int64
+		loop := map[string]*Page[string]{
+			"":  {Items: []string{"a"}, NextHref: nextHref("2")},
+			"2": {Items: []string{"b"}, NextHref: nextHref("2")},
+		}
+		items, diags := ListAll(ctx, name, ListOptions{}, pagesLister(loop, &starts, &limits))
+		assert.Nil(t, diags)
+		assert.Equal(t, []string{"a", "b"}, items)
+		assert.Equal(t, []string{"", "2"}, starts)
+	})
+}
+
+// Unit test for the function DataSourceListOptions. Tests that the max_results and page_size
+// attributes are set in the options and that unset attributes leave the defaults.
+func TestDataSourceListOptions(t *testing.T) {
+
+	options := DataSourceListOptions(types.Int64Value(10), types.Int64Value(50))
+	assert.Equal(t, ListOptions{MaxResults: 10, PageSize: 50}, options)
+
+	options = DataSourceListOptions(types.Int64Null(), types.Int64Null())
+	assert.Equal(t, ListOptions{}, options)
+}
+
+// Unit test for the function TruncateResults.
+func TestTruncateResults(t *testing.T) {
+
+	items := []string{"a", "b", "c"}
+
+	result, diags := TruncateResults("test", items, types.Int64Null())
+	assert.Nil(t, diags)
+	assert.Equal(t, items, result)
+
+	result, diags = TruncateResults("test", items, types.Int64Value(3))
+	assert.Nil(t, diags)
+	assert.Equal(t, items, result)
+
+	result, diags = TruncateResults("test", items, types.Int64Value(2))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Equal(t, []string{"a", "b"}, result)
+}
+
+// Unit test for the function nextPageStart.
+func TestNextPageStart(t *testing.T) {
+
+	assert.Nil(t, nextPageStart(nil))
+	empty := ""
+	assert.Nil(t, nextPageStart(&empty))
+
+	href := "/policies/rules?limit=100&start=abc%3D%3D"
+	assert.Equal(t, "abc==", *nextPageStart(&href))
+
+	token := "2"
+	assert.Equal(t, "2", *nextPageStart(&token))
+}
@@ -1,8 +1,27 @@
 package common
 
-import clumioConfig "github.com/clumio-code/clumio-go-sdk/config"
+import (
+	"context"
+
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	clumioConfig "github.com/clumio-code/clumio-go-sdk/config"
+)
 
 // ApiClient defines the APIs/connections required by the resources.
 type ApiClient struct {
 	ClumioConfig clumioConfig.Config
+	// Middlewares are applied to every call made through the SDK clients created by the resources.
+	Middlewares []sdkclients.Middleware
+	// DeletionProtection is the deletion protection of the resources which support it and do not
+	// set their own deletion_protection attribute.
+	DeletionProtection bool
+}
+
+// CallMiddlewares returns the Middlewares applied in the context of the given Terraform operation,
+// so that the calls made by the operation are logged with its fields and that the retries and the
+// limiter stop waiting once the context is done. The SDK clients must therefore be created with the
+// context bounded by the timeout of the operation, if any.
+func (c *ApiClient) CallMiddlewares(ctx context.Context) []sdkclients.Middleware {
+	return sdkclients.WithContext(ctx, c.Middlewares...)
 }
@@ -0,0 +1,163 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the poller used to wait for the completion of the asynchronous tasks returned by the
+// Clumio API and the diagnostic reporting why a task did not complete.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clumio-code/clumio-go-sdk/controllers/tasks"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxTaskPollInterval is the longest interval between two polls of a task. The interval starts at
+// the interval given to PollTask and doubles after every poll until it reaches this value.
+const maxTaskPollInterval = 30 * time.Second
+
+// TaskError is returned by PollTask when a task completes without succeeding. It holds the details
+// reported by the task so that the reason of the failure can be surfaced to the user.
+type TaskError struct {
+	// TaskId is the identifier of the task.
+	TaskId string
+	// Type is the type of the task, such as "policy_update".
+	Type string
+	// Status is the final status of the task, either aborted or failed.
+	Status string
+	// ErrorCode is the code of the error reported by the task, if any.
+	ErrorCode string
+	// ErrorMessage is the message of the error reported by the task, if any.
+	ErrorMessage string
+}
+
+// Error returns a single line description of the task error.
+func (e *TaskError) Error() string {
+	msg := fmt.Sprintf("Task %s %s", e.TaskId, e.Status)
+	if e.Type != "" {
+		msg = fmt.Sprintf("Task %s (%s) %s", e.TaskId, e.Type, e.Status)
+	}
+	if e.ErrorMessage != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.ErrorMessage)
+	}
+	if e.ErrorCode != "" {
+		msg = fmt.Sprintf("%s (error code %s)", msg, e.ErrorCode)
+	}
+	return msg
+}
+
+// PollTask polls the task with the given id till it completes either with success, aborted or
+// failed, or till the given timeout elapses. The first poll happens after the given interval, which
+// then doubles after every poll up to maxTaskPollInterval but never beyond the timeout. The progress
+// of the task is logged at DEBUG and its status transitions at INFO. If the task is aborted or
+// failed, the returned error is a *TaskError.
+func PollTask(ctx context.Context, taskClient tasks.TasksV1Client,
+	taskId string, timeout time.Duration, interval time.Duration) error {
+
+	deadline := time.Now().Add(timeout)
+	lastStatus := ""
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			tflog.Warn(ctx, "Timed out polling Clumio task", map[string]any{
+				"task_id":     taskId,
+				"last_status": lastStatus,
+				"timeout":     timeout.String(),
+			})
+			return errors.New("polling task timeout")
+		}
+		timer := time.NewTimer(min(interval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		resp, apiErr := taskClient.ReadTask(taskId)
+		if apiErr != nil {
+			return fmt.Errorf("unable to read task %s: %s", taskId, ParseMessageFromApiError(apiErr))
+		}
+		interval = nextTaskPollInterval(interval)
+		if resp == nil || resp.Status == nil {
+			continue
+		}
+
+		status := *resp.Status
+		taskType := derefString(resp.Type)
+		logFields := map[string]any{
+			"task_id": taskId,
+			"status":  status,
+		}
+		if resp.Progress != nil {
+			logFields["progress"] = *resp.Progress
+		}
+		tflog.Debug(ctx, "Polled Clumio task", logFields)
+		if status != lastStatus {
+			tflog.Info(ctx, "Clumio task status changed", map[string]any{
+				"task_id":         taskId,
+				"task_type":       taskType,
+				"previous_status": lastStatus,
+				"status":          status,
+			})
+			lastStatus = status
+		}
+
+		switch status {
+		case TaskSuccess:
+			return nil
+		case TaskAborted, TaskFailed:
+			return &TaskError{
+				TaskId:       taskId,
+				Type:         taskType,
+				Status:       status,
+				ErrorCode:    derefString(resp.ErrorCode),
+				ErrorMessage: derefString(resp.ErrorMessage),
+			}
+		}
+	}
+}
+
+// TaskErrorDiagnostic returns the error diagnostic with the given summary for an error returned by
+// PollTask. If the task failed, the detail lists its type, error code and error message.
+func TaskErrorDiagnostic(summary string, err error) diag.Diagnostic {
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) {
+		return diag.NewErrorDiagnostic(summary, err.Error())
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "Task %s %s.", taskErr.TaskId, taskErr.Status)
+	if taskErr.Type != "" {
+		fmt.Fprintf(&detail, "\nTask type: %s", taskErr.Type)
+	}
+	if taskErr.ErrorCode != "" {
+		fmt.Fprintf(&detail, "\nError code: %s", taskErr.ErrorCode)
+	}
+	if taskErr.ErrorMessage != "" {
+		fmt.Fprintf(&detail, "\nError message: %s", taskErr.ErrorMessage)
+	}
+	return diag.NewErrorDiagnostic(summary, detail.String())
+}
+
+// nextTaskPollInterval returns the interval to wait for before the poll following one made after
+// the given interval.
+func nextTaskPollInterval(interval time.Duration) time.Duration {
+	if interval >= maxTaskPollInterval {
+		return interval
+	}
+	return min(2*interval, maxTaskPollInterval)
+}
+
+// derefString returns the value of the given string pointer, or "" if it is nil.
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,158 @@
+// Copyright 2025. Clumio, Inc.
+
+//go:build unit
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for the task poller for the following cases:
+//   - A failed task returns a TaskError.
+//   - The type, error code and error message of a failed task are in the detail of its diagnostic.
+//   - The status transitions of a task are logged once.
+//   - An error reading the task mentions the task id.
+//   - Polling stops when the context is canceled.
+func TestPollTaskDetails(t *testing.T) {
+
+	taskId := "12345"
+	inProgress := TaskInProgress
+	completed := TaskSuccess
+	failed := TaskFailed
+
+	t.Run("Failed task returns a TaskError", func(t *testing.T) {
+		mockTaskClient := sdkclients.NewMockTaskClient(t)
+		mockTaskClient.EXPECT().ReadTask(taskId).Times(1).Return(
+			&models.ReadTaskResponse{Status: &failed}, nil)
+
+		err := PollTask(context.Background(), mockTaskClient, taskId, 5*time.Second, 1)
+		var taskErr *TaskError
+		assert.True(t, errors.As(err, &taskErr))
+		assert.Equal(t, taskId, taskErr.TaskId)
+		assert.Equal(t, TaskFailed, taskErr.Status)
+		assert.Equal(t, "Task 12345 failed", err.Error())
+	})
+
+	t.Run("Details of a failed task are in its diagnostic", func(t *testing.T) {
+		taskType := "policy_update"
+		errorCode := "3015"
+		errorMessage := "The policy is in use."
+		mockTaskClient := sdkclients.NewMockTaskClient(t)
+		mockTaskClient.EXPECT().ReadTask(taskId).Times(1).Return(
+			&models.ReadTaskResponse{
+				Status:       &failed,
+				Type:         &taskType,
+				ErrorCode:    &errorCode,
+				ErrorMessage: &errorMessage,
+			}, nil)
+
+		err := PollTask(context.Background(), mockTaskClient, taskId, 5*time.Second, 1)
+		diagnostic := TaskErrorDiagnostic("Unable to update policy", err)
+		assert.Equal(t, "Task 12345 failed.\nTask type: policy_update\nError code: 3015"+
+			"\nError message: The policy is in use.", diagnostic.Detail())
+	})
+
+	t.Run("Status transitions are logged once", func(t *testing.T) {
+		var output bytes.Buffer
+		ctx := tflogtest.RootLogger(context.Background(), &output)
+		mockTaskClient := sdkclients.NewMockTaskClient(t)
+		mockTaskClient.EXPECT().ReadTask(taskId).Times(2).Return(
+			&models.ReadTaskResponse{Status: &inProgress}, nil)
+		mockTaskClient.EXPECT().ReadTask(taskId).Times(1).Return(
+			&models.ReadTaskResponse{Status: &completed}, nil)
+
+		err := PollTask(ctx, mockTaskClient, taskId, 5*time.Second, 1)
+		assert.Nil(t, err)
+
+		entries, err := tflogtest.MultilineJSONDecode(&output)
+		assert.Nil(t, err)
+		var polls, transitions []map[string]any
+		for _, entry := range entries {
+			switch entry["@message"] {
+			case "Polled Clumio task":
+				polls = append(polls, entry)
+			case "Clumio task status changed":
+				transitions = append(transitions, entry)
+			}
+		}
+		assert.Len(t, polls, 3)
+		assert.Len(t, transitions, 2)
+		assert.Equal(t, TaskInProgress, transitions[0]["status"])
+		assert.Equal(t, TaskInProgress, transitions[1]["previous_status"])
+		assert.Equal(t, TaskSuccess, transitions[1]["status"])
+	})
+
+	t.Run("Error reading the task mentions the task id", func(t *testing.T) {
+		mockTaskClient := sdkclients.NewMockTaskClient(t)
+		mockTaskClient.EXPECT().ReadTask(taskId).Times(1).Return(nil,
+			&apiutils.APIError{
+				ResponseCode: http.StatusInternalServerError,
+				Reason:       "Test",
+				Response:     []byte("Test Error"),
+			})
+
+		err := PollTask(context.Background(), mockTaskClient, taskId, 5*time.Second, 1)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), taskId)
+	})
+
+	t.Run("Polling stops when the context is canceled", func(t *testing.T) {
+		mockTaskClient := sdkclients.NewMockTaskClient(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := PollTask(ctx, mockTaskClient, taskId, 5*time.Second, time.Minute)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
+// Unit test for the function TaskErrorDiagnostic for the following cases:
+//   - The detail lists the type, error code and error message of a failed task.
+//   - The detail of any other error is its message.
+func TestTaskErrorDiagnostic(t *testing.T) {
+
+	t.Run("Detail of a failed task", func(t *testing.T) {
+		err := &TaskError{
+			TaskId:       "12345",
+			Type:         "policy_update",
+			Status:       TaskFailed,
+			ErrorCode:    "3015",
+			ErrorMessage: "The policy is in use.",
+		}
+
+		diagnostic := TaskErrorDiagnostic("Unable to update policy", err)
+		assert.Equal(t, "Unable to update policy", diagnostic.Summary())
+		assert.Equal(t, "Task 12345 failed.\nTask type: policy_update\nError code: 3015"+
+			"\nError message: The policy is in use.", diagnostic.Detail())
+		assert.Equal(t, "Task 12345 (policy_update) failed: The policy is in use."+
+			" (error code 3015)", err.Error())
+	})
+
+	t.Run("Detail of another error", func(t *testing.T) {
+		diagnostic := TaskErrorDiagnostic(
+			"Unable to update policy", errors.New("polling task timeout"))
+		assert.Equal(t, "polling task timeout", diagnostic.Detail())
+	})
+}
+
+// Unit test for the function nextTaskPollInterval. Tests that the interval doubles up to
+// maxTaskPollInterval and that a longer interval is kept as is.
+func TestNextTaskPollInterval(t *testing.T) {
+
+	assert.Equal(t, 10*time.Second, nextTaskPollInterval(5*time.Second))
+	assert.Equal(t, maxTaskPollInterval, nextTaskPollInterval(20*time.Second))
+	assert.Equal(t, maxTaskPollInterval, nextTaskPollInterval(maxTaskPollInterval))
+	assert.Equal(t, time.Minute, nextTaskPollInterval(time.Minute))
+}
@@ -13,11 +13,11 @@ import (
 	"time"
 
 	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
-	"github.com/clumio-code/clumio-go-sdk/controllers/tasks"
 	"github.com/clumio-code/clumio-go-sdk/models"
 	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -35,33 +35,14 @@ func SnakeCaseToCamelCase(key string) string {
 	return newKey
 }
 
-// PollTask polls created tasks till it completes either with success, aborted, failed
-// or it returns an error.
-func PollTask(ctx context.Context, taskClient tasks.TasksV1Client,
-	taskId string, timeout time.Duration, interval time.Duration) error {
-
-	ticker := time.NewTicker(interval)
-	tickerTimeout := time.After(timeout)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-ticker.C:
-			resp, apiErr := taskClient.ReadTask(taskId)
-			if apiErr != nil {
-				return errors.New(fmt.Sprintf("Error for Task %s: ", ParseMessageFromApiError(apiErr)))
-			} else if *resp.Status == TaskSuccess {
-				return nil
-			} else if *resp.Status == TaskAborted {
-				return errors.New(fmt.Sprintf("Task %s aborted", taskId))
-			} else if *resp.Status == TaskFailed {
-				return errors.New(fmt.Sprintf("Task %s failed", taskId))
-			}
-		case <-tickerTimeout:
-			return errors.New("polling task timeout")
-		}
+// PollTimeout returns the time left till the deadline of the given context, or the given default
+// timeout if the context has no deadline. Resources bound their context by the timeouts given in
+// their timeouts block, so this is the time available to poll for an asynchronous operation.
+func PollTimeout(ctx context.Context, defaultTimeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
 	}
+	return defaultTimeout
 }
 
 // SliceDifferenceString returns the slice difference in string slices.
@@ -105,13 +86,40 @@ func GetStringPtr(v basetypes.StringValue) *string {
 	}
 }
 
-// Parses the api error and returns the response in stringified format
+// GetWriteOnlyStringPtr returns the ptr of the write-only value if it is set, otherwise it returns
+// the ptr of the persisted value that the write-only attribute is an alternative for. Write-only
+// values are only available in the configuration, so the caller must have read them from there.
+func GetWriteOnlyStringPtr(
+	value basetypes.StringValue, writeOnlyValue basetypes.StringValue) *string {
+
+	if !writeOnlyValue.IsNull() && !writeOnlyValue.IsUnknown() {
+		s := writeOnlyValue.ValueString()
+		return &s
+	}
+	return GetStringPtr(value)
+}
+
+// Parses the api error and returns the messages of the Clumio error envelope, one per line. If the
+// response is not an error envelope, it is returned in stringified format.
 func ParseMessageFromApiError(apiError *apiutils.APIError) string {
 	// Handle auth errors separately
-	if apiError.ResponseCode == http.StatusUnauthorized || apiError.ResponseCode == http.StatusForbidden {
+	if isAuthError(apiError) {
 		return AuthError
 	}
-	return string(apiError.Response)
+	body, ok := parseApiErrorBody(apiError)
+	if !ok {
+		return string(apiError.Response)
+	}
+	var messages []string
+	for _, entry := range body.Errors {
+		if entry.ErrorMessage != "" {
+			messages = append(messages, entry.message())
+		}
+		for _, fieldError := range entry.FieldErrors {
+			messages = append(messages, fieldError.message())
+		}
+	}
+	return strings.Join(messages, "\n")
 }
 
 // Parses through the path of a nested block and returns the lowest level field name
@@ -218,3 +226,35 @@ func GetSDKConfigForOU(clumioConfig sdkconfig.Config, organizationalUnitId strin
 		CustomHeaders:             clumioConfig.CustomHeaders,
 	}
 }
+
+// GetSDKConfigForOUContext returns the SDK config for the calls of a resource or data source whose
+// organizational_unit_context attribute has the given value. It returns false if the attribute is
+// not set, in which case the calls are made with the SDK config of the provider.
+func GetSDKConfigForOUContext(
+	client *ApiClient, ouContext basetypes.StringValue) (sdkconfig.Config, bool) {
+
+	if client == nil || ouContext.IsNull() || ouContext.IsUnknown() || ouContext.ValueString() == "" {
+		return sdkconfig.Config{}, false
+	}
+	return GetSDKConfigForOU(client.ClumioConfig, ouContext.ValueString()), true
+}
+
+// CheckDeletionProtection returns an error if the resource with the given name and ID must not be
+// deleted. The deletion is prevented by the given deletion_protection attribute of the resource or,
+// if that is not set, by the deletion_protection of the provider.
+func CheckDeletionProtection(client *ApiClient, deletionProtection basetypes.BoolValue,
+	resourceName string, id string) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	protected := client != nil && client.DeletionProtection
+	if !deletionProtection.IsNull() && !deletionProtection.IsUnknown() {
+		protected = deletionProtection.ValueBool()
+	}
+	if protected {
+		summary := fmt.Sprintf("Unable to delete %s (ID: %v)", resourceName, id)
+		detail := "Deletion protection is enabled for the resource. To delete it, set its" +
+			" deletion_protection attribute to false and apply the change first."
+		diags.AddError(summary, detail)
+	}
+	return diags
+}
@@ -26,11 +26,23 @@ import (
 
 // Test all common utils
 func TestUtils(t *testing.T) {
-	t.Run("ParseMessageFromApiError - Parses and returns stringified response from api error", func(t *testing.T) {
+	t.Run("ParseMessageFromApiError - Parses and returns the messages of the error envelope", func(t *testing.T) {
 		mockResponse := "{\"errors\":[{\"error_code\":111,\"error_message\":\"The request is invalid.\"}]}"
 		mockByteArray := []byte(fmt.Sprintf("%v", mockResponse))
 		mockApiError := apiutils.NewAPIError("test-reason", 500, mockByteArray)
 
+		expected := "The request is invalid. (error code 111)"
+		res := ParseMessageFromApiError(mockApiError)
+		testResult := reflect.DeepEqual(res, expected)
+		if !testResult {
+			t.Fatalf(TestResultsNotMatchingError, res, expected)
+		}
+	})
+
+	t.Run("ParseMessageFromApiError - Returns stringified response for unknown shapes", func(t *testing.T) {
+		mockResponse := "{\"message\":\"Internal server error\"}"
+		mockApiError := apiutils.NewAPIError("test-reason", 500, []byte(mockResponse))
+
 		res := ParseMessageFromApiError(mockApiError)
 		testResult := reflect.DeepEqual(res, mockResponse)
 		if !testResult {
@@ -97,6 +109,24 @@ func TestUtils(t *testing.T) {
 		assert.Nil(t, stringPtr)
 	})
 
+	t.Run("GetWriteOnlyStringPtr - Prefers the write-only value when set", func(t *testing.T) {
+		value := basetypes.NewStringValue("test_value")
+		writeOnlyValue := basetypes.NewStringValue("test_write_only_value")
+
+		stringPtr := GetWriteOnlyStringPtr(value, writeOnlyValue)
+		assert.Equal(t, "test_write_only_value", *stringPtr)
+	})
+
+	t.Run("GetWriteOnlyStringPtr - Falls back to the persisted value", func(t *testing.T) {
+		value := basetypes.NewStringValue("test_value")
+
+		stringPtr := GetWriteOnlyStringPtr(value, basetypes.NewStringNull())
+		assert.Equal(t, "test_value", *stringPtr)
+
+		stringPtr = GetWriteOnlyStringPtr(basetypes.NewStringNull(), basetypes.NewStringNull())
+		assert.Nil(t, stringPtr)
+	})
+
 	t.Run("GetSDKConfigForOU - Returns config with updated OU id", func(t *testing.T) {
 		clumioConfig := sdkconfig.Config{
 			OrganizationalUnitContext: "test_ou_context",
@@ -105,6 +135,27 @@ func TestUtils(t *testing.T) {
 		updatedConfig := GetSDKConfigForOU(clumioConfig, "updated_ou_context")
 		assert.Equal(t, "updated_ou_context", updatedConfig.OrganizationalUnitContext)
 	})
+
+	t.Run("GetSDKConfigForOUContext - Returns config for the OU context if set", func(t *testing.T) {
+		client := &ApiClient{
+			ClumioConfig: sdkconfig.Config{
+				Token:                     "test_token",
+				OrganizationalUnitContext: "test_ou_context",
+			},
+		}
+
+		config, ok := GetSDKConfigForOUContext(client, basetypes.NewStringValue("resource_ou"))
+		assert.True(t, ok)
+		assert.Equal(t, "resource_ou", config.OrganizationalUnitContext)
+		assert.Equal(t, "test_token", config.Token)
+
+		_, ok = GetSDKConfigForOUContext(client, basetypes.NewStringNull())
+		assert.False(t, ok)
+		_, ok = GetSDKConfigForOUContext(client, basetypes.NewStringUnknown())
+		assert.False(t, ok)
+		_, ok = GetSDKConfigForOUContext(nil, basetypes.NewStringValue("resource_ou"))
+		assert.False(t, ok)
+	})
 }
 
 // Unit test for the utility function PollTask
@@ -167,6 +218,27 @@ func TestPollTask(t *testing.T) {
 	})
 }
 
+// Unit test for the utility function PollTimeout.
+// Tests the following scenarios:
+//   - Context without a deadline returns the default timeout.
+//   - Context with a deadline returns the time left till the deadline.
+func TestPollTimeout(t *testing.T) {
+
+	defaultTimeout := 5 * time.Minute
+
+	t.Run("Context without deadline", func(t *testing.T) {
+		assert.Equal(t, defaultTimeout, PollTimeout(context.Background(), defaultTimeout))
+	})
+
+	t.Run("Context with deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+		defer cancel()
+		timeout := PollTimeout(ctx, defaultTimeout)
+		assert.Greater(t, timeout, defaultTimeout)
+		assert.LessOrEqual(t, timeout, time.Hour)
+	})
+}
+
 // Unit test for the utility function PollForProtectionGroup.
 // Tests the following scenarios:
 //   - Success scenario for protection group polling.
@@ -399,3 +471,38 @@ func TestCompareUnversionAttrDiff(t *testing.T) {
 		assert.True(t, CompareUnversionAttrDiff(updateDiffName, updateResp))
 	})
 }
+
+// Unit test for the utility function CheckDeletionProtection.
+// Tests the following scenarios:
+//   - Deletion is allowed if deletion protection is disabled by both resource and provider.
+//   - Deletion is prevented if deletion protection is enabled by the resource.
+//   - Deletion is prevented if deletion protection is enabled by the provider only.
+//   - Deletion is allowed if deletion protection is disabled by the resource but enabled by the
+//     provider.
+func TestCheckDeletionProtection(t *testing.T) {
+
+	client := &ApiClient{}
+	protectedClient := &ApiClient{DeletionProtection: true}
+
+	t.Run("Deletion protection disabled", func(t *testing.T) {
+		assert.Nil(t, CheckDeletionProtection(nil, basetypes.NewBoolNull(), "test", "id"))
+		assert.Nil(t, CheckDeletionProtection(client, basetypes.NewBoolNull(), "test", "id"))
+		assert.Nil(t, CheckDeletionProtection(client, basetypes.NewBoolValue(false), "test", "id"))
+	})
+
+	t.Run("Deletion protection enabled by the resource", func(t *testing.T) {
+		diags := CheckDeletionProtection(client, basetypes.NewBoolValue(true), "test", "id")
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("Deletion protection enabled by the provider", func(t *testing.T) {
+		diags := CheckDeletionProtection(protectedClient, basetypes.NewBoolNull(), "test", "id")
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("Deletion protection disabled by the resource", func(t *testing.T) {
+		diags := CheckDeletionProtection(
+			protectedClient, basetypes.NewBoolValue(false), "test", "id")
+		assert.Nil(t, diags)
+	})
+}
@@ -13,6 +13,35 @@ const (
 	// User-Agent header key used in Clumio client API requests. The value to be set is defined
 	// below in: userAgentHeaderValue.
 	userAgentHeader = "User-Agent"
+
+	// Provider schema attribute names.
+	schemaClumioRegion          = "clumio_region"
+	schemaMaxRetries            = "max_retries"
+	schemaRetryMinBackoff       = "retry_min_backoff"
+	schemaRetryMaxBackoff       = "retry_max_backoff"
+	schemaProfile               = "profile"
+	schemaValidateCredentials   = "validate_credentials"
+	schemaHTTPSProxy            = "https_proxy"
+	schemaCABundleFile          = "ca_bundle_file"
+	schemaInsecureSkipVerify    = "insecure_skip_verify"
+	schemaRequestTimeout        = "request_timeout"
+	schemaMaxConcurrentRequests = "max_concurrent_requests"
+	schemaMaxRequestsPerSecond  = "max_requests_per_second"
+	schemaDeletionProtection    = "deletion_protection"
+
+	// Location of the Clumio shared config file holding the named credential profiles, relative
+	// to the home directory of the user.
+	sharedConfigFileDir  = ".clumio"
+	sharedConfigFileName = "config"
+
+	// Name of the profile used when no profile is given.
+	defaultProfile = "default"
+
+	// Keys of the values held by a profile in the shared config file. They match the names of the
+	// corresponding provider attributes.
+	profileKeyApiToken                  = "clumio_api_token"
+	profileKeyApiBaseUrl                = "clumio_api_base_url"
+	profileKeyOrganizationalUnitContext = "clumio_organizational_unit_context"
 )
 
 // userAgentHeaderValue is the value to be set for the User-Agent header in Clumio client API
@@ -0,0 +1,103 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the functions used to validate the credentials of the provider while it is
+// being configured.
+
+package clumio_pf
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	clumioConfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// credentialsKey identifies the credentials which were validated.
+type credentialsKey struct {
+	token                     string
+	baseUrl                   string
+	organizationalUnitContext string
+}
+
+// validateCredentials makes a single authenticated call to the Clumio API to ensure that the given
+// config can be used. If an organizational unit context is given, the call reads that OU, which
+// also ensures that it exists and that the token can access it. The result is cached so that the
+// credentials are only validated once per provider instance. The given API base URL is the one
+// reported in the diagnostics, as the base URL of the config may be that of the transport proxy.
+func (p *clumioProvider) validateCredentials(
+	ctx context.Context, config clumioConfig.Config, apiBaseUrl string,
+	middlewares []sdkclients.Middleware) diag.Diagnostics {
+
+	key := credentialsKey{
+		token:                     config.Token,
+		baseUrl:                   config.BaseUrl,
+		organizationalUnitContext: config.OrganizationalUnitContext,
+	}
+	p.validationLock.Lock()
+	defer p.validationLock.Unlock()
+	if diags, ok := p.validatedCredentials[key]; ok {
+		return diags
+	}
+
+	tflog.Debug(ctx, "Validating Clumio credentials")
+	ouClient := p.newOrganizationalUnitClient(config, middlewares...)
+	var diags diag.Diagnostics
+	if config.OrganizationalUnitContext != "" {
+		_, apiErr := ouClient.ReadOrganizationalUnit(config.OrganizationalUnitContext, nil)
+		if apiErr != nil && apiErr.ResponseCode == http.StatusNotFound {
+			attribute := path.Root("clumio_organizational_unit_context")
+			summary := "Organizational Unit Not Found"
+			detail := fmt.Sprintf("Organizational unit %q does not exist or is not accessible"+
+				" with the given clumio_api_token. The value should be the id of the"+
+				" organizational unit and not the name.", config.OrganizationalUnitContext)
+			diags.AddAttributeError(attribute, summary, detail)
+		} else if apiErr != nil {
+			diags.Append(credentialsErrorDiagnostic(apiBaseUrl, apiErr))
+		}
+	} else {
+		limit := int64(1)
+		_, apiErr := ouClient.ListOrganizationalUnits(&limit, nil, nil)
+		if apiErr != nil {
+			diags.Append(credentialsErrorDiagnostic(apiBaseUrl, apiErr))
+		}
+	}
+
+	p.validatedCredentials[key] = diags
+	if !diags.HasError() {
+		tflog.Info(ctx, "Validated Clumio credentials", map[string]any{"success": true})
+	}
+	return diags
+}
+
+// credentialsErrorDiagnostic returns the diagnostic for a failed credentials validation call,
+// attributed to the provider attribute which is most likely wrong.
+func credentialsErrorDiagnostic(apiBaseUrl string, apiErr *apiutils.APIError) diag.Diagnostic {
+
+	switch {
+	case apiErr.ResponseCode == http.StatusUnauthorized ||
+		apiErr.ResponseCode == http.StatusForbidden:
+		summary := "Invalid Clumio Credentials"
+		detail := fmt.Sprintf("The clumio_api_token was rejected by %s. Ensure that the token"+
+			" is valid, has not expired and was created for the region of the API base URL.",
+			apiBaseUrl)
+		return diag.NewAttributeErrorDiagnostic(path.Root("clumio_api_token"), summary, detail)
+	case apiErr.ResponseCode == 0:
+		summary := "Unable to Reach Clumio API"
+		detail := fmt.Sprintf("Unable to connect to %s: %s. Ensure that clumio_api_base_url or"+
+			" clumio_region is correct.", apiBaseUrl, apiErr.Reason)
+		return diag.NewAttributeErrorDiagnostic(path.Root("clumio_api_base_url"), summary, detail)
+	default:
+		summary := "Unable to Validate Clumio Credentials"
+		detail := fmt.Sprintf("Validating the credentials against %s failed with status %d: %s",
+			apiBaseUrl, apiErr.ResponseCode, common.ParseMessageFromApiError(apiErr))
+		return diag.NewErrorDiagnostic(summary, detail)
+	}
+}
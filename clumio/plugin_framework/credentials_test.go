@@ -0,0 +1,137 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in credentials.go.
+
+//go:build unit
+
+package clumio_pf
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	clumioConfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupValidateCredentialsTest returns a provider whose credentials are validated using the
+// returned mock organizational unit client.
+func setupValidateCredentialsTest(
+	t *testing.T) (*clumioProvider, *sdkclients.MockOrganizationalUnitClient) {
+
+	mockOUClient := sdkclients.NewMockOrganizationalUnitClient(t)
+	clumioProvider := New().(*clumioProvider)
+	clumioProvider.newOrganizationalUnitClient = func(config clumioConfig.Config,
+		middlewares ...sdkclients.Middleware) sdkclients.OrganizationalUnitClient {
+		return mockOUClient
+	}
+	return clumioProvider, mockOUClient
+}
+
+// Unit test for the following cases:
+//   - Success scenario for validating credentials without an OU context.
+//   - Success scenario for validating credentials with an OU context.
+//   - Result of the validation is cached.
+//   - Token which is rejected returns an attribute error for clumio_api_token.
+//   - OU context which does not exist returns an attribute error for the OU context.
+//   - API which cannot be reached returns an attribute error for clumio_api_base_url.
+func TestValidateCredentials(t *testing.T) {
+
+	ctx := context.Background()
+	config := clumioConfig.Config{
+		Token:   "test-token",
+		BaseUrl: "https://us-west-2.api.clumio.com",
+	}
+	ouId := "test-ou-id"
+	ouConfig := config
+	ouConfig.OrganizationalUnitContext = ouId
+
+	// Tests that the OUs are listed when no OU context is given.
+	t.Run("Success scenario without OU context", func(t *testing.T) {
+		clumioProvider, mockOUClient := setupValidateCredentialsTest(t)
+
+		// Setup Expectations
+		mockOUClient.EXPECT().ListOrganizationalUnits(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(&models.ListOrganizationalUnitsResponse{}, nil)
+
+		diags := clumioProvider.validateCredentials(ctx, config, config.BaseUrl, nil)
+		assert.False(t, diags.HasError())
+	})
+
+	// Tests that the OU is read when an OU context is given and that the result is cached.
+	t.Run("Success scenario with OU context is cached", func(t *testing.T) {
+		clumioProvider, mockOUClient := setupValidateCredentialsTest(t)
+
+		// Setup Expectations
+		mockOUClient.EXPECT().ReadOrganizationalUnit(ouId, mock.Anything).Times(1).Return(
+			&models.ReadOrganizationalUnitResponse{}, nil)
+
+		diags := clumioProvider.validateCredentials(ctx, ouConfig, ouConfig.BaseUrl, nil)
+		assert.False(t, diags.HasError())
+		diags = clumioProvider.validateCredentials(ctx, ouConfig, ouConfig.BaseUrl, nil)
+		assert.False(t, diags.HasError())
+	})
+
+	// Tests that a rejected token is reported on clumio_api_token.
+	t.Run("Token is rejected", func(t *testing.T) {
+		clumioProvider, mockOUClient := setupValidateCredentialsTest(t)
+		apiErr := &apiutils.APIError{
+			ResponseCode: http.StatusUnauthorized,
+			Reason:       "test",
+			Response:     []byte("Unauthorized"),
+		}
+
+		// Setup Expectations
+		mockOUClient.EXPECT().ListOrganizationalUnits(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiErr)
+
+		diags := clumioProvider.validateCredentials(ctx, config, config.BaseUrl, nil)
+		assert.True(t, diags.HasError())
+		assert.Len(t, diags, 1)
+		assert.Equal(t, "Invalid Clumio Credentials", diags[0].Summary())
+		assert.True(t, diags[0].(diag.DiagnosticWithPath).Path().Equal(
+			path.Root("clumio_api_token")))
+	})
+
+	// Tests that an OU context which does not exist is reported on the OU context.
+	t.Run("OU context does not exist", func(t *testing.T) {
+		clumioProvider, mockOUClient := setupValidateCredentialsTest(t)
+		apiErr := &apiutils.APIError{
+			ResponseCode: http.StatusNotFound,
+			Reason:       "test",
+			Response:     []byte("Not Found"),
+		}
+
+		// Setup Expectations
+		mockOUClient.EXPECT().ReadOrganizationalUnit(ouId, mock.Anything).Times(1).Return(
+			nil, apiErr)
+
+		diags := clumioProvider.validateCredentials(ctx, ouConfig, ouConfig.BaseUrl, nil)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Organizational Unit Not Found", diags[0].Summary())
+	})
+
+	// Tests that an API which cannot be reached is reported on clumio_api_base_url.
+	t.Run("API cannot be reached", func(t *testing.T) {
+		clumioProvider, mockOUClient := setupValidateCredentialsTest(t)
+		apiErr := &apiutils.APIError{
+			Reason: "dial tcp: lookup us-west-2.api.clumio.com: no such host",
+		}
+
+		// Setup Expectations
+		mockOUClient.EXPECT().ListOrganizationalUnits(mock.Anything, mock.Anything, mock.Anything).
+			Times(1).Return(nil, apiErr)
+
+		diags := clumioProvider.validateCredentials(ctx, config, config.BaseUrl, nil)
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Unable to Reach Clumio API", diags[0].Summary())
+	})
+}
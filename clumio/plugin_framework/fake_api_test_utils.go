@@ -0,0 +1,117 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file holds the helpers used by the acceptance tests which run against the fake Clumio API.
+// It is only built with the "fake" tag so that the fake is not part of the provider binary.
+
+//go:build fake
+
+package clumio_pf
+
+import (
+	"fmt"
+	"testing"
+
+	fakeapi "github.com/clumio-code/terraform-provider-clumio/clumio/fake_api"
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// UtilTestAccStartFakeApi starts the fake Clumio API for the duration of the given test and points
+// the provider at it by setting the environment variables of the API base URL and token. The
+// acceptance tests using it must not set clumio_api_base_url in their configuration.
+func UtilTestAccStartFakeApi(t *testing.T, options ...fakeapi.Option) *fakeapi.Server {
+	t.Helper()
+
+	server := fakeapi.NewServer(options...)
+	t.Cleanup(server.Close)
+	t.Setenv(common.ClumioApiBaseUrl, server.URL)
+	t.Setenv(common.ClumioApiToken, fakeapi.Token)
+	t.Setenv(common.ClumioOrganizationalUnitContext, "")
+	t.Setenv(common.ClumioRegion, "")
+	t.Setenv(common.ClumioProfile, "")
+	return server
+}
+
+// UtilTestAccFakeImportStep returns the test step which imports the resource with the given name by
+// its ID, using the given configuration, and verifies that the imported resource has the given ID.
+// The ID is given by reference as it is only known once the resource is created by a previous step.
+func UtilTestAccFakeImportStep(resourceName string, config string, id *string) resource.TestStep {
+
+	return resource.TestStep{
+		Config:       config,
+		ResourceName: resourceName,
+		ImportState:  true,
+		ImportStateCheck: func(instStates []*terraform.InstanceState) error {
+			if len(instStates) != 1 {
+				return fmt.Errorf("expected 1 InstanceState for the imported %s", resourceName)
+			}
+			if instStates[0].ID != *id {
+				return fmt.Errorf("Imported %s has different ID. Expected: %v, Actual: %v",
+					resourceName, *id, instStates[0].ID)
+			}
+			return nil
+		},
+	}
+}
+
+// UtilTestAccFakeDriftStep returns the test step which sets the given fields on the object of the
+// given kind with the given ID in the fake Clumio API, as if it was changed outside of Terraform,
+// and verifies that the resource with the given name is planned to be updated to undo the drift.
+// The given check, if any, verifies the object once the given configuration is applied again.
+func UtilTestAccFakeDriftStep(server *fakeapi.Server, kind fakeapi.Kind, id *string,
+	fields map[string]any, resourceName string, config string,
+	check resource.TestCheckFunc) resource.TestStep {
+
+	return resource.TestStep{
+		PreConfig: func() {
+			server.UpdateObject(kind, *id, fields)
+		},
+		Config: config,
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		Check: check,
+	}
+}
+
+// UtilTestAccFakeDeleteStep returns the test step which deletes the object of the given kind with
+// the given ID from the fake Clumio API, as if it was deleted outside of Terraform, and verifies
+// that the resource with the given name is planned to be created again.
+func UtilTestAccFakeDeleteStep(server *fakeapi.Server, kind fakeapi.Kind, id *string,
+	resourceName string, config string) resource.TestStep {
+
+	return utilTestAccFakeRecreateStep(func() {
+		server.DeleteObject(kind, *id)
+	}, resourceName, config)
+}
+
+// UtilTestAccFakeSoftDeleteStep is like UtilTestAccFakeDeleteStep for the objects, such as the
+// protection groups, which the Clumio API keeps once deleted and marks deleted.
+func UtilTestAccFakeSoftDeleteStep(server *fakeapi.Server, kind fakeapi.Kind, id *string,
+	resourceName string, config string) resource.TestStep {
+
+	return utilTestAccFakeRecreateStep(func() {
+		server.UpdateObject(kind, *id, map[string]any{"is_deleted": true})
+	}, resourceName, config)
+}
+
+// utilTestAccFakeRecreateStep returns the test step which runs the given out of band deletion and
+// verifies that the resource with the given name is planned to be created again.
+func utilTestAccFakeRecreateStep(
+	deleteObject func(), resourceName string, config string) resource.TestStep {
+
+	return resource.TestStep{
+		PreConfig: deleteObject,
+		Config:    config,
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+			},
+		},
+	}
+}
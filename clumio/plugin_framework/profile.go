@@ -0,0 +1,104 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the functions used to read the named credential profiles from the Clumio
+// shared config file.
+
+package clumio_pf
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+)
+
+// clumioProfile holds the values of a named profile in the Clumio shared config file.
+type clumioProfile struct {
+	ApiToken                  string
+	ApiBaseUrl                string
+	OrganizationalUnitContext string
+}
+
+// sharedConfigFilePath returns the path of the Clumio shared config file. It is read from the
+// CLUMIO_CONFIG_FILE environment variable and defaults to ~/.clumio/config.
+func sharedConfigFilePath() (string, error) {
+	if path := os.Getenv(common.ClumioConfigFile); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, sharedConfigFileDir, sharedConfigFileName), nil
+}
+
+// loadProfile reads the profile with the given name from the shared config file at the given path.
+// It returns nil if either the file or the profile does not exist.
+func loadProfile(path string, name string) (*clumioProfile, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles, err := parseSharedConfig(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+	values, ok := profiles[name]
+	if !ok {
+		return nil, nil
+	}
+	return &clumioProfile{
+		ApiToken:                  values[profileKeyApiToken],
+		ApiBaseUrl:                values[profileKeyApiBaseUrl],
+		OrganizationalUnitContext: values[profileKeyOrganizationalUnitContext],
+	}, nil
+}
+
+// parseSharedConfig parses the content of a shared config file into a map from profile name to
+// the key-value pairs of the profile. The file is made of sections such as "[default]" followed by
+// "key = value" lines. Empty lines and lines starting with "#" or ";" are ignored.
+func parseSharedConfig(reader io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(reader)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNum)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = make(map[string]string)
+			}
+			current = profiles[name]
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected \"key = value\"", lineNum)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key outside of a profile section", lineNum)
+		}
+		current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in profile.go.
+
+//go:build unit
+
+package clumio_pf
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSharedConfig = `
+# Profiles used by the unit tests.
+[default]
+clumio_api_token = default-token
+clumio_api_base_url = https://us-west-2.api.clumio.com
+
+; Profile for another tenant.
+[eu]
+clumio_api_token=eu-token
+clumio_api_base_url = https://eu-central-1.de.api.clumio.com
+clumio_organizational_unit_context = eu-ou
+`
+
+// Unit test for the following cases:
+//   - Success scenario for parsing a shared config file.
+//   - Key outside of a profile section returns an error.
+//   - Line which is not a key-value pair returns an error.
+//   - Empty profile name returns an error.
+func TestParseSharedConfig(t *testing.T) {
+
+	t.Run("Success scenario for parse shared config", func(t *testing.T) {
+		profiles, err := parseSharedConfig(strings.NewReader(testSharedConfig))
+		assert.Nil(t, err)
+		assert.Len(t, profiles, 2)
+		assert.Equal(t, "default-token", profiles["default"][profileKeyApiToken])
+		assert.Equal(t, "eu-token", profiles["eu"][profileKeyApiToken])
+		assert.Equal(t, "eu-ou", profiles["eu"][profileKeyOrganizationalUnitContext])
+	})
+
+	t.Run("Key outside of a profile section", func(t *testing.T) {
+		_, err := parseSharedConfig(strings.NewReader("clumio_api_token = token"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Line which is not a key-value pair", func(t *testing.T) {
+		_, err := parseSharedConfig(strings.NewReader("[default]\nclumio_api_token"))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Empty profile name", func(t *testing.T) {
+		_, err := parseSharedConfig(strings.NewReader("[ ]\nclumio_api_token = token"))
+		assert.NotNil(t, err)
+	})
+}
+
+// Unit test for the following cases:
+//   - Success scenario for loading a profile.
+//   - Profile which does not exist returns nil.
+//   - Shared config file which does not exist returns nil.
+//   - Shared config file which cannot be parsed returns an error.
+func TestLoadProfile(t *testing.T) {
+
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config")
+	err := os.WriteFile(configFile, []byte(testSharedConfig), 0600)
+	assert.Nil(t, err)
+
+	t.Run("Success scenario for load profile", func(t *testing.T) {
+		profile, err := loadProfile(configFile, "eu")
+		assert.Nil(t, err)
+		assert.Equal(t, &clumioProfile{
+			ApiToken:                  "eu-token",
+			ApiBaseUrl:                "https://eu-central-1.de.api.clumio.com",
+			OrganizationalUnitContext: "eu-ou",
+		}, profile)
+	})
+
+	t.Run("Profile does not exist", func(t *testing.T) {
+		profile, err := loadProfile(configFile, "us")
+		assert.Nil(t, err)
+		assert.Nil(t, profile)
+	})
+
+	t.Run("Shared config file does not exist", func(t *testing.T) {
+		profile, err := loadProfile(filepath.Join(dir, "missing"), defaultProfile)
+		assert.Nil(t, err)
+		assert.Nil(t, profile)
+	})
+
+	t.Run("Shared config file cannot be parsed", func(t *testing.T) {
+		invalidFile := filepath.Join(dir, "invalid")
+		err := os.WriteFile(invalidFile, []byte("clumio_api_token = token"), 0600)
+		assert.Nil(t, err)
+		profile, err := loadProfile(invalidFile, defaultProfile)
+		assert.NotNil(t, err)
+		assert.Nil(t, profile)
+	})
+}
+
+// Unit test for the following cases:
+//   - Shared config file is read from the CLUMIO_CONFIG_FILE environment variable.
+//   - Shared config file defaults to ~/.clumio/config.
+func TestSharedConfigFilePath(t *testing.T) {
+
+	t.Run("Shared config file from environment", func(t *testing.T) {
+		t.Setenv(common.ClumioConfigFile, "/tmp/clumio-config")
+		configFile, err := sharedConfigFilePath()
+		assert.Nil(t, err)
+		assert.Equal(t, "/tmp/clumio-config", configFile)
+	})
+
+	t.Run("Default shared config file", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv(common.ClumioConfigFile, "")
+		t.Setenv("HOME", home)
+		configFile, err := sharedConfigFilePath()
+		assert.Nil(t, err)
+		assert.Equal(t, filepath.Join(home, ".clumio", "config"), configFile)
+	})
+}
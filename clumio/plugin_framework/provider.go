@@ -7,8 +7,12 @@ package clumio_pf
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/clumio_auto_user_provisioning_rule"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/clumio_auto_user_provisioning_setting"
@@ -35,26 +39,42 @@ import (
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/clumio_user"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/clumio_wallet"
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
+	sdkclients "github.com/clumio-code/terraform-provider-clumio/clumio/sdk_clients"
 
 	clumioConfig "github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the following Provider interface.
 var (
-	_ provider.Provider = &clumioProvider{}
+	_ provider.Provider                  = &clumioProvider{}
+	_ provider.ProviderWithListResources = &clumioProvider{}
 )
 
 // clumioProvider is the struct backing the Clumio Provider for Terraform.
-type clumioProvider struct{}
+type clumioProvider struct {
+	// newOrganizationalUnitClient creates the client used to validate the credentials.
+	newOrganizationalUnitClient func(config clumioConfig.Config,
+		middlewares ...sdkclients.Middleware) sdkclients.OrganizationalUnitClient
+	// validatedCredentials caches the result of validating the credentials, guarded by
+	// validationLock.
+	validatedCredentials map[credentialsKey]diag.Diagnostics
+	validationLock       sync.Mutex
+}
 
 // New creates a new instance of clumioProvider.
 func New() provider.Provider {
-	return &clumioProvider{}
+	return &clumioProvider{
+		newOrganizationalUnitClient: sdkclients.NewOrganizationalUnitClient,
+		validatedCredentials:        make(map[credentialsKey]diag.Diagnostics),
+	}
 }
 
 // Metadata returns the provider type name.
@@ -91,16 +111,67 @@ func (p *clumioProvider) Configure(
 		detail := "Value must not be computed from other values in the configuration."
 		resp.Diagnostics.AddAttributeError(attribute, summary, detail)
 	}
+	if config.ClumioRegion.IsUnknown() {
+		attribute := path.Root(schemaClumioRegion)
+		summary := "Unknown Clumio Region"
+		detail := "Value must not be computed from other values in the configuration."
+		resp.Diagnostics.AddAttributeError(attribute, summary, detail)
+	}
+	if config.ValidateCredentials.IsUnknown() {
+		attribute := path.Root(schemaValidateCredentials)
+		summary := "Unknown Validate Credentials"
+		detail := "Value must not be computed from other values in the configuration."
+		resp.Diagnostics.AddAttributeError(attribute, summary, detail)
+	}
+	if config.MaxRetries.IsUnknown() || config.RetryMinBackoff.IsUnknown() ||
+		config.RetryMaxBackoff.IsUnknown() {
+		summary := "Unknown Retry Settings"
+		detail := fmt.Sprintf("Values of %s, %s and %s must not be computed from other values"+
+			" in the configuration.", schemaMaxRetries, schemaRetryMinBackoff, schemaRetryMaxBackoff)
+		resp.Diagnostics.AddError(summary, detail)
+	}
+	if config.HTTPSProxy.IsUnknown() || config.CABundleFile.IsUnknown() ||
+		config.InsecureSkipVerify.IsUnknown() || config.RequestTimeout.IsUnknown() {
+		summary := "Unknown Transport Settings"
+		detail := fmt.Sprintf("Values of %s, %s, %s and %s must not be computed from other"+
+			" values in the configuration.", schemaHTTPSProxy, schemaCABundleFile,
+			schemaInsecureSkipVerify, schemaRequestTimeout)
+		resp.Diagnostics.AddError(summary, detail)
+	}
+	if config.MaxConcurrentRequests.IsUnknown() || config.MaxRequestsPerSecond.IsUnknown() {
+		summary := "Unknown Request Limits"
+		detail := fmt.Sprintf("Values of %s and %s must not be computed from other values in"+
+			" the configuration.", schemaMaxConcurrentRequests, schemaMaxRequestsPerSecond)
+		resp.Diagnostics.AddError(summary, detail)
+	}
+	if config.DeletionProtection.IsUnknown() {
+		attribute := path.Root(schemaDeletionProtection)
+		summary := "Unknown Deletion Protection"
+		detail := "Value must not be computed from other values in the configuration."
+		resp.Diagnostics.AddAttributeError(attribute, summary, detail)
+	}
+	if config.Profile.IsUnknown() {
+		attribute := path.Root(schemaProfile)
+		summary := "Unknown Clumio Profile"
+		detail := "Value must not be computed from other values in the configuration."
+		resp.Diagnostics.AddAttributeError(attribute, summary, detail)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Provider attributes can be set statically in the configuration or using environment. If
-	// statically set, the value is available in the configuration. If set using environment, the
-	// value is available in documented environment variables. Statically set values take precedence
-	// over environment variables.
+	// Provider attributes can be set statically in the configuration, using environment or using a
+	// named profile of the shared config file. If statically set, the value is available in the
+	// configuration. If set using environment, the value is available in documented environment
+	// variables. Statically set values take precedence over environment variables, which take
+	// precedence over the values of the profile. The base URL can also be given as a region, in
+	// which case it is resolved from the region below.
 	clumioApiToken := os.Getenv(common.ClumioApiToken)
 	clumioApiBaseUrl := os.Getenv(common.ClumioApiBaseUrl)
+	clumioRegion := ""
+	if clumioApiBaseUrl == "" {
+		clumioRegion = os.Getenv(common.ClumioRegion)
+	}
 	clumioOrganizationalUnitContext := os.Getenv(common.ClumioOrganizationalUnitContext)
 
 	if !config.ClumioApiToken.IsNull() {
@@ -108,11 +179,54 @@ func (p *clumioProvider) Configure(
 	}
 	if !config.ClumioApiBaseUrl.IsNull() {
 		clumioApiBaseUrl = config.ClumioApiBaseUrl.ValueString()
+		clumioRegion = ""
+	} else if !config.ClumioRegion.IsNull() {
+		clumioApiBaseUrl = ""
+		clumioRegion = config.ClumioRegion.ValueString()
+	}
+	if clumioRegion != "" {
+		regionBaseUrl, ok := apiBaseUrlForRegion(clumioRegion)
+		if !ok {
+			attribute := path.Root(schemaClumioRegion)
+			summary := "Unknown Clumio Region"
+			detail := fmt.Sprintf("Region %q is not a known Clumio region. Valid regions are %s."+
+				" To use a Clumio endpoint of another region, set clumio_api_base_url instead.",
+				clumioRegion, strings.Join(clumioRegionNames(), ", "))
+			resp.Diagnostics.AddAttributeError(attribute, summary, detail)
+			return
+		}
+		clumioApiBaseUrl = regionBaseUrl
 	}
 	if !config.ClumioOrganizationalUnitContext.IsNull() {
 		clumioOrganizationalUnitContext = config.ClumioOrganizationalUnitContext.ValueString()
 	}
 
+	// Values which are set neither statically nor using environment are read from the profile.
+	// The profile is given by the profile attribute or the CLUMIO_PROFILE environment variable
+	// and defaults to "default", in which case it is not an error for it to be missing.
+	profileName := os.Getenv(common.ClumioProfile)
+	if !config.Profile.IsNull() {
+		profileName = config.Profile.ValueString()
+	}
+	if clumioApiToken == "" || clumioApiBaseUrl == "" || clumioOrganizationalUnitContext == "" {
+		profile, diags := readProfile(profileName)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if profile != nil {
+			if clumioApiToken == "" {
+				clumioApiToken = profile.ApiToken
+			}
+			if clumioApiBaseUrl == "" {
+				clumioApiBaseUrl = profile.ApiBaseUrl
+			}
+			if clumioOrganizationalUnitContext == "" {
+				clumioOrganizationalUnitContext = profile.OrganizationalUnitContext
+			}
+		}
+	}
+
 	// Ensure that all required values are set. If not, return an error.
 	if clumioApiToken == "" {
 		attribute := path.Root("clumioApiToken")
@@ -133,25 +247,215 @@ func (p *clumioProvider) Configure(
 	// Ensure that the base URL does not end with a slash.
 	clumioApiBaseUrl = strings.TrimRight(clumioApiBaseUrl, "/")
 
+	// Warn about a base URL which was given explicitly and does not match a known Clumio endpoint,
+	// as it is most likely a typo.
+	if clumioRegion == "" && !isKnownApiBaseUrl(clumioApiBaseUrl) {
+		attribute := path.Root("clumio_api_base_url")
+		summary := "Unrecognized Clumio API Base URL"
+		detail := fmt.Sprintf("The host of %q does not match the API base URL of any known"+
+			" Clumio region. Ensure that the value is correct or use clumio_region instead.",
+			clumioApiBaseUrl)
+		resp.Diagnostics.AddAttributeWarning(attribute, summary, detail)
+	}
+
+	// Resolve the retry settings, falling back to the defaults for those which are not set.
+	retryConfig := sdkclients.RetryConfig{
+		MaxRetries: sdkclients.DefaultMaxRetries,
+		MinBackoff: parseDurationAttribute(schemaRetryMinBackoff, config.RetryMinBackoff,
+			sdkclients.DefaultRetryMinBackoff, &resp.Diagnostics),
+		MaxBackoff: parseDurationAttribute(schemaRetryMaxBackoff, config.RetryMaxBackoff,
+			sdkclients.DefaultRetryMaxBackoff, &resp.Diagnostics),
+	}
+	if !config.MaxRetries.IsNull() {
+		retryConfig.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if retryConfig.MinBackoff > retryConfig.MaxBackoff {
+		attribute := path.Root(schemaRetryMinBackoff)
+		summary := "Invalid Retry Backoff"
+		detail := fmt.Sprintf("%s (%s) must not be greater than %s (%s).",
+			schemaRetryMinBackoff, retryConfig.MinBackoff, schemaRetryMaxBackoff,
+			retryConfig.MaxBackoff)
+		resp.Diagnostics.AddAttributeError(attribute, summary, detail)
+		return
+	}
+
+	// The SDK creates its HTTP clients internally and does not accept a transport, so its calls
+	// are sent through a local proxy applying the transport settings when any is set.
+	transportConfig, diags := transportConfigFromModel(config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	sdkBaseUrl := clumioApiBaseUrl
+	if transportConfig.IsSet() {
+		proxyUrl, err := sdkclients.StartTransportProxy(clumioApiBaseUrl, transportConfig)
+		if err != nil {
+			summary := "Invalid Transport Settings"
+			detail := fmt.Sprintf("Unable to apply the transport settings: %v", err)
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		sdkBaseUrl = proxyUrl
+	}
+	requestTimeout := config.RequestTimeout
+	if requestTimeout.IsNull() && os.Getenv(common.ClumioRequestTimeout) != "" {
+		requestTimeout = types.StringValue(os.Getenv(common.ClumioRequestTimeout))
+	}
+	timeout := parseDurationAttribute(schemaRequestTimeout, requestTimeout, 0, &resp.Diagnostics)
+	// The limiter is shared by all resources, so that the request limits apply to all the calls
+	// made by the provider, including those polling for asynchronous operations.
+	limiter := sdkclients.NewLimiter(int(config.MaxConcurrentRequests.ValueInt64()),
+		int(config.MaxRequestsPerSecond.ValueInt64()))
+	middlewares := []sdkclients.Middleware{
+		// The retry middleware is the outermost one so that every attempt of a call is traced,
+		// limited and bounded by the request timeout. A call does not hold on to its slot of the
+		// limiter while it backs off.
+		sdkclients.NewRetryMiddleware(retryConfig),
+		sdkclients.NewTracingMiddleware(clumioApiToken),
+		sdkclients.NewLimiterMiddleware(limiter, timeout),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the Clumio API client and make it available to instances of DataSource and Resource
 	// types in their Configure methods.
 	tflog.Debug(ctx, "Creating Clumio client")
 	client := &common.ApiClient{
 		ClumioConfig: clumioConfig.Config{
 			Token:                     clumioApiToken,
-			BaseUrl:                   clumioApiBaseUrl,
+			BaseUrl:                   sdkBaseUrl,
 			OrganizationalUnitContext: clumioOrganizationalUnitContext,
 			CustomHeaders: map[string]string{
 				userAgentHeader:               userAgentHeaderValue,
 				clumioTfProviderVersionHeader: clumioTfProviderVersionHeaderValue,
 			},
 		},
+		Middlewares:        middlewares,
+		DeletionProtection: config.DeletionProtection.ValueBool(),
 	}
+
+	// Fail fast on credentials which cannot be used, rather than on the first API call made by a
+	// resource.
+	if config.ValidateCredentials.ValueBool() {
+		resp.Diagnostics.Append(
+			p.validateCredentials(ctx, client.ClumioConfig, clumioApiBaseUrl,
+				client.CallMiddlewares(ctx))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.ListResourceData = client
 	tflog.Info(ctx, "Configured Clumio client", map[string]any{"success": true})
 }
 
+// readProfile reads the named profile from the shared config file. If no name is given, the default
+// profile is read and nil is returned if either the file or the profile does not exist. A profile
+// which is given by name must exist.
+func readProfile(profileName string) (*clumioProfile, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	named := profileName != ""
+	if !named {
+		profileName = defaultProfile
+	}
+	configFile, err := sharedConfigFilePath()
+	if err != nil {
+		if named {
+			summary := "Unable to locate Clumio shared config file"
+			detail := err.Error()
+			diags.AddAttributeError(path.Root(schemaProfile), summary, detail)
+		}
+		return nil, diags
+	}
+	profile, err := loadProfile(configFile, profileName)
+	if err != nil {
+		summary := "Unable to read Clumio shared config file"
+		detail := err.Error()
+		diags.AddAttributeError(path.Root(schemaProfile), summary, detail)
+		return nil, diags
+	}
+	if profile == nil && named {
+		summary := "Clumio Profile not found"
+		detail := fmt.Sprintf("Profile %q was not found in %s.", profileName, configFile)
+		diags.AddAttributeError(path.Root(schemaProfile), summary, detail)
+	}
+	return profile, diags
+}
+
+// parseDurationAttribute parses the duration string held by the given provider attribute. It
+// returns the default value if the attribute is not set and adds an attribute error to the
+// diagnostics if the value is not a valid non-negative duration.
+func parseDurationAttribute(attributeName string, value types.String,
+	defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+
+	if value.IsNull() {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		attribute := path.Root(attributeName)
+		summary := "Invalid Duration"
+		detail := fmt.Sprintf("Value %q of %s must be a non-negative duration such as \"2s\".",
+			value.ValueString(), attributeName)
+		diags.AddAttributeError(attribute, summary, detail)
+		return defaultValue
+	}
+	return duration
+}
+
+// transportConfigFromModel returns the transport settings given either in the configuration or in
+// the environment. Disabling the verification of the certificate of the Clumio API is reported with
+// a warning, as it exposes the API token to anyone able to intercept the connection.
+func transportConfigFromModel(
+	config clumioProviderModel) (sdkclients.TransportConfig, diag.Diagnostics) {
+
+	var diags diag.Diagnostics
+	transportConfig := sdkclients.TransportConfig{
+		HTTPSProxy:   stringValueOrEnv(config.HTTPSProxy, common.ClumioHttpsProxy),
+		CABundleFile: stringValueOrEnv(config.CABundleFile, common.ClumioCABundleFile),
+	}
+	if !config.InsecureSkipVerify.IsNull() {
+		transportConfig.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if value := os.Getenv(common.ClumioInsecureSkipVerify); value != "" {
+		insecureSkipVerify, err := strconv.ParseBool(value)
+		if err != nil {
+			attribute := path.Root(schemaInsecureSkipVerify)
+			summary := "Invalid Insecure Skip Verify"
+			detail := fmt.Sprintf("Value %q of the %s environment variable must be true or false.",
+				value, common.ClumioInsecureSkipVerify)
+			diags.AddAttributeError(attribute, summary, detail)
+			return transportConfig, diags
+		}
+		transportConfig.InsecureSkipVerify = insecureSkipVerify
+	}
+	if transportConfig.InsecureSkipVerify {
+		attribute := path.Root(schemaInsecureSkipVerify)
+		summary := "TLS Certificate Verification Disabled"
+		detail := "The certificate of the Clumio API is not verified, so anyone able to intercept" +
+			" the connection can read the API token and the data sent to the Clumio API. Only" +
+			" disable the verification for testing, and set ca_bundle_file to trust the" +
+			" certificate authority of a TLS intercepting proxy instead."
+		diags.AddAttributeWarning(attribute, summary, detail)
+	}
+	return transportConfig, diags
+}
+
+// stringValueOrEnv returns the given value if it is set, otherwise the value of the given
+// environment variable.
+func stringValueOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
 // DataSources defines the data sources implemented in the provider. Any new data source should be
 // added here.
 func (p *clumioProvider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -159,6 +463,7 @@ func (p *clumioProvider) DataSources(_ context.Context) []func() datasource.Data
 		clumio_role.NewClumioRoleDataSource,
 		clumio_aws_manual_connection_resources.NewAwsManualConnectionResourcesDataSource,
 		clumio_policy.NewClumioPolicyDataSource,
+		clumio_policy.NewPolicySchedulePreviewDataSource,
 		clumio_policy_rule.NewClumioPolicyRuleDataSource,
 		clumio_protection_group.NewClumioProtectionGroupDataSource,
 		clumio_aws_connection.NewClumioAWSConnectionDataSource,
@@ -194,3 +499,17 @@ func (p *clumioProvider) Resources(_ context.Context) []func() resource.Resource
 		clumio_post_process_gcp_connection.NewClumioPostProcessGCPConnectionResource,
 	}
 }
+
+// ListResources defines the list resources implemented in the provider, which are used by
+// `terraform query` to list the existing resources. Any new list resource should be added here.
+func (p *clumioProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		clumio_aws_connection.NewClumioAWSConnectionListResource,
+		clumio_policy.NewPolicyListResource,
+		clumio_policy_rule.NewPolicyRuleListResource,
+		clumio_protection_group.NewClumioProtectionGroupListResource,
+		clumio_user.NewClumioUserListResource,
+		clumio_organizational_unit.NewClumioOrganizationalUnitListResource,
+		clumio_report_configuration.NewClumioReportConfigurationListResource,
+	}
+}
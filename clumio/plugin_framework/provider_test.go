@@ -8,6 +8,9 @@ package clumio_pf
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/clumio-code/terraform-provider-clumio/clumio/plugin_framework/common"
@@ -36,6 +39,15 @@ func TestProviderMetadata(t *testing.T) {
 //   - Success scenario for provider configure.
 //   - clumio_api_base_url is empty in the configure request.
 //   - clumio_api_token is empty in the configure request.
+//   - clumio_api_base_url is resolved from clumio_region.
+//   - clumio_region is not a known region.
+//   - retry_min_backoff is not a valid duration.
+//   - retry_min_backoff is greater than retry_max_backoff.
+//   - Transport settings are applied through the transport proxy.
+//   - Transport setting is not valid.
+//   - request_timeout is not a valid duration.
+//   - Values which are not set are read from the given profile.
+//   - Profile which is given by name does not exist.
 func TestProviderConfigure(t *testing.T) {
 
 	ctx := context.Background()
@@ -46,12 +58,48 @@ func TestProviderConfigure(t *testing.T) {
 	apiTokenKey := "clumio_api_token"
 	apiBaseUrlKey := "clumio_api_base_url"
 	ouContextKey := "clumio_organizational_unit_context"
+	maxRetriesKey := "max_retries"
+	minBackoffKey := "retry_min_backoff"
+	maxBackoffKey := "retry_max_backoff"
+	profileKey := "profile"
+	regionKey := "clumio_region"
+	validateKey := "validate_credentials"
+	proxyKey := "https_proxy"
+	caBundleKey := "ca_bundle_file"
+	insecureKey := "insecure_skip_verify"
+	requestTimeoutKey := "request_timeout"
+	maxConcurrentKey := "max_concurrent_requests"
+	maxRequestsPerSecondKey := "max_requests_per_second"
+	deletionProtectionKey := "deletion_protection"
+
+	// Ensure that no shared config file of the environment is read.
+	configFile := filepath.Join(t.TempDir(), "config")
+	t.Setenv(common.ClumioConfigFile, configFile)
+	t.Setenv(common.ClumioProfile, "")
+	t.Setenv(common.ClumioRegion, "")
+	t.Setenv(common.ClumioHttpsProxy, "")
+	t.Setenv(common.ClumioCABundleFile, "")
+	t.Setenv(common.ClumioInsecureSkipVerify, "")
+	t.Setenv(common.ClumioRequestTimeout, "")
 
 	mapType := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
-			apiBaseUrlKey: tftypes.String,
-			apiTokenKey:   tftypes.String,
-			ouContextKey:  tftypes.String,
+			apiBaseUrlKey:           tftypes.String,
+			apiTokenKey:             tftypes.String,
+			ouContextKey:            tftypes.String,
+			maxRetriesKey:           tftypes.Number,
+			minBackoffKey:           tftypes.String,
+			maxBackoffKey:           tftypes.String,
+			profileKey:              tftypes.String,
+			regionKey:               tftypes.String,
+			validateKey:             tftypes.Bool,
+			proxyKey:                tftypes.String,
+			caBundleKey:             tftypes.String,
+			insecureKey:             tftypes.Bool,
+			requestTimeoutKey:       tftypes.String,
+			maxConcurrentKey:        tftypes.Number,
+			maxRequestsPerSecondKey: tftypes.Number,
+			deletionProtectionKey:   tftypes.Bool,
 		},
 		OptionalAttributes: nil,
 	}
@@ -59,6 +107,19 @@ func TestProviderConfigure(t *testing.T) {
 	vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, baseUrl)
 	vals[apiTokenKey] = tftypes.NewValue(tftypes.String, token)
 	vals[ouContextKey] = tftypes.NewValue(tftypes.String, ou)
+	vals[maxRetriesKey] = tftypes.NewValue(tftypes.Number, nil)
+	vals[minBackoffKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[maxBackoffKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[profileKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[regionKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[validateKey] = tftypes.NewValue(tftypes.Bool, nil)
+	vals[proxyKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[caBundleKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[insecureKey] = tftypes.NewValue(tftypes.Bool, nil)
+	vals[requestTimeoutKey] = tftypes.NewValue(tftypes.String, nil)
+	vals[maxConcurrentKey] = tftypes.NewValue(tftypes.Number, nil)
+	vals[maxRequestsPerSecondKey] = tftypes.NewValue(tftypes.Number, nil)
+	vals[deletionProtectionKey] = tftypes.NewValue(tftypes.Bool, nil)
 
 	// Success scenario for provider configure
 	t.Run("Success scenario for provider configure", func(t *testing.T) {
@@ -77,6 +138,7 @@ func TestProviderConfigure(t *testing.T) {
 		assert.Equal(t, token, configResp.ResourceData.(*common.ApiClient).ClumioConfig.Token)
 		assert.Equal(t, ou,
 			configResp.ResourceData.(*common.ApiClient).ClumioConfig.OrganizationalUnitContext)
+		assert.Len(t, configResp.ResourceData.(*common.ApiClient).Middlewares, 3)
 
 	})
 
@@ -116,8 +178,235 @@ func TestProviderConfigure(t *testing.T) {
 		}, configResp)
 
 		assert.True(t, configResp.Diagnostics.HasError())
+
+		//Reset the token at the end of test.
+		vals[apiTokenKey] = tftypes.NewValue(tftypes.String, token)
+	})
+
+	// Tests that clumio_api_base_url is resolved from clumio_region.
+	t.Run("Base URL is resolved from clumio_region", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, nil)
+		vals[regionKey] = tftypes.NewValue(tftypes.String, "eu-central-1")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.False(t, configResp.Diagnostics.HasError())
+		assert.Equal(t, 0, configResp.Diagnostics.WarningsCount())
+		assert.Equal(t, "https://eu-central-1.de.api.clumio.com",
+			configResp.ResourceData.(*common.ApiClient).ClumioConfig.BaseUrl)
+
+		//Reset the base url and region at the end of test.
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, baseUrl)
+		vals[regionKey] = tftypes.NewValue(tftypes.String, nil)
+	})
+
+	// Tests that diagnostics is returned when clumio_region is not a known region.
+	t.Run("Error when clumio_region is unknown", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, nil)
+		vals[regionKey] = tftypes.NewValue(tftypes.String, "mars-north-1")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.True(t, configResp.Diagnostics.HasError())
+
+		//Reset the base url and region at the end of test.
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, baseUrl)
+		vals[regionKey] = tftypes.NewValue(tftypes.String, nil)
+	})
+
+	// Tests that diagnostics is returned when retry_min_backoff is not a valid duration.
+	t.Run("Error when retry_min_backoff is invalid", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[minBackoffKey] = tftypes.NewValue(tftypes.String, "one second")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.True(t, configResp.Diagnostics.HasError())
+
+		//Reset the min backoff at the end of test.
+		vals[minBackoffKey] = tftypes.NewValue(tftypes.String, nil)
+	})
+
+	// Tests that diagnostics is returned when retry_min_backoff is greater than
+	// retry_max_backoff.
+	t.Run("Error when retry_min_backoff is greater than retry_max_backoff", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[minBackoffKey] = tftypes.NewValue(tftypes.String, "10s")
+		vals[maxBackoffKey] = tftypes.NewValue(tftypes.String, "5s")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.True(t, configResp.Diagnostics.HasError())
+
+		//Reset the backoffs at the end of test.
+		vals[minBackoffKey] = tftypes.NewValue(tftypes.String, nil)
+		vals[maxBackoffKey] = tftypes.NewValue(tftypes.String, nil)
+	})
+
+	// Tests that the SDK clients reach the Clumio API through the transport proxy when a transport
+	// setting is set, and that disabling the verification of the certificate is warned about.
+	t.Run("Transport settings are applied", func(t *testing.T) {
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, "https://us-west-2.api.clumio.com")
+		vals[proxyKey] = tftypes.NewValue(tftypes.String, "http://proxy.example.com:3128")
+		vals[insecureKey] = tftypes.NewValue(tftypes.Bool, true)
+		configResp := &provider.ConfigureResponse{}
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.False(t, configResp.Diagnostics.HasError())
+		assert.Equal(t, 1, configResp.Diagnostics.WarningsCount())
+		assert.True(t, strings.HasPrefix(
+			configResp.ResourceData.(*common.ApiClient).ClumioConfig.BaseUrl, "http://127.0.0.1:"))
+
+		//Reset the transport settings at the end of test.
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, baseUrl)
+		vals[proxyKey] = tftypes.NewValue(tftypes.String, nil)
+		vals[insecureKey] = tftypes.NewValue(tftypes.Bool, nil)
+	})
+
+	// Tests that an error is returned when a transport setting is not valid, either in the
+	// configuration or in the environment.
+	t.Run("Error when a transport setting is invalid", func(t *testing.T) {
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, "https://us-west-2.api.clumio.com")
+		vals[caBundleKey] = tftypes.NewValue(
+			tftypes.String, filepath.Join(t.TempDir(), "missing.pem"))
+		configResp := &provider.ConfigureResponse{}
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.Equal(t, 1, configResp.Diagnostics.ErrorsCount())
+
+		//Reset the transport settings at the end of test.
+		vals[apiBaseUrlKey] = tftypes.NewValue(tftypes.String, baseUrl)
+		vals[caBundleKey] = tftypes.NewValue(tftypes.String, nil)
+
+		t.Setenv(common.ClumioInsecureSkipVerify, "maybe")
+		configResp = &provider.ConfigureResponse{}
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.Equal(t, 1, configResp.Diagnostics.ErrorsCount())
+	})
+
+	// Tests that an error is returned when request_timeout is not a valid duration.
+	t.Run("Error when request_timeout is invalid", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[requestTimeoutKey] = tftypes.NewValue(tftypes.String, "soon")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.True(t, configResp.Diagnostics.HasError())
+
+		//Reset the request timeout at the end of test.
+		vals[requestTimeoutKey] = tftypes.NewValue(tftypes.String, nil)
+	})
+
+	// Tests that the values which are not set are read from the given profile.
+	t.Run("Values are read from the profile", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		err := os.WriteFile(configFile, []byte(
+			"[test]\nclumio_api_token = profile-token\nclumio_api_base_url = profile-base-url\n"),
+			0600)
+		assert.Nil(t, err)
+		vals[apiTokenKey] = tftypes.NewValue(tftypes.String, nil)
+		vals[profileKey] = tftypes.NewValue(tftypes.String, "test")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.False(t, configResp.Diagnostics.HasError())
+		client := configResp.ResourceData.(*common.ApiClient)
+		assert.Equal(t, "profile-token", client.ClumioConfig.Token)
+		assert.Equal(t, baseUrl, client.ClumioConfig.BaseUrl)
+		assert.Equal(t, ou, client.ClumioConfig.OrganizationalUnitContext)
+
+		//Reset the token and profile at the end of test.
+		vals[apiTokenKey] = tftypes.NewValue(tftypes.String, token)
+		vals[profileKey] = tftypes.NewValue(tftypes.String, nil)
 	})
 
+	// Tests that diagnostics is returned when the given profile does not exist.
+	t.Run("Error when the profile does not exist", func(t *testing.T) {
+		configResp := &provider.ConfigureResponse{}
+		resp := &provider.SchemaResponse{}
+		clumioProvider.Schema(context.Background(), provider.SchemaRequest{}, resp)
+
+		vals[apiTokenKey] = tftypes.NewValue(tftypes.String, nil)
+		vals[profileKey] = tftypes.NewValue(tftypes.String, "missing")
+		clumioProvider.Configure(ctx, provider.ConfigureRequest{
+			Config: tfsdk.Config{
+				Raw:    tftypes.NewValue(mapType, vals),
+				Schema: resp.Schema,
+			},
+		}, configResp)
+
+		assert.True(t, configResp.Diagnostics.HasError())
+
+		//Reset the token and profile at the end of test.
+		vals[apiTokenKey] = tftypes.NewValue(tftypes.String, token)
+		vals[profileKey] = tftypes.NewValue(tftypes.String, nil)
+	})
 }
 
 // Unit test for the provider Resources function.
@@ -137,5 +426,5 @@ func TestProviderDataSources(t *testing.T) {
 	clumioProvider := New()
 
 	resp := clumioProvider.DataSources(ctx)
-	assert.Equal(t, 11, len(resp))
+	assert.Equal(t, 12, len(resp))
 }
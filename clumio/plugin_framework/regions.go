@@ -0,0 +1,99 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the table of the Clumio regions and the functions used to resolve the API base
+// URL of a region and to validate API base URLs.
+
+package clumio_pf
+
+import (
+	"net/url"
+	"strings"
+)
+
+// clumioRegion describes a region in which Clumio is available.
+type clumioRegion struct {
+	// Name of the region as given in the clumio_region attribute.
+	Name string
+	// URL of the Clumio portal of the region.
+	PortalUrl string
+	// Base URL of the Clumio APIs of the region.
+	ApiBaseUrl string
+}
+
+// clumioRegions is the table of the regions in which Clumio is available. It is the single source
+// for the values of clumio_region, the API base URLs and the documentation of both attributes.
+var clumioRegions = []clumioRegion{
+	{
+		Name:       "us-west-2",
+		PortalUrl:  "https://west.portal.clumio.com/",
+		ApiBaseUrl: "https://us-west-2.api.clumio.com",
+	},
+	{
+		Name:       "us-east-1",
+		PortalUrl:  "https://east.portal.clumio.com/",
+		ApiBaseUrl: "https://us-east-1.api.clumio.com",
+	},
+	{
+		Name:       "ca-central-1",
+		PortalUrl:  "https://canada.portal.clumio.com/",
+		ApiBaseUrl: "https://ca-central-1.ca.api.clumio.com",
+	},
+	{
+		Name:       "eu-central-1",
+		PortalUrl:  "https://eu1.portal.clumio.com/",
+		ApiBaseUrl: "https://eu-central-1.de.api.clumio.com",
+	},
+	{
+		Name:       "ap-southeast-2",
+		PortalUrl:  "https://au.portal.clumio.com/",
+		ApiBaseUrl: "https://ap-southeast-2.au.api.clumio.com",
+	},
+}
+
+// apiBaseUrlForRegion returns the API base URL of the region with the given name and whether the
+// region is known.
+func apiBaseUrlForRegion(name string) (string, bool) {
+	for _, region := range clumioRegions {
+		if region.Name == name {
+			return region.ApiBaseUrl, true
+		}
+	}
+	return "", false
+}
+
+// clumioRegionNames returns the names of the known regions.
+func clumioRegionNames() []string {
+	names := make([]string, 0, len(clumioRegions))
+	for _, region := range clumioRegions {
+		names = append(names, region.Name)
+	}
+	return names
+}
+
+// isKnownApiBaseUrl returns whether the host of the given API base URL is the host of the API base
+// URL of a known region.
+func isKnownApiBaseUrl(baseUrl string) bool {
+	parsed, err := url.Parse(baseUrl)
+	if err != nil || parsed.Hostname() == "" {
+		return false
+	}
+	for _, region := range clumioRegions {
+		known, err := url.Parse(region.ApiBaseUrl)
+		if err == nil && strings.EqualFold(known.Hostname(), parsed.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiBaseUrlDescription returns the description of the API base URLs of the known regions used in
+// the documentation of the provider attributes.
+func apiBaseUrlDescription() string {
+	var builder strings.Builder
+	for _, region := range clumioRegions {
+		builder.WriteString("Region: " + region.Name + "\n\n\t\t")
+		builder.WriteString("Portal: " + region.PortalUrl + "\n\n\t\t")
+		builder.WriteString("API Base URL: " + region.ApiBaseUrl + "\n\n\t\t")
+	}
+	return builder.String()
+}
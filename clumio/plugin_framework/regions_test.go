@@ -0,0 +1,52 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the functions in regions.go.
+
+//go:build unit
+
+package clumio_pf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for the following cases:
+//   - API base URL of a known region.
+//   - Region which is not known.
+func TestApiBaseUrlForRegion(t *testing.T) {
+
+	t.Run("Known region", func(t *testing.T) {
+		baseUrl, ok := apiBaseUrlForRegion("ap-southeast-2")
+		assert.True(t, ok)
+		assert.Equal(t, "https://ap-southeast-2.au.api.clumio.com", baseUrl)
+	})
+
+	t.Run("Unknown region", func(t *testing.T) {
+		baseUrl, ok := apiBaseUrlForRegion("mars-north-1")
+		assert.False(t, ok)
+		assert.Empty(t, baseUrl)
+	})
+}
+
+// Unit test for the following cases:
+//   - API base URL of a known region, also with a different scheme, case or path.
+//   - API base URL with an unknown host.
+//   - Value which is not a URL.
+func TestIsKnownApiBaseUrl(t *testing.T) {
+
+	t.Run("Known API base URL", func(t *testing.T) {
+		assert.True(t, isKnownApiBaseUrl("https://us-west-2.api.clumio.com"))
+		assert.True(t, isKnownApiBaseUrl("https://US-EAST-1.api.clumio.com/"))
+		assert.True(t, isKnownApiBaseUrl("http://eu-central-1.de.api.clumio.com/api"))
+	})
+
+	t.Run("Unknown host", func(t *testing.T) {
+		assert.False(t, isKnownApiBaseUrl("https://us-west-2.api.clumio.example.com"))
+	})
+
+	t.Run("Not a URL", func(t *testing.T) {
+		assert.False(t, isKnownApiBaseUrl("us-west-2.api.clumio.com"))
+	})
+}
@@ -7,9 +7,14 @@ package clumio_pf
 
 import (
 	"context"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,7 +24,20 @@ import (
 type clumioProviderModel struct {
 	ClumioApiToken                  types.String `tfsdk:"clumio_api_token"`
 	ClumioApiBaseUrl                types.String `tfsdk:"clumio_api_base_url"`
+	ClumioRegion                    types.String `tfsdk:"clumio_region"`
 	ClumioOrganizationalUnitContext types.String `tfsdk:"clumio_organizational_unit_context"`
+	MaxRetries                      types.Int64  `tfsdk:"max_retries"`
+	RetryMinBackoff                 types.String `tfsdk:"retry_min_backoff"`
+	RetryMaxBackoff                 types.String `tfsdk:"retry_max_backoff"`
+	Profile                         types.String `tfsdk:"profile"`
+	ValidateCredentials             types.Bool   `tfsdk:"validate_credentials"`
+	HTTPSProxy                      types.String `tfsdk:"https_proxy"`
+	CABundleFile                    types.String `tfsdk:"ca_bundle_file"`
+	InsecureSkipVerify              types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout                  types.String `tfsdk:"request_timeout"`
+	MaxConcurrentRequests           types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxRequestsPerSecond            types.Int64  `tfsdk:"max_requests_per_second"`
+	DeletionProtection              types.Bool   `tfsdk:"deletion_protection"`
 }
 
 // Schema defines the structure and constraints of the provider block for the Clumio Provider for
@@ -34,24 +52,26 @@ func (p *clumioProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				MarkdownDescription: "The API token required to invoke Clumio APIs. " +
 					"Informations for generating this token are available here: " +
 					"https://documentation.commvault.com/clumio/api_tokens.html#manage-tokens",
-				Optional: true,
+				Optional:  true,
+				Sensitive: true,
 			},
 			"clumio_api_base_url": schema.StringAttribute{
-				MarkdownDescription: "The base URL for Clumio APIs. The following are the valid " +
-					"values for clumio_api_base_url. Use the appropriate value depending" +
-					" on the region for which your credentials were created. " +
-					"Below are the URLs to access the Clumio portal for each region and the corresponding API Base URLs:\n\n\t\t" +
-					"Portal: https://west.portal.clumio.com/\n\n\t\t" +
-					"API Base URL: https://us-west-2.api.clumio.com\n\n\t\t" +
-					"Portal: https://east.portal.clumio.com/\n\n\t\t" +
-					"API Base URL: https://us-east-1.api.clumio.com\n\n\t\t" +
-					"Portal: https://canada.portal.clumio.com/\n\n\t\t" +
-					"API Base URL:  https://ca-central-1.ca.api.clumio.com\n\n\t\t" +
-					"Portal: https://eu1.portal.clumio.com/\n\n\t\t" +
-					"API Base URL:  https://eu-central-1.de.api.clumio.com\n\n\t\t" +
-					"Portal: https://au.portal.clumio.com/\n\n\t\t" +
-					"API Base URL:  https://ap-southeast-2.au.api.clumio.com\n\n\t\t",
+				MarkdownDescription: "The base URL for Clumio APIs. Use the appropriate value" +
+					" depending on the region for which your credentials were created. Alternatively," +
+					" the region can be given using clumio_region. Below are the regions, the URLs to" +
+					" access the Clumio portal for each region and the corresponding API Base URLs:\n\n\t\t" +
+					apiBaseUrlDescription(),
+				Optional: true,
+			},
+			schemaClumioRegion: schema.StringAttribute{
+				MarkdownDescription: "The Clumio region for which your credentials were created," +
+					" from which the base URL for Clumio APIs is resolved. Alternative for" +
+					" clumio_api_base_url and for the environment variable CLUMIO_REGION. Valid" +
+					" values are `" + strings.Join(clumioRegionNames(), "`, `") + "`.",
 				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("clumio_api_base_url")),
+				},
 			},
 			"clumio_organizational_unit_context": schema.StringAttribute{
 				MarkdownDescription: "Organizational Unit context in which to create the" +
@@ -60,6 +80,103 @@ func (p *clumioProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					" be the id of the Organizational Unit and not the name.",
 				Optional: true,
 			},
+			schemaProfile: schema.StringAttribute{
+				MarkdownDescription: "Name of the profile of the Clumio shared config file from" +
+					" which to read the values of clumio_api_token, clumio_api_base_url and" +
+					" clumio_organizational_unit_context which are set neither in the" +
+					" configuration nor using environment variables. The shared config file" +
+					" defaults to `~/.clumio/config` and can be changed using the" +
+					" CLUMIO_CONFIG_FILE environment variable. Alternative for the environment" +
+					" variable CLUMIO_PROFILE. Defaults to `default`.",
+				Optional: true,
+			},
+			schemaValidateCredentials: schema.BoolAttribute{
+				MarkdownDescription: "Whether to validate the credentials while the provider is" +
+					" configured, by making a single call to the Clumio API. This ensures that" +
+					" clumio_api_token is valid for the API base URL and, if set, that" +
+					" clumio_organizational_unit_context refers to an existing organizational" +
+					" unit which the token can access. Defaults to `false`.",
+				Optional: true,
+			},
+			schemaDeletionProtection: schema.BoolAttribute{
+				MarkdownDescription: "Whether to prevent the deletion of the `clumio_policy`," +
+					" `clumio_protection_group` and `clumio_aws_connection` resources which do" +
+					" not set their own deletion_protection attribute. Defaults to `false`.",
+				Optional: true,
+			},
+			schemaHTTPSProxy: schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy through which to reach the Clumio API, such" +
+					" as `http://proxy.example.com:3128`. Alternative for the environment variable" +
+					" CLUMIO_HTTPS_PROXY. If neither is set, the standard HTTPS_PROXY and" +
+					" NO_PROXY environment variables are honored.",
+				Optional: true,
+			},
+			schemaCABundleFile: schema.StringAttribute{
+				MarkdownDescription: "Path of a PEM file holding the certificate authorities to" +
+					" trust in addition to the system certificate directories, such as the" +
+					" certificate authority of a TLS intercepting proxy. Alternative for the" +
+					" environment variable CLUMIO_CA_BUNDLE_FILE.",
+				Optional: true,
+			},
+			schemaInsecureSkipVerify: schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the TLS certificate of" +
+					" the Clumio API. This exposes the API token to anyone able to intercept the" +
+					" connection and must only be used for testing; prefer ca_bundle_file." +
+					" Alternative for the environment variable CLUMIO_INSECURE_SKIP_VERIFY." +
+					" Defaults to `false`.",
+				Optional: true,
+			},
+			schemaRequestTimeout: schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait for a single Clumio API call, as a" +
+					" duration string such as `30s` or `2m`. A call which timed out is" +
+					" abandoned rather than canceled and counts against" +
+					" max_concurrent_requests until it completes. Calls which only read objects" +
+					" are retried after a timeout as configured by max_retries, other calls are" +
+					" not as they may still be processed. Alternative for the environment" +
+					" variable CLUMIO_REQUEST_TIMEOUT. Defaults to no timeout.",
+				Optional: true,
+			},
+			schemaMaxConcurrentRequests: schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of Clumio API calls which the provider" +
+					" makes at the same time, across all resources. Calls which exceed the limit" +
+					" wait for another call to complete. Defaults to no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			schemaMaxRequestsPerSecond: schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of Clumio API calls which the provider" +
+					" starts per second, across all resources. Calls which exceed the limit" +
+					" are delayed. Defaults to no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			schemaMaxRetries: schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of times a Clumio API call which failed" +
+					" due to throttling or a transient error is retried. Calls which create" +
+					" objects are only retried when they were throttled. Defaults to 3. Set" +
+					" to 0 to disable retries.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			schemaRetryMinBackoff: schema.StringAttribute{
+				MarkdownDescription: "The time to wait before the first retry of a failed" +
+					" Clumio API call, as a duration string such as `500ms` or `2s`. The" +
+					" time doubles with every retry and is jittered. Defaults to `1s`.",
+				Optional: true,
+			},
+			schemaRetryMaxBackoff: schema.StringAttribute{
+				MarkdownDescription: "The maximum time to wait between retries of a failed" +
+					" Clumio API call, as a duration string such as `30s` or `1m`. The" +
+					" `Retry-After` header of throttled responses is not exposed by the Clumio" +
+					" SDK and is not honored. Defaults to `30s`.",
+				Optional: true,
+			},
 		},
 	}
 }
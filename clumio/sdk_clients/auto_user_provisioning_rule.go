@@ -5,14 +5,85 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkAUPRules "github.com/clumio-code/clumio-go-sdk/controllers/auto_user_provisioning_rules"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type AutoUserProvisioningRuleClient interface {
 	sdkAUPRules.AutoUserProvisioningRulesV1Client
 }
 
-func NewAutoUserProvisioningRuleClient(config config.Config) AutoUserProvisioningRuleClient {
-	return sdkAUPRules.NewAutoUserProvisioningRulesV1(config)
+// NewAutoUserProvisioningRuleClient returns a AutoUserProvisioningRuleClient for the given config.
+// Every call made through the client is passed through the given middlewares, in order.
+func NewAutoUserProvisioningRuleClient(
+	config config.Config, middlewares ...Middleware) AutoUserProvisioningRuleClient {
+
+	client := sdkAUPRules.NewAutoUserProvisioningRulesV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &autoUserProvisioningRuleClient{client, chainMiddlewares(middlewares)}
+}
+
+// autoUserProvisioningRuleClient is a AutoUserProvisioningRuleClient which passes every call
+// through a middleware.
+type autoUserProvisioningRuleClient struct {
+	AutoUserProvisioningRuleClient
+	middleware Middleware
+}
+
+func (c *autoUserProvisioningRuleClient) CreateAutoUserProvisioningRule(
+	body *models.CreateAutoUserProvisioningRuleV1Request) (
+	*models.CreateAutoUserProvisioningRuleResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateAutoUserProvisioningRule", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateAutoUserProvisioningRuleResponse, *apiutils.APIError) {
+			return c.AutoUserProvisioningRuleClient.CreateAutoUserProvisioningRule(body)
+		})
+}
+
+func (c *autoUserProvisioningRuleClient) DeleteAutoUserProvisioningRule(ruleId string) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("DeleteAutoUserProvisioningRule", true, "ruleId", ruleId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.AutoUserProvisioningRuleClient.DeleteAutoUserProvisioningRule(ruleId)
+		})
+}
+
+func (c *autoUserProvisioningRuleClient) ListAutoUserProvisioningRules(
+	limit *int64, start *string, filter *string) (
+	*models.ListAutoUserProvisioningRulesResponse, *apiutils.APIError) {
+
+	call := readCall("ListAutoUserProvisioningRules", "limit", limit, "start", start,
+		"filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListAutoUserProvisioningRulesResponse, *apiutils.APIError) {
+			return c.AutoUserProvisioningRuleClient.ListAutoUserProvisioningRules(limit, start, filter)
+		})
+}
+
+func (c *autoUserProvisioningRuleClient) ReadAutoUserProvisioningRule(ruleId string) (
+	*models.ReadAutoUserProvisioningRuleResponse, *apiutils.APIError) {
+
+	call := readCall("ReadAutoUserProvisioningRule", "ruleId", ruleId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadAutoUserProvisioningRuleResponse, *apiutils.APIError) {
+			return c.AutoUserProvisioningRuleClient.ReadAutoUserProvisioningRule(ruleId)
+		})
+}
+
+func (c *autoUserProvisioningRuleClient) UpdateAutoUserProvisioningRule(
+	ruleId string, body *models.UpdateAutoUserProvisioningRuleV1Request) (
+	*models.UpdateAutoUserProvisioningRuleResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateAutoUserProvisioningRule", true, "ruleId", ruleId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateAutoUserProvisioningRuleResponse, *apiutils.APIError) {
+			return c.AutoUserProvisioningRuleClient.UpdateAutoUserProvisioningRule(ruleId, body)
+		})
 }
@@ -5,14 +5,51 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkAUPSettings "github.com/clumio-code/clumio-go-sdk/controllers/auto_user_provisioning_settings"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type AutoUserProvisioningSettingClient interface {
 	sdkAUPSettings.AutoUserProvisioningSettingsV1Client
 }
 
-func NewAutoUserProvisioningSettingClient(config config.Config) AutoUserProvisioningSettingClient {
-	return sdkAUPSettings.NewAutoUserProvisioningSettingsV1(config)
+// NewAutoUserProvisioningSettingClient returns a AutoUserProvisioningSettingClient for the given
+// config. Every call made through the client is passed through the given middlewares, in order.
+func NewAutoUserProvisioningSettingClient(
+	config config.Config, middlewares ...Middleware) AutoUserProvisioningSettingClient {
+
+	client := sdkAUPSettings.NewAutoUserProvisioningSettingsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &autoUserProvisioningSettingClient{client, chainMiddlewares(middlewares)}
+}
+
+// autoUserProvisioningSettingClient is a AutoUserProvisioningSettingClient which passes every call
+// through a middleware.
+type autoUserProvisioningSettingClient struct {
+	AutoUserProvisioningSettingClient
+	middleware Middleware
+}
+
+func (c *autoUserProvisioningSettingClient) ReadAutoUserProvisioningSetting() (
+	*models.ReadAutoUserProvisioningSettingResponse, *apiutils.APIError) {
+
+	return invoke(c.middleware, readCall("ReadAutoUserProvisioningSetting"),
+		func() (*models.ReadAutoUserProvisioningSettingResponse, *apiutils.APIError) {
+			return c.AutoUserProvisioningSettingClient.ReadAutoUserProvisioningSetting()
+		})
+}
+
+func (c *autoUserProvisioningSettingClient) UpdateAutoUserProvisioningSetting(
+	body *models.UpdateAutoUserProvisioningSettingV1Request) (
+	*models.UpdateAutoUserProvisioningSettingResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateAutoUserProvisioningSetting", true, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateAutoUserProvisioningSettingResponse, *apiutils.APIError) {
+			return c.AutoUserProvisioningSettingClient.UpdateAutoUserProvisioningSetting(body)
+		})
 }
@@ -5,14 +5,82 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	aws_connections "github.com/clumio-code/clumio-go-sdk/controllers/aws_connections"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type AWSConnectionClient interface {
 	aws_connections.AwsConnectionsV1Client
 }
 
-func NewAWSConnectionClient(config config.Config) AWSConnectionClient {
-	return aws_connections.NewAwsConnectionsV1(config)
+// NewAWSConnectionClient returns a AWSConnectionClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewAWSConnectionClient(
+	config config.Config, middlewares ...Middleware) AWSConnectionClient {
+
+	client := aws_connections.NewAwsConnectionsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &awsConnectionClient{client, chainMiddlewares(middlewares)}
+}
+
+// awsConnectionClient is a AWSConnectionClient which passes every call through a middleware.
+type awsConnectionClient struct {
+	AWSConnectionClient
+	middleware Middleware
+}
+
+func (c *awsConnectionClient) CreateAwsConnection(body *models.CreateAwsConnectionV1Request) (
+	*models.CreateAWSConnectionResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateAwsConnection", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateAWSConnectionResponse, *apiutils.APIError) {
+			return c.AWSConnectionClient.CreateAwsConnection(body)
+		})
+}
+
+func (c *awsConnectionClient) DeleteAwsConnection(connectionId string) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("DeleteAwsConnection", true, "connectionId", connectionId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.AWSConnectionClient.DeleteAwsConnection(connectionId)
+		})
+}
+
+func (c *awsConnectionClient) ListAwsConnections(limit *int64, start *string, filter *string) (
+	*models.ListAWSConnectionsResponse, *apiutils.APIError) {
+
+	call := readCall("ListAwsConnections", "limit", limit, "start", start, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListAWSConnectionsResponse, *apiutils.APIError) {
+			return c.AWSConnectionClient.ListAwsConnections(limit, start, filter)
+		})
+}
+
+func (c *awsConnectionClient) ReadAwsConnection(connectionId string, returnExternalId *string) (
+	*models.ReadAWSConnectionResponse, *apiutils.APIError) {
+
+	call := readCall("ReadAwsConnection", "connectionId", connectionId,
+		"returnExternalId", returnExternalId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadAWSConnectionResponse, *apiutils.APIError) {
+			return c.AWSConnectionClient.ReadAwsConnection(connectionId, returnExternalId)
+		})
+}
+
+func (c *awsConnectionClient) UpdateAwsConnection(
+	connectionId string, body models.UpdateAwsConnectionV1Request) (
+	*models.UpdateAWSConnectionResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateAwsConnection", true, "connectionId", connectionId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateAWSConnectionResponse, *apiutils.APIError) {
+			return c.AWSConnectionClient.UpdateAwsConnection(connectionId, body)
+		})
 }
@@ -5,14 +5,54 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	awsenvironments "github.com/clumio-code/clumio-go-sdk/controllers/aws_environments"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type AWSEnvironmentClient interface {
 	awsenvironments.AwsEnvironmentsV1Client
 }
 
-func NewAWSEnvironmentClient(config config.Config) AWSEnvironmentClient {
-	return awsenvironments.NewAwsEnvironmentsV1(config)
+// NewAWSEnvironmentClient returns a AWSEnvironmentClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewAWSEnvironmentClient(
+	config config.Config, middlewares ...Middleware) AWSEnvironmentClient {
+
+	client := awsenvironments.NewAwsEnvironmentsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &awsEnvironmentClient{client, chainMiddlewares(middlewares)}
+}
+
+// awsEnvironmentClient is a AWSEnvironmentClient which passes every call through a middleware.
+type awsEnvironmentClient struct {
+	AWSEnvironmentClient
+	middleware Middleware
+}
+
+func (c *awsEnvironmentClient) ListAwsEnvironments(
+	limit *int64, start *string, filter *string, embed *string, lookbackDays *int64) (
+	*models.ListAWSEnvironmentsResponse, *apiutils.APIError) {
+
+	call := readCall("ListAwsEnvironments", "limit", limit, "start", start, "filter", filter,
+		"embed", embed, "lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ListAWSEnvironmentsResponse, *apiutils.APIError) {
+			return c.AWSEnvironmentClient.ListAwsEnvironments(limit, start, filter, embed, lookbackDays)
+		})
+}
+
+func (c *awsEnvironmentClient) ReadAwsEnvironment(
+	environmentId string, embed *string, lookbackDays *int64) (
+	*models.ReadAWSEnvironmentResponse, *apiutils.APIError) {
+
+	call := readCall("ReadAwsEnvironment", "environmentId", environmentId, "embed", embed,
+		"lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ReadAWSEnvironmentResponse, *apiutils.APIError) {
+			return c.AWSEnvironmentClient.ReadAwsEnvironment(environmentId, embed, lookbackDays)
+		})
 }
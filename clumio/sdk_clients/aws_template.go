@@ -5,14 +5,51 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	awstemplates "github.com/clumio-code/clumio-go-sdk/controllers/aws_templates"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type AWSTemplatesClient interface {
 	awstemplates.AwsTemplatesV1Client
 }
 
-func NewAWSTemplatesClient(config config.Config) AWSTemplatesClient {
-	return awstemplates.NewAwsTemplatesV1(config)
+// NewAWSTemplatesClient returns a AWSTemplatesClient for the given config. Every call made through
+// the client is passed through the given middlewares, in order.
+func NewAWSTemplatesClient(
+	config config.Config, middlewares ...Middleware) AWSTemplatesClient {
+
+	client := awstemplates.NewAwsTemplatesV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &awsTemplatesClient{client, chainMiddlewares(middlewares)}
+}
+
+// awsTemplatesClient is a AWSTemplatesClient which passes every call through a middleware.
+type awsTemplatesClient struct {
+	AWSTemplatesClient
+	middleware Middleware
+}
+
+func (c *awsTemplatesClient) CreateConnectionTemplate(
+	returnGroupToken *bool, body *models.CreateConnectionTemplateV1Request) (
+	*models.CreateAWSTemplateV2Response, *apiutils.APIError) {
+
+	call := writeCall("CreateConnectionTemplate", false, "returnGroupToken", returnGroupToken,
+		"body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateAWSTemplateV2Response, *apiutils.APIError) {
+			return c.AWSTemplatesClient.CreateConnectionTemplate(returnGroupToken, body)
+		})
+}
+
+func (c *awsTemplatesClient) ReadConnectionTemplates() (
+	*models.ReadAWSTemplatesV2Response, *apiutils.APIError) {
+
+	return invoke(c.middleware, readCall("ReadConnectionTemplates"),
+		func() (*models.ReadAWSTemplatesV2Response, *apiutils.APIError) {
+			return c.AWSTemplatesClient.ReadConnectionTemplates()
+		})
 }
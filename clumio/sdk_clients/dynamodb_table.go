@@ -5,14 +5,54 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkDynamoDBTable "github.com/clumio-code/clumio-go-sdk/controllers/aws_dynamodb_tables"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type DynamoDBTableClient interface {
 	sdkDynamoDBTable.AwsDynamodbTablesV1Client
 }
 
-func NewDynamoDBTableClient(config config.Config) DynamoDBTableClient {
-	return sdkDynamoDBTable.NewAwsDynamodbTablesV1(config)
+// NewDynamoDBTableClient returns a DynamoDBTableClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewDynamoDBTableClient(
+	config config.Config, middlewares ...Middleware) DynamoDBTableClient {
+
+	client := sdkDynamoDBTable.NewAwsDynamodbTablesV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &dynamoDBTableClient{client, chainMiddlewares(middlewares)}
+}
+
+// dynamoDBTableClient is a DynamoDBTableClient which passes every call through a middleware.
+type dynamoDBTableClient struct {
+	DynamoDBTableClient
+	middleware Middleware
+}
+
+func (c *dynamoDBTableClient) ListAwsDynamodbTables(
+	limit *int64, start *string, filter *string, embed *string, lookbackDays *int64) (
+	*models.ListDynamoDBTableResponse, *apiutils.APIError) {
+
+	call := readCall("ListAwsDynamodbTables", "limit", limit, "start", start, "filter", filter,
+		"embed", embed, "lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ListDynamoDBTableResponse, *apiutils.APIError) {
+			return c.DynamoDBTableClient.ListAwsDynamodbTables(limit, start, filter, embed, lookbackDays)
+		})
+}
+
+func (c *dynamoDBTableClient) ReadAwsDynamodbTable(
+	tableId string, lookbackDays *int64, embed *string) (
+	*models.ReadDynamoDBTableResponse, *apiutils.APIError) {
+
+	call := readCall("ReadAwsDynamodbTable", "tableId", tableId, "lookbackDays", lookbackDays,
+		"embed", embed)
+	return invoke(c.middleware, call,
+		func() (*models.ReadDynamoDBTableResponse, *apiutils.APIError) {
+			return c.DynamoDBTableClient.ReadAwsDynamodbTable(tableId, lookbackDays, embed)
+		})
 }
@@ -5,14 +5,92 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	gcpconnections "github.com/clumio-code/clumio-go-sdk/controllers/gcp_connections"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type GcpConnectionClient interface {
 	gcpconnections.GcpConnectionsV1Client
 }
 
-func NewGcpConnectionClient(config config.Config) GcpConnectionClient {
-	return gcpconnections.NewGcpConnectionsV1(config)
+// NewGcpConnectionClient returns a GcpConnectionClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewGcpConnectionClient(
+	config config.Config, middlewares ...Middleware) GcpConnectionClient {
+
+	client := gcpconnections.NewGcpConnectionsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &gcpConnectionClient{client, chainMiddlewares(middlewares)}
+}
+
+// gcpConnectionClient is a GcpConnectionClient which passes every call through a middleware.
+type gcpConnectionClient struct {
+	GcpConnectionClient
+	middleware Middleware
+}
+
+func (c *gcpConnectionClient) CreateGcpConnection(body *models.CreateGcpConnectionV1Request) (
+	*models.CreateGCPConnectionResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateGcpConnection", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateGCPConnectionResponse, *apiutils.APIError) {
+			return c.GcpConnectionClient.CreateGcpConnection(body)
+		})
+}
+
+func (c *gcpConnectionClient) DeleteGcpConnection(projectId string) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("DeleteGcpConnection", true, "projectId", projectId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.GcpConnectionClient.DeleteGcpConnection(projectId)
+		})
+}
+
+func (c *gcpConnectionClient) ListGcpConnections(limit *int64, start *string, filter *string) (
+	*models.ListGCPConnectionsResponse, *apiutils.APIError) {
+
+	call := readCall("ListGcpConnections", "limit", limit, "start", start, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListGCPConnectionsResponse, *apiutils.APIError) {
+			return c.GcpConnectionClient.ListGcpConnections(limit, start, filter)
+		})
+}
+
+func (c *gcpConnectionClient) PostProcessGcpConnection(
+	body *models.PostProcessGcpConnectionV1Request) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("PostProcessGcpConnection", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.GcpConnectionClient.PostProcessGcpConnection(body)
+		})
+}
+
+func (c *gcpConnectionClient) ReadGcpConnection(projectId string) (
+	*models.ReadGCPConnectionResponse, *apiutils.APIError) {
+
+	call := readCall("ReadGcpConnection", "projectId", projectId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadGCPConnectionResponse, *apiutils.APIError) {
+			return c.GcpConnectionClient.ReadGcpConnection(projectId)
+		})
+}
+
+func (c *gcpConnectionClient) UpdateGcpConnection(
+	projectId string, body *models.UpdateGcpConnectionV1Request) (
+	*models.UpdateGCPConnectionResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateGcpConnection", true, "projectId", projectId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateGCPConnectionResponse, *apiutils.APIError) {
+			return c.GcpConnectionClient.UpdateGcpConnection(projectId, body)
+		})
 }
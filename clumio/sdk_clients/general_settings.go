@@ -5,14 +5,49 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkGeneralSettings "github.com/clumio-code/clumio-go-sdk/controllers/general_settings"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type GeneralSettingsClient interface {
 	sdkGeneralSettings.GeneralSettingsV2Client
 }
 
-func NewGeneralSettingsClient(config config.Config) GeneralSettingsClient {
-	return sdkGeneralSettings.NewGeneralSettingsV2(config)
+// NewGeneralSettingsClient returns a GeneralSettingsClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewGeneralSettingsClient(
+	config config.Config, middlewares ...Middleware) GeneralSettingsClient {
+
+	client := sdkGeneralSettings.NewGeneralSettingsV2(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &generalSettingsClient{client, chainMiddlewares(middlewares)}
+}
+
+// generalSettingsClient is a GeneralSettingsClient which passes every call through a middleware.
+type generalSettingsClient struct {
+	GeneralSettingsClient
+	middleware Middleware
+}
+
+func (c *generalSettingsClient) ReadGeneralSettings() (
+	*models.ReadGeneralSettingsResponseV2, *apiutils.APIError) {
+
+	return invoke(c.middleware, readCall("ReadGeneralSettings"),
+		func() (*models.ReadGeneralSettingsResponseV2, *apiutils.APIError) {
+			return c.GeneralSettingsClient.ReadGeneralSettings()
+		})
+}
+
+func (c *generalSettingsClient) UpdateGeneralSettings(body *models.UpdateGeneralSettingsV2Request) (
+	*models.PatchGeneralSettingsResponseV2, *apiutils.APIError) {
+
+	call := writeCall("UpdateGeneralSettings", true, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.PatchGeneralSettingsResponseV2, *apiutils.APIError) {
+			return c.GeneralSettingsClient.UpdateGeneralSettings(body)
+		})
 }
@@ -0,0 +1,137 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the limiter bounding the number of concurrent Clumio API calls and their rate, and the
+// middleware applying it and the request timeout to every call.
+
+package sdkclients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// requestTimeoutReason is the reason of the error returned for a call which timed out.
+const requestTimeoutReason = "request timed out"
+
+// Limiter bounds the number of Clumio API calls which are in flight at the same time and the rate
+// at which they are started. A single Limiter is shared by all the clients of a provider instance
+// so that the bounds apply across resources.
+type Limiter struct {
+	// slots holds a token for every call in flight. It is nil if concurrency is not limited.
+	slots chan struct{}
+	// interval is the minimum time between the start of two calls. It is 0 if the rate is not
+	// limited.
+	interval time.Duration
+
+	// lock guards next, the earliest time at which the next call may start.
+	lock sync.Mutex
+	next time.Time
+}
+
+// NewLimiter returns a Limiter allowing at most maxConcurrent calls in flight and starting at most
+// requestsPerSecond calls per second. A bound which is 0 is not enforced.
+func NewLimiter(maxConcurrent int, requestsPerSecond int) *Limiter {
+	limiter := &Limiter{}
+	if maxConcurrent > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		limiter.interval = time.Second / time.Duration(requestsPerSecond)
+	}
+	return limiter
+}
+
+// acquire waits until a call may start and returns the function releasing it once it completed.
+// The call first waits for its turn under the rate limit and only then for a free slot, so that it
+// does not hold on to a slot while it is delayed by the rate limit. It returns false if the given
+// context is done before the call may start.
+func (l *Limiter) acquire(ctx context.Context) (func(), bool) {
+	if l.interval > 0 {
+		l.lock.Lock()
+		start := now()
+		if l.next.After(start) {
+			start = l.next
+		}
+		l.next = start.Add(l.interval)
+		l.lock.Unlock()
+		if wait := start.Sub(now()); wait > 0 && !sleep(ctx, wait) {
+			return nil, false
+		}
+	}
+	release := func() {}
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, false
+		}
+		release = func() { <-l.slots }
+	}
+	return release, true
+}
+
+// NewLimiterMiddleware returns a Middleware which applies the given limiter to every call and, if
+// the given timeout is not 0, fails calls which take longer than the timeout. The SDK does not
+// accept a context, so a call which timed out is abandoned rather than canceled. It keeps its slot
+// of the limiter until it completes, so that abandoned calls still count against the concurrency
+// limit. The time a call waited for the limiter is logged at DEBUG.
+func NewLimiterMiddleware(limiter *Limiter, timeout time.Duration) Middleware {
+	return func(call Call, next func() (any, *apiutils.APIError)) (any, *apiutils.APIError) {
+		ctx := call.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		start := now()
+		release, ok := limiter.acquire(ctx)
+		if !ok {
+			return nil, &apiutils.APIError{
+				Reason: fmt.Sprintf("%s: canceled while waiting for the call limit: %s",
+					call.Operation, ctx.Err()),
+			}
+		}
+		if wait := now().Sub(start); wait > 0 {
+			tflog.Debug(ctx, "Waited for Clumio API call limit", map[string]any{
+				"operation": call.Operation,
+				"wait_ms":   wait.Milliseconds(),
+			})
+		}
+		if timeout <= 0 {
+			defer release()
+			return next()
+		}
+
+		type result struct {
+			res    any
+			apiErr *apiutils.APIError
+		}
+		done := make(chan result, 1)
+		go func() {
+			defer release()
+			res, apiErr := next()
+			done <- result{res, apiErr}
+		}()
+
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case r := <-done:
+			return r.res, r.apiErr
+		case <-timer.C:
+			return nil, &apiutils.APIError{
+				Reason: fmt.Sprintf("%s: %s after %s", call.Operation, requestTimeoutReason,
+					timeout),
+			}
+		}
+	}
+}
+
+// isRequestTimeout returns true if the error was returned for a call which timed out.
+func isRequestTimeout(apiErr *apiutils.APIError) bool {
+	return apiErr.ResponseCode == 0 && strings.Contains(apiErr.Reason, requestTimeoutReason)
+}
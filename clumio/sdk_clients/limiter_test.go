@@ -0,0 +1,207 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the limiter middleware.
+
+//go:build unit
+
+package sdkclients
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for the following cases:
+//   - Number of calls in flight does not exceed the concurrency limit.
+//   - Calls are spaced according to the rate limit.
+//   - Limiter without limits does not delay calls.
+//   - Call does not hold a slot while it waits for the rate limit.
+//   - Call whose context is done while it waits for a slot is not made.
+func TestLimiterMiddleware(t *testing.T) {
+
+	taskId := "test-task-id"
+	status := "completed"
+	readResponse := &models.ReadTaskResponse{Status: &status}
+
+	// Tests that no more than the given number of calls are in flight at the same time.
+	t.Run("Concurrency is limited", func(t *testing.T) {
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewLimiterMiddleware(NewLimiter(2, 0), 0)}
+		var lock sync.Mutex
+		inFlight, maxInFlight := 0, 0
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).RunAndReturn(
+			func(string) (*models.ReadTaskResponse, *apiutils.APIError) {
+				lock.Lock()
+				inFlight++
+				maxInFlight = max(maxInFlight, inFlight)
+				lock.Unlock()
+				time.Sleep(5 * time.Millisecond)
+				lock.Lock()
+				inFlight--
+				lock.Unlock()
+				return readResponse, nil
+			}).Times(10)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, apiErr := client.ReadTask(taskId)
+				assert.Nil(t, apiErr)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 2, maxInFlight)
+	})
+
+	// Tests that the start of the calls is spaced by the interval of the rate limit.
+	t.Run("Rate is limited", func(t *testing.T) {
+		backoffs := setupRetryTest(t)
+		origNow := now
+		current := time.Now()
+		now = func() time.Time { return current }
+		t.Cleanup(func() {
+			now = origNow
+		})
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewLimiterMiddleware(NewLimiter(0, 4), 0)}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(3).Return(readResponse, nil)
+
+		for i := 0; i < 3; i++ {
+			_, apiErr := client.ReadTask(taskId)
+			assert.Nil(t, apiErr)
+		}
+		assert.Equal(t, []time.Duration{250 * time.Millisecond, 500 * time.Millisecond},
+			*backoffs)
+	})
+
+	// Tests that a limiter without limits does not delay the calls.
+	t.Run("No limits", func(t *testing.T) {
+		backoffs := setupRetryTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewLimiterMiddleware(NewLimiter(0, 0), 0)}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(3).Return(readResponse, nil)
+
+		for i := 0; i < 3; i++ {
+			_, apiErr := client.ReadTask(taskId)
+			assert.Nil(t, apiErr)
+		}
+		assert.Empty(t, *backoffs)
+	})
+
+	// Tests that a call waiting for its turn under the rate limit does not hold on to a slot, so
+	// that it does not block the calls which may already start.
+	t.Run("Slot is not held while waiting for the rate limit", func(t *testing.T) {
+		setupRetryTest(t)
+		origNow := now
+		current := time.Now()
+		now = func() time.Time { return current }
+		limiter := NewLimiter(1, 4)
+		slotsInUse := make([]int, 0)
+		sleep = func(_ context.Context, d time.Duration) bool {
+			slotsInUse = append(slotsInUse, len(limiter.slots))
+			return true
+		}
+		t.Cleanup(func() {
+			now = origNow
+		})
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewLimiterMiddleware(limiter, 0)}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(2).Return(readResponse, nil)
+
+		for i := 0; i < 2; i++ {
+			_, apiErr := client.ReadTask(taskId)
+			assert.Nil(t, apiErr)
+		}
+		assert.Equal(t, []int{0}, slotsInUse)
+	})
+
+	// Tests that a call whose context is done while it waits for a slot fails without being made.
+	t.Run("Canceled call is not made", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		limiter := NewLimiter(1, 0)
+		limiter.slots <- struct{}{}
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{
+			mockTask, chainMiddlewares(WithContext(ctx, NewLimiterMiddleware(limiter, 0)))}
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, res)
+		assert.NotNil(t, apiErr)
+		assert.Contains(t, apiErr.Reason, context.Canceled.Error())
+	})
+}
+
+// Unit test for the following cases:
+//   - Call which completes in time returns its result.
+//   - Call which does not complete in time returns a timeout error.
+//   - Call which timed out keeps its slot of the limiter until it completes.
+//   - Only calls which do not modify any state are retried after a timeout.
+func TestLimiterMiddlewareTimeout(t *testing.T) {
+
+	taskId := "test-task-id"
+	status := "completed"
+	readResponse := &models.ReadTaskResponse{Status: &status}
+
+	t.Run("Call completes in time", func(t *testing.T) {
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewLimiterMiddleware(NewLimiter(0, 0), time.Minute)}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(readResponse, nil)
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, apiErr)
+		assert.Equal(t, readResponse, res)
+	})
+
+	// Tests that a call which timed out holds on to its slot until it completes, so that a later
+	// call waits for it rather than exceeding the concurrency limit.
+	t.Run("Call times out and keeps its slot", func(t *testing.T) {
+		mockTask := NewMockTaskClient(t)
+		limiter := NewLimiter(1, 0)
+		client := &taskClient{mockTask, NewLimiterMiddleware(limiter, 10*time.Millisecond)}
+		release := make(chan time.Time)
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).WaitUntil(release).Return(readResponse, nil)
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, res)
+		assert.NotNil(t, apiErr)
+		assert.True(t, isRequestTimeout(apiErr))
+		assert.Len(t, limiter.slots, 1)
+
+		close(release)
+		assert.Eventually(t, func() bool {
+			return len(limiter.slots) == 0
+		}, time.Second, time.Millisecond)
+	})
+
+	// Tests that a read which timed out is retried while a write, which may still be processed by
+	// the API, is not.
+	t.Run("Only read-only calls are retried after a timeout", func(t *testing.T) {
+		apiErr := &apiutils.APIError{
+			Reason: "ReadTask: " + requestTimeoutReason + " after 10ms",
+		}
+		assert.True(t, isRetryable(readCall("ReadTask"), apiErr))
+		assert.False(t, isRetryable(writeCall("UpdatePolicyDefinition", true), apiErr))
+		assert.False(t, isRetryable(writeCall("CreateWallet", false), apiErr))
+	})
+}
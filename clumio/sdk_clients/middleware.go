@@ -0,0 +1,99 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the middleware chain which is applied to every call made through the clients of this
+// package.
+
+package sdkclients
+
+import (
+	"context"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+)
+
+// Call describes a single invocation of a Clumio API made through one of the clients of this
+// package.
+type Call struct {
+	// Context is the context of the Terraform operation making the call. It carries the logging
+	// fields and the cancellation of the operation and is nil if no context was given.
+	Context context.Context
+	// Operation is the name of the client method being invoked, e.g. "ReadPolicyDefinition".
+	Operation string
+	// Idempotent is true if making the call more than once has the same effect as making it once.
+	Idempotent bool
+	// ReadOnly is true if the call does not modify any state, so that it may be repeated while an
+	// earlier attempt is still in flight.
+	ReadOnly bool
+	// Params holds the arguments of the call by parameter name, e.g. "filter" or "body".
+	Params map[string]any
+}
+
+// Middleware wraps the invocation of a Clumio API. It must call next to perform the invocation
+// and may do so more than once, for instance to retry a failed call.
+type Middleware func(call Call, next func() (any, *apiutils.APIError)) (any, *apiutils.APIError)
+
+// WithContext returns the given middlewares applied in the given context, so that they see the
+// context of the Terraform operation making the calls rather than the one of the provider
+// configuration. The context is set on every call before it is passed to a middleware.
+func WithContext(ctx context.Context, middlewares ...Middleware) []Middleware {
+	withContext := make([]Middleware, len(middlewares))
+	for i, middleware := range middlewares {
+		withContext[i] = func(call Call, next func() (any, *apiutils.APIError)) (
+			any, *apiutils.APIError) {
+
+			call.Context = ctx
+			return middleware(call, next)
+		}
+	}
+	return withContext
+}
+
+// chainMiddlewares combines the given middlewares into a single one. The first middleware is the
+// outermost one and is therefore the first to see a call and the last to see its result.
+func chainMiddlewares(middlewares []Middleware) Middleware {
+	return func(call Call, next func() (any, *apiutils.APIError)) (any, *apiutils.APIError) {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			middleware, inner := middlewares[i], next
+			next = func() (any, *apiutils.APIError) {
+				return middleware(call, inner)
+			}
+		}
+		return next()
+	}
+}
+
+// invoke runs fn through the given middleware and returns its typed result.
+func invoke[T any](middleware Middleware, call Call, fn func() (T, *apiutils.APIError)) (
+	T, *apiutils.APIError) {
+
+	// The result is taken from the value returned by the middleware rather than captured from fn,
+	// as a middleware may abandon an invocation which then completes after it returned.
+	out, apiErr := middleware(call, func() (any, *apiutils.APIError) {
+		return fn()
+	})
+	res, _ := out.(T)
+	return res, apiErr
+}
+
+// readCall returns the Call for an operation which does not modify any state, such as a read or a
+// list. The arguments of the call are given as alternating parameter names and values.
+func readCall(operation string, params ...any) Call {
+	return Call{
+		Operation: operation, Idempotent: true, ReadOnly: true, Params: callParams(params)}
+}
+
+// writeCall returns the Call for an operation which modifies state. Only updates and deletes are
+// idempotent, creates are not as repeating them may create duplicate objects. The arguments of the
+// call are given as alternating parameter names and values.
+func writeCall(operation string, idempotent bool, params ...any) Call {
+	return Call{Operation: operation, Idempotent: idempotent, Params: callParams(params)}
+}
+
+// callParams converts the given alternating parameter names and values into a map.
+func callParams(keyValues []any) map[string]any {
+	params := make(map[string]any, len(keyValues)/2)
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		params[keyValues[i].(string)] = keyValues[i+1]
+	}
+	return params
+}
@@ -0,0 +1,95 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the middleware chain.
+
+//go:build unit
+
+package sdkclients
+
+import (
+	"context"
+	"testing"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Unit test for the following cases:
+//   - Middlewares are invoked in order with the call being made.
+//   - Middlewares applied in a context see that context on every call.
+//   - Client is wrapped only when middlewares are given.
+func TestMiddlewareChain(t *testing.T) {
+
+	taskId := "test-task-id"
+	status := "completed"
+	readResponse := &models.ReadTaskResponse{Status: &status}
+
+	// Tests that the first middleware is the outermost one and that every middleware sees the
+	// call being made and its result.
+	t.Run("Middlewares are invoked in order", func(t *testing.T) {
+
+		invoked := make([]string, 0)
+		recorder := func(name string) Middleware {
+			return func(call Call, next func() (any, *apiutils.APIError)) (
+				any, *apiutils.APIError) {
+
+				assert.Equal(t, readCall("ReadTask", "taskId", taskId), call)
+				invoked = append(invoked, name+" before")
+				res, apiErr := next()
+				assert.Equal(t, readResponse, res)
+				invoked = append(invoked, name+" after")
+				return res, apiErr
+			}
+		}
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{
+			mockTask, chainMiddlewares([]Middleware{recorder("first"), recorder("second")})}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(readResponse, nil)
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, apiErr)
+		assert.Equal(t, readResponse, res)
+		assert.Equal(t, []string{
+			"first before", "second before", "second after", "first after"}, invoked)
+	})
+
+	// Tests that every middleware applied in a context sees that context on the call, so that the
+	// calls are logged and canceled with the Terraform operation making them.
+	t.Run("Middlewares see the context of the call", func(t *testing.T) {
+
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "test-operation")
+		contexts := make([]context.Context, 0)
+		recorder := func(call Call, next func() (any, *apiutils.APIError)) (
+			any, *apiutils.APIError) {
+
+			contexts = append(contexts, call.Context)
+			return next()
+		}
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{
+			mockTask, chainMiddlewares(WithContext(ctx, recorder, recorder))}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(readResponse, nil)
+
+		_, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, apiErr)
+		assert.Equal(t, []context.Context{ctx, ctx}, contexts)
+	})
+
+	// Tests that the SDK client is only wrapped when middlewares are given.
+	t.Run("Client is wrapped only when middlewares are given", func(t *testing.T) {
+
+		config := sdkconfig.Config{}
+		_, wrapped := NewTaskClient(config).(*taskClient)
+		assert.False(t, wrapped)
+
+		_, wrapped = NewTaskClient(config, NewRetryMiddleware(RetryConfig{})).(*taskClient)
+		assert.True(t, wrapped)
+	})
+}
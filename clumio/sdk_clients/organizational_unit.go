@@ -5,14 +5,84 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	organizationalunits "github.com/clumio-code/clumio-go-sdk/controllers/organizational_units"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type OrganizationalUnitClient interface {
 	organizationalunits.OrganizationalUnitsV2Client
 }
 
-func NewOrganizationalUnitClient(config config.Config) OrganizationalUnitClient {
-	return organizationalunits.NewOrganizationalUnitsV2(config)
+// NewOrganizationalUnitClient returns a OrganizationalUnitClient for the given config. Every call
+// made through the client is passed through the given middlewares, in order.
+func NewOrganizationalUnitClient(
+	config config.Config, middlewares ...Middleware) OrganizationalUnitClient {
+
+	client := organizationalunits.NewOrganizationalUnitsV2(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &organizationalUnitClient{client, chainMiddlewares(middlewares)}
+}
+
+// organizationalUnitClient is a OrganizationalUnitClient which passes every call through a
+// middleware.
+type organizationalUnitClient struct {
+	OrganizationalUnitClient
+	middleware Middleware
+}
+
+func (c *organizationalUnitClient) CreateOrganizationalUnit(
+	embed *string, body *models.CreateOrganizationalUnitV2Request) (
+	*models.CreateOrganizationalUnitResponseWrapper, *apiutils.APIError) {
+
+	call := writeCall("CreateOrganizationalUnit", false, "embed", embed, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateOrganizationalUnitResponseWrapper, *apiutils.APIError) {
+			return c.OrganizationalUnitClient.CreateOrganizationalUnit(embed, body)
+		})
+}
+
+func (c *organizationalUnitClient) DeleteOrganizationalUnit(id string, embed *string) (
+	*models.DeleteOrganizationalUnitResponse, *apiutils.APIError) {
+
+	call := writeCall("DeleteOrganizationalUnit", true, "id", id, "embed", embed)
+	return invoke(c.middleware, call,
+		func() (*models.DeleteOrganizationalUnitResponse, *apiutils.APIError) {
+			return c.OrganizationalUnitClient.DeleteOrganizationalUnit(id, embed)
+		})
+}
+
+func (c *organizationalUnitClient) ListOrganizationalUnits(
+	limit *int64, start *string, filter *string) (
+	*models.ListOrganizationalUnitsResponse, *apiutils.APIError) {
+
+	call := readCall("ListOrganizationalUnits", "limit", limit, "start", start, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListOrganizationalUnitsResponse, *apiutils.APIError) {
+			return c.OrganizationalUnitClient.ListOrganizationalUnits(limit, start, filter)
+		})
+}
+
+func (c *organizationalUnitClient) PatchOrganizationalUnit(
+	id string, embed *string, body *models.PatchOrganizationalUnitV2Request) (
+	*models.PatchOrganizationalUnitResponseWrapper, *apiutils.APIError) {
+
+	call := writeCall("PatchOrganizationalUnit", true, "id", id, "embed", embed, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.PatchOrganizationalUnitResponseWrapper, *apiutils.APIError) {
+			return c.OrganizationalUnitClient.PatchOrganizationalUnit(id, embed, body)
+		})
+}
+
+func (c *organizationalUnitClient) ReadOrganizationalUnit(id string, embed *string) (
+	*models.ReadOrganizationalUnitResponse, *apiutils.APIError) {
+
+	call := readCall("ReadOrganizationalUnit", "id", id, "embed", embed)
+	return invoke(c.middleware, call,
+		func() (*models.ReadOrganizationalUnitResponse, *apiutils.APIError) {
+			return c.OrganizationalUnitClient.ReadOrganizationalUnit(id, embed)
+		})
 }
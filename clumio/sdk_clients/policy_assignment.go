@@ -5,14 +5,40 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	sdkconfig "github.com/clumio-code/clumio-go-sdk/config"
 	sdkpolicyassignments "github.com/clumio-code/clumio-go-sdk/controllers/policy_assignments"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type PolicyAssignmentClient interface {
 	sdkpolicyassignments.PolicyAssignmentsV1Client
 }
 
-func NewPolicyAssignmentClient(config sdkconfig.Config) PolicyAssignmentClient {
-	return sdkpolicyassignments.NewPolicyAssignmentsV1(config)
+// NewPolicyAssignmentClient returns a PolicyAssignmentClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewPolicyAssignmentClient(
+	config sdkconfig.Config, middlewares ...Middleware) PolicyAssignmentClient {
+
+	client := sdkpolicyassignments.NewPolicyAssignmentsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &policyAssignmentClient{client, chainMiddlewares(middlewares)}
+}
+
+// policyAssignmentClient is a PolicyAssignmentClient which passes every call through a middleware.
+type policyAssignmentClient struct {
+	PolicyAssignmentClient
+	middleware Middleware
+}
+
+func (c *policyAssignmentClient) SetPolicyAssignments(body *models.SetPolicyAssignmentsV1Request) (
+	*models.SetAssignmentsResponse, *apiutils.APIError) {
+
+	call := writeCall("SetPolicyAssignments", true, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.SetAssignmentsResponse, *apiutils.APIError) {
+			return c.PolicyAssignmentClient.SetPolicyAssignments(body)
+		})
 }
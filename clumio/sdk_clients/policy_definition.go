@@ -5,14 +5,83 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkPolicyDefinitions "github.com/clumio-code/clumio-go-sdk/controllers/policy_definitions"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type PolicyDefinitionClient interface {
 	sdkPolicyDefinitions.PolicyDefinitionsV1Client
 }
 
-func NewPolicyDefinitionClient(config config.Config) PolicyDefinitionClient {
-	return sdkPolicyDefinitions.NewPolicyDefinitionsV1(config)
+// NewPolicyDefinitionClient returns a PolicyDefinitionClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewPolicyDefinitionClient(
+	config config.Config, middlewares ...Middleware) PolicyDefinitionClient {
+
+	client := sdkPolicyDefinitions.NewPolicyDefinitionsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &policyDefinitionClient{client, chainMiddlewares(middlewares)}
+}
+
+// policyDefinitionClient is a PolicyDefinitionClient which passes every call through a middleware.
+type policyDefinitionClient struct {
+	PolicyDefinitionClient
+	middleware Middleware
+}
+
+func (c *policyDefinitionClient) CreatePolicyDefinition(
+	body *models.CreatePolicyDefinitionV1Request) (
+	*models.CreatePolicyResponse, *apiutils.APIError) {
+
+	call := writeCall("CreatePolicyDefinition", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreatePolicyResponse, *apiutils.APIError) {
+			return c.PolicyDefinitionClient.CreatePolicyDefinition(body)
+		})
+}
+
+func (c *policyDefinitionClient) DeletePolicyDefinition(policyId string) (
+	*models.DeletePolicyResponse, *apiutils.APIError) {
+
+	call := writeCall("DeletePolicyDefinition", true, "policyId", policyId)
+	return invoke(c.middleware, call,
+		func() (*models.DeletePolicyResponse, *apiutils.APIError) {
+			return c.PolicyDefinitionClient.DeletePolicyDefinition(policyId)
+		})
+}
+
+func (c *policyDefinitionClient) ListPolicyDefinitions(filter *string, embed *string) (
+	*models.ListPoliciesResponse, *apiutils.APIError) {
+
+	call := readCall("ListPolicyDefinitions", "filter", filter, "embed", embed)
+	return invoke(c.middleware, call,
+		func() (*models.ListPoliciesResponse, *apiutils.APIError) {
+			return c.PolicyDefinitionClient.ListPolicyDefinitions(filter, embed)
+		})
+}
+
+func (c *policyDefinitionClient) ReadPolicyDefinition(policyId string, embed *string) (
+	*models.ReadPolicyResponse, *apiutils.APIError) {
+
+	call := readCall("ReadPolicyDefinition", "policyId", policyId, "embed", embed)
+	return invoke(c.middleware, call,
+		func() (*models.ReadPolicyResponse, *apiutils.APIError) {
+			return c.PolicyDefinitionClient.ReadPolicyDefinition(policyId, embed)
+		})
+}
+
+func (c *policyDefinitionClient) UpdatePolicyDefinition(
+	policyId string, embed *string, body *models.UpdatePolicyDefinitionV1Request) (
+	*models.UpdatePolicyResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdatePolicyDefinition", true, "policyId", policyId, "embed", embed,
+		"body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdatePolicyResponse, *apiutils.APIError) {
+			return c.PolicyDefinitionClient.UpdatePolicyDefinition(policyId, embed, body)
+		})
 }
@@ -5,14 +5,82 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkPolicyRules "github.com/clumio-code/clumio-go-sdk/controllers/policy_rules"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type PolicyRuleClient interface {
 	sdkPolicyRules.PolicyRulesV1Client
 }
 
-func NewPolicyRuleClient(config config.Config) PolicyRuleClient {
-	return sdkPolicyRules.NewPolicyRulesV1(config)
+// NewPolicyRuleClient returns a PolicyRuleClient for the given config. Every call made through the
+// client is passed through the given middlewares, in order.
+func NewPolicyRuleClient(
+	config config.Config, middlewares ...Middleware) PolicyRuleClient {
+
+	client := sdkPolicyRules.NewPolicyRulesV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &policyRuleClient{client, chainMiddlewares(middlewares)}
+}
+
+// policyRuleClient is a PolicyRuleClient which passes every call through a middleware.
+type policyRuleClient struct {
+	PolicyRuleClient
+	middleware Middleware
+}
+
+func (c *policyRuleClient) CreatePolicyRule(body *models.CreatePolicyRuleV1Request) (
+	*models.CreateRuleResponse, *apiutils.APIError) {
+
+	call := writeCall("CreatePolicyRule", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateRuleResponse, *apiutils.APIError) {
+			return c.PolicyRuleClient.CreatePolicyRule(body)
+		})
+}
+
+func (c *policyRuleClient) DeletePolicyRule(ruleId string) (
+	*models.DeleteRuleResponse, *apiutils.APIError) {
+
+	call := writeCall("DeletePolicyRule", true, "ruleId", ruleId)
+	return invoke(c.middleware, call,
+		func() (*models.DeleteRuleResponse, *apiutils.APIError) {
+			return c.PolicyRuleClient.DeletePolicyRule(ruleId)
+		})
+}
+
+func (c *policyRuleClient) ListPolicyRules(
+	limit *int64, start *string, organizationalUnitId *string, sort *string, filter *string) (
+	*models.ListRulesResponse, *apiutils.APIError) {
+
+	call := readCall("ListPolicyRules", "limit", limit, "start", start,
+		"organizationalUnitId", organizationalUnitId, "sort", sort, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListRulesResponse, *apiutils.APIError) {
+			return c.PolicyRuleClient.ListPolicyRules(limit, start, organizationalUnitId, sort, filter)
+		})
+}
+
+func (c *policyRuleClient) ReadPolicyRule(ruleId string) (
+	*models.ReadRuleResponse, *apiutils.APIError) {
+
+	call := readCall("ReadPolicyRule", "ruleId", ruleId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadRuleResponse, *apiutils.APIError) {
+			return c.PolicyRuleClient.ReadPolicyRule(ruleId)
+		})
+}
+
+func (c *policyRuleClient) UpdatePolicyRule(ruleId string, body *models.UpdatePolicyRuleV1Request) (
+	*models.UpdateRuleResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdatePolicyRule", true, "ruleId", ruleId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateRuleResponse, *apiutils.APIError) {
+			return c.PolicyRuleClient.UpdatePolicyRule(ruleId, body)
+		})
 }
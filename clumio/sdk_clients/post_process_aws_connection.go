@@ -5,14 +5,42 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkPostProcessConn "github.com/clumio-code/clumio-go-sdk/controllers/post_process_aws_connection"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type PostProcessAWSConnectionClient interface {
 	sdkPostProcessConn.PostProcessAwsConnectionV1Client
 }
 
-func NewPostProcessAWSConnectionClient(config config.Config) PostProcessAWSConnectionClient {
-	return sdkPostProcessConn.NewPostProcessAwsConnectionV1(config)
+// NewPostProcessAWSConnectionClient returns a PostProcessAWSConnectionClient for the given config.
+// Every call made through the client is passed through the given middlewares, in order.
+func NewPostProcessAWSConnectionClient(
+	config config.Config, middlewares ...Middleware) PostProcessAWSConnectionClient {
+
+	client := sdkPostProcessConn.NewPostProcessAwsConnectionV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &postProcessAWSConnectionClient{client, chainMiddlewares(middlewares)}
+}
+
+// postProcessAWSConnectionClient is a PostProcessAWSConnectionClient which passes every call
+// through a middleware.
+type postProcessAWSConnectionClient struct {
+	PostProcessAWSConnectionClient
+	middleware Middleware
+}
+
+func (c *postProcessAWSConnectionClient) PostProcessAwsConnection(
+	body *models.PostProcessAwsConnectionV1Request) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("PostProcessAwsConnection", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.PostProcessAWSConnectionClient.PostProcessAwsConnection(body)
+		})
 }
@@ -5,14 +5,40 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkPostProcessKms "github.com/clumio-code/clumio-go-sdk/controllers/post_process_kms"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type PostProcessKMSClient interface {
 	sdkPostProcessKms.PostProcessKmsV1Client
 }
 
-func NewPostProcessKMSClient(config config.Config) PostProcessKMSClient {
-	return sdkPostProcessKms.NewPostProcessKmsV1(config)
+// NewPostProcessKMSClient returns a PostProcessKMSClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewPostProcessKMSClient(
+	config config.Config, middlewares ...Middleware) PostProcessKMSClient {
+
+	client := sdkPostProcessKms.NewPostProcessKmsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &postProcessKMSClient{client, chainMiddlewares(middlewares)}
+}
+
+// postProcessKMSClient is a PostProcessKMSClient which passes every call through a middleware.
+type postProcessKMSClient struct {
+	PostProcessKMSClient
+	middleware Middleware
+}
+
+func (c *postProcessKMSClient) PostProcessKms(body *models.PostProcessKmsV1Request) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("PostProcessKms", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.PostProcessKMSClient.PostProcessKms(body)
+		})
 }
@@ -5,14 +5,104 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkProtectionGroups "github.com/clumio-code/clumio-go-sdk/controllers/protection_groups"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type ProtectionGroupClient interface {
 	sdkProtectionGroups.ProtectionGroupsV1Client
 }
 
-func NewProtectionGroupClient(config config.Config) ProtectionGroupClient {
-	return sdkProtectionGroups.NewProtectionGroupsV1(config)
+// NewProtectionGroupClient returns a ProtectionGroupClient for the given config. Every call made
+// through the client is passed through the given middlewares, in order.
+func NewProtectionGroupClient(
+	config config.Config, middlewares ...Middleware) ProtectionGroupClient {
+
+	client := sdkProtectionGroups.NewProtectionGroupsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &protectionGroupClient{client, chainMiddlewares(middlewares)}
+}
+
+// protectionGroupClient is a ProtectionGroupClient which passes every call through a middleware.
+type protectionGroupClient struct {
+	ProtectionGroupClient
+	middleware Middleware
+}
+
+func (c *protectionGroupClient) AddBucketProtectionGroup(
+	groupId string, body models.AddBucketProtectionGroupV1Request) (
+	*models.AddBucketToProtectionGroupResponse, *apiutils.APIError) {
+
+	call := writeCall("AddBucketProtectionGroup", false, "groupId", groupId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.AddBucketToProtectionGroupResponse, *apiutils.APIError) {
+			return c.ProtectionGroupClient.AddBucketProtectionGroup(groupId, body)
+		})
+}
+
+func (c *protectionGroupClient) CreateProtectionGroup(body models.CreateProtectionGroupV1Request) (
+	*models.CreateProtectionGroupResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateProtectionGroup", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateProtectionGroupResponse, *apiutils.APIError) {
+			return c.ProtectionGroupClient.CreateProtectionGroup(body)
+		})
+}
+
+func (c *protectionGroupClient) DeleteBucketProtectionGroup(groupId string, bucketId string) (
+	*models.DeleteBucketFromProtectionGroupResponse, *apiutils.APIError) {
+
+	call := writeCall("DeleteBucketProtectionGroup", true, "groupId", groupId, "bucketId", bucketId)
+	return invoke(c.middleware, call,
+		func() (*models.DeleteBucketFromProtectionGroupResponse, *apiutils.APIError) {
+			return c.ProtectionGroupClient.DeleteBucketProtectionGroup(groupId, bucketId)
+		})
+}
+
+func (c *protectionGroupClient) DeleteProtectionGroup(groupId string) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("DeleteProtectionGroup", true, "groupId", groupId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.ProtectionGroupClient.DeleteProtectionGroup(groupId)
+		})
+}
+
+func (c *protectionGroupClient) ListProtectionGroups(
+	limit *int64, start *string, filter *string, lookbackDays *int64) (
+	*models.ListProtectionGroupsResponse, *apiutils.APIError) {
+
+	call := readCall("ListProtectionGroups", "limit", limit, "start", start, "filter", filter,
+		"lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ListProtectionGroupsResponse, *apiutils.APIError) {
+			return c.ProtectionGroupClient.ListProtectionGroups(limit, start, filter, lookbackDays)
+		})
+}
+
+func (c *protectionGroupClient) ReadProtectionGroup(groupId string, lookbackDays *int64) (
+	*models.ReadProtectionGroupResponse, *apiutils.APIError) {
+
+	call := readCall("ReadProtectionGroup", "groupId", groupId, "lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ReadProtectionGroupResponse, *apiutils.APIError) {
+			return c.ProtectionGroupClient.ReadProtectionGroup(groupId, lookbackDays)
+		})
+}
+
+func (c *protectionGroupClient) UpdateProtectionGroup(
+	groupId string, body *models.UpdateProtectionGroupV1Request) (
+	*models.UpdateProtectionGroupResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateProtectionGroup", true, "groupId", groupId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateProtectionGroupResponse, *apiutils.APIError) {
+			return c.ProtectionGroupClient.UpdateProtectionGroup(groupId, body)
+		})
 }
@@ -5,14 +5,82 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkProtectionGroupS3Assets "github.com/clumio-code/clumio-go-sdk/controllers/protection_groups_s3_assets"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type ProtectionGroupS3AssetsClient interface {
 	sdkProtectionGroupS3Assets.ProtectionGroupsS3AssetsV1Client
 }
 
-func NewProtectionGroupS3AssetsClient(config config.Config) ProtectionGroupS3AssetsClient {
-	return sdkProtectionGroupS3Assets.NewProtectionGroupsS3AssetsV1(config)
+// NewProtectionGroupS3AssetsClient returns a ProtectionGroupS3AssetsClient for the given config.
+// Every call made through the client is passed through the given middlewares, in order.
+func NewProtectionGroupS3AssetsClient(
+	config config.Config, middlewares ...Middleware) ProtectionGroupS3AssetsClient {
+
+	client := sdkProtectionGroupS3Assets.NewProtectionGroupsS3AssetsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &protectionGroupS3AssetsClient{client, chainMiddlewares(middlewares)}
+}
+
+// protectionGroupS3AssetsClient is a ProtectionGroupS3AssetsClient which passes every call through
+// a middleware.
+type protectionGroupS3AssetsClient struct {
+	ProtectionGroupS3AssetsClient
+	middleware Middleware
+}
+
+func (c *protectionGroupS3AssetsClient) ListProtectionGroupS3AssetPitrIntervals(
+	protectionGroupS3AssetId string, limit *int64, start *string, filter *string) (
+	*models.ListProtectionGroupS3AssetPitrIntervalsResponse, *apiutils.APIError) {
+
+	call := readCall("ListProtectionGroupS3AssetPitrIntervals",
+		"protectionGroupS3AssetId", protectionGroupS3AssetId, "limit", limit, "start", start,
+		"filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListProtectionGroupS3AssetPitrIntervalsResponse, *apiutils.APIError) {
+			return c.ProtectionGroupS3AssetsClient.ListProtectionGroupS3AssetPitrIntervals(protectionGroupS3AssetId, limit, start, filter)
+		})
+}
+
+func (c *protectionGroupS3AssetsClient) ListProtectionGroupS3Assets(
+	limit *int64, start *string, filter *string, lookbackDays *int64) (
+	*models.ListProtectionGroupS3AssetsResponse, *apiutils.APIError) {
+
+	call := readCall("ListProtectionGroupS3Assets", "limit", limit, "start", start,
+		"filter", filter, "lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ListProtectionGroupS3AssetsResponse, *apiutils.APIError) {
+			return c.ProtectionGroupS3AssetsClient.ListProtectionGroupS3Assets(limit, start, filter, lookbackDays)
+		})
+}
+
+func (c *protectionGroupS3AssetsClient) ReadProtectionGroupS3Asset(
+	protectionGroupS3AssetId string, lookbackDays *int64) (
+	*models.ReadProtectionGroupS3AssetResponse, *apiutils.APIError) {
+
+	call := readCall("ReadProtectionGroupS3Asset",
+		"protectionGroupS3AssetId", protectionGroupS3AssetId, "lookbackDays", lookbackDays)
+	return invoke(c.middleware, call,
+		func() (*models.ReadProtectionGroupS3AssetResponse, *apiutils.APIError) {
+			return c.ProtectionGroupS3AssetsClient.ReadProtectionGroupS3Asset(protectionGroupS3AssetId, lookbackDays)
+		})
+}
+
+func (c *protectionGroupS3AssetsClient) ReadProtectionGroupS3AssetContinuousBackupStats(
+	protectionGroupS3AssetId string, bucketName *string, bucketId *string, beginTimestamp string, endTimestamp string, interval *string) (
+	*models.ReadProtectionGroupS3AssetContinuousBackupStatsResponse, *apiutils.APIError) {
+
+	call := readCall("ReadProtectionGroupS3AssetContinuousBackupStats",
+		"protectionGroupS3AssetId", protectionGroupS3AssetId, "bucketName", bucketName,
+		"bucketId", bucketId, "beginTimestamp", beginTimestamp, "endTimestamp", endTimestamp,
+		"interval", interval)
+	return invoke(c.middleware, call,
+		func() (*models.ReadProtectionGroupS3AssetContinuousBackupStatsResponse, *apiutils.APIError) {
+			return c.ProtectionGroupS3AssetsClient.ReadProtectionGroupS3AssetContinuousBackupStats(protectionGroupS3AssetId, bucketName, bucketId, beginTimestamp, endTimestamp, interval)
+		})
 }
@@ -5,14 +5,87 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkReportCompliances "github.com/clumio-code/clumio-go-sdk/controllers/report_compliance"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type ReportConfigurationClient interface {
 	sdkReportCompliances.ReportComplianceV1Client
 }
 
-func NewReportConfigurationClient(config config.Config) ReportConfigurationClient {
-	return sdkReportCompliances.NewReportComplianceV1(config)
+// NewReportConfigurationClient returns a ReportConfigurationClient for the given config. Every call
+// made through the client is passed through the given middlewares, in order.
+func NewReportConfigurationClient(
+	config config.Config, middlewares ...Middleware) ReportConfigurationClient {
+
+	client := sdkReportCompliances.NewReportComplianceV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &reportConfigurationClient{client, chainMiddlewares(middlewares)}
+}
+
+// reportConfigurationClient is a ReportConfigurationClient which passes every call through a
+// middleware.
+type reportConfigurationClient struct {
+	ReportConfigurationClient
+	middleware Middleware
+}
+
+func (c *reportConfigurationClient) CreateComplianceReportConfiguration(
+	body *models.CreateComplianceReportConfigurationV1Request) (
+	*models.CreateComplianceConfigurationResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateComplianceReportConfiguration", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateComplianceConfigurationResponse, *apiutils.APIError) {
+			return c.ReportConfigurationClient.CreateComplianceReportConfiguration(body)
+		})
+}
+
+func (c *reportConfigurationClient) DeleteComplianceReportConfiguration(configurationId string) (
+	interface{}, *apiutils.APIError) {
+
+	call := writeCall("DeleteComplianceReportConfiguration", true,
+		"configurationId", configurationId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.ReportConfigurationClient.DeleteComplianceReportConfiguration(configurationId)
+		})
+}
+
+func (c *reportConfigurationClient) ListComplianceReportConfigurations(
+	limit *int64, start *string, filter *string) (
+	*models.ListComplianceConfigurationsResponse, *apiutils.APIError) {
+
+	call := readCall("ListComplianceReportConfigurations", "limit", limit, "start", start,
+		"filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListComplianceConfigurationsResponse, *apiutils.APIError) {
+			return c.ReportConfigurationClient.ListComplianceReportConfigurations(limit, start, filter)
+		})
+}
+
+func (c *reportConfigurationClient) ReadComplianceReportConfiguration(configurationId string) (
+	*models.ReadComplianceConfigurationResponse, *apiutils.APIError) {
+
+	call := readCall("ReadComplianceReportConfiguration", "configurationId", configurationId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadComplianceConfigurationResponse, *apiutils.APIError) {
+			return c.ReportConfigurationClient.ReadComplianceReportConfiguration(configurationId)
+		})
+}
+
+func (c *reportConfigurationClient) UpdateComplianceReportConfiguration(
+	configurationId string, body *models.UpdateComplianceReportConfigurationV1Request) (
+	*models.UpdateComplianceConfigurationResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateComplianceReportConfiguration", true,
+		"configurationId", configurationId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateComplianceConfigurationResponse, *apiutils.APIError) {
+			return c.ReportConfigurationClient.UpdateComplianceReportConfiguration(configurationId, body)
+		})
 }
@@ -0,0 +1,135 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the middleware which retries Clumio API calls that failed with a throttling or a
+// transient error.
+
+package sdkclients
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed call is retried if not configured.
+	DefaultMaxRetries = 3
+	// DefaultRetryMinBackoff is the backoff before the first retry if not configured.
+	DefaultRetryMinBackoff = 1 * time.Second
+	// DefaultRetryMaxBackoff is the upper bound of the backoff between retries if not configured.
+	DefaultRetryMaxBackoff = 30 * time.Second
+)
+
+var (
+	// sleep waits for the given duration between retries. It returns false without waiting the
+	// full duration if the given context is done first. It is overridden in the unit tests.
+	sleep = func(ctx context.Context, d time.Duration) bool {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	// jitter returns a random duration in [0, d). It is overridden in the unit tests.
+	jitter = func(d time.Duration) time.Duration {
+		if d <= 0 {
+			return 0
+		}
+		return time.Duration(rand.Int63n(int64(d)))
+	}
+)
+
+// RetryConfig holds the settings of the retry middleware.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of times a failed call is retried.
+	MaxRetries int
+	// MinBackoff is the backoff before the first retry. It doubles with every retry.
+	MinBackoff time.Duration
+	// MaxBackoff is the upper bound of the backoff between retries.
+	MaxBackoff time.Duration
+}
+
+// NewRetryMiddleware returns a Middleware which retries calls that failed with a throttling
+// (429) or a transient (5xx, connection reset, timeout) error, waiting a jittered exponential
+// backoff between attempts. Calls which are not idempotent are only retried when they were
+// throttled, as the API rejects throttled requests before processing them, and calls which timed
+// out are only retried if they do not modify any state. The SDK does not expose
+// the response headers, so the Retry-After header of a throttled response cannot be honored and
+// the backoff is always bounded by MaxBackoff. A call stops being retried once its context is done.
+func NewRetryMiddleware(retryConfig RetryConfig) Middleware {
+	return func(call Call, next func() (any, *apiutils.APIError)) (any, *apiutils.APIError) {
+		ctx := call.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		res, apiErr := next()
+		for attempt := 0; attempt < retryConfig.MaxRetries && apiErr != nil; attempt++ {
+			if !isRetryable(call, apiErr) {
+				break
+			}
+			backoff := retryBackoff(retryConfig, attempt)
+			tflog.Warn(ctx, "Retrying Clumio API call", map[string]any{
+				"operation":     call.Operation,
+				"response_code": apiErr.ResponseCode,
+				"attempt":       attempt + 1,
+				"backoff":       backoff.String(),
+			})
+			if !sleep(ctx, backoff) {
+				tflog.Warn(ctx, "Canceled retrying Clumio API call", map[string]any{
+					"operation": call.Operation,
+					"error":     ctx.Err().Error(),
+				})
+				break
+			}
+			res, apiErr = next()
+		}
+		return res, apiErr
+	}
+}
+
+// isRetryable returns true if the call which failed with the given error can be retried.
+func isRetryable(call Call, apiErr *apiutils.APIError) bool {
+	if apiErr.ResponseCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !call.Idempotent {
+		return false
+	}
+	switch apiErr.ResponseCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	// A call which timed out may still be in flight, so only calls which do not modify any state
+	// are repeated.
+	return isConnectionReset(apiErr) || (call.ReadOnly && isRequestTimeout(apiErr))
+}
+
+// isConnectionReset returns true if the error was caused by the connection being reset before a
+// response was received.
+func isConnectionReset(apiErr *apiutils.APIError) bool {
+	message := strings.ToLower(apiErr.Reason + " " + string(apiErr.Response))
+	return strings.Contains(message, "connection reset")
+}
+
+// retryBackoff returns the duration to wait before the given retry attempt. The backoff starts at
+// MinBackoff and doubles with every attempt up to MaxBackoff, with jitter applied to its upper
+// half.
+func retryBackoff(retryConfig RetryConfig, attempt int) time.Duration {
+	backoff := retryConfig.MinBackoff
+	for i := 0; i < attempt && backoff < retryConfig.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > retryConfig.MaxBackoff {
+		backoff = retryConfig.MaxBackoff
+	}
+	return backoff/2 + jitter(backoff/2+1)
+}
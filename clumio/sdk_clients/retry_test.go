@@ -0,0 +1,223 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the retry middleware.
+
+//go:build unit
+
+package sdkclients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupRetryTest overrides the sleep and jitter functions so that the tests neither wait nor
+// depend on randomness. It returns the backoffs which were slept. Sleeping is canceled as usual if
+// the context of the call is done.
+func setupRetryTest(t *testing.T) *[]time.Duration {
+
+	backoffs := make([]time.Duration, 0)
+	origSleep, origJitter := sleep, jitter
+	sleep = func(ctx context.Context, d time.Duration) bool {
+		backoffs = append(backoffs, d)
+		return ctx.Err() == nil
+	}
+	jitter = func(d time.Duration) time.Duration { return 0 }
+	t.Cleanup(func() {
+		sleep, jitter = origSleep, origJitter
+	})
+	return &backoffs
+}
+
+// Unit test for the following cases:
+//   - Read call is retried on a transient error until it succeeds.
+//   - Read call is retried at most MaxRetries times.
+//   - Non-idempotent call is not retried on a transient error.
+//   - Non-idempotent call is retried when it was throttled.
+//   - Call is not retried on a client error.
+//   - Call is retried when the connection was reset.
+//   - Call is not retried once its context is done.
+func TestRetryMiddleware(t *testing.T) {
+
+	retryConfig := RetryConfig{
+		MaxRetries: 3,
+		MinBackoff: time.Second,
+		MaxBackoff: 3 * time.Second,
+	}
+	taskId := "test-task-id"
+	status := "completed"
+	readResponse := &models.ReadTaskResponse{Status: &status}
+	unavailableError := &apiutils.APIError{
+		ResponseCode: 503,
+		Reason:       "test",
+		Response:     []byte("Service Unavailable"),
+	}
+	throttledError := &apiutils.APIError{
+		ResponseCode: 429,
+		Reason:       "test",
+		Response:     []byte("Too Many Requests"),
+	}
+
+	// Tests that a read call is retried with an exponential backoff until it succeeds.
+	t.Run("Read call is retried until it succeeds", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewRetryMiddleware(retryConfig)}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(2).Return(nil, unavailableError)
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(readResponse, nil)
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, apiErr)
+		assert.Equal(t, readResponse, res)
+		assert.Equal(t, []time.Duration{500 * time.Millisecond, time.Second}, *backoffs)
+	})
+
+	// Tests that the error is returned once the call was retried MaxRetries times and that the
+	// backoff does not exceed MaxBackoff.
+	t.Run("Read call is retried at most MaxRetries times", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewRetryMiddleware(retryConfig)}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(4).Return(nil, unavailableError)
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Equal(t, unavailableError, apiErr)
+		assert.Nil(t, res)
+		assert.Equal(t, []time.Duration{
+			500 * time.Millisecond, time.Second, 1500 * time.Millisecond}, *backoffs)
+	})
+
+	// Tests that a non-idempotent call is not retried on a transient error as it may already have
+	// been processed.
+	t.Run("Non-idempotent call is not retried on transient error", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		mockWallet := NewMockWalletClient(t)
+		client := &walletClient{mockWallet, NewRetryMiddleware(retryConfig)}
+
+		// Setup Expectations
+		mockWallet.EXPECT().CreateWallet(mock.Anything).Times(1).Return(nil, unavailableError)
+
+		res, apiErr := client.CreateWallet(&models.CreateWalletV1Request{})
+		assert.Equal(t, unavailableError, apiErr)
+		assert.Nil(t, res)
+		assert.Empty(t, *backoffs)
+	})
+
+	// Tests that a non-idempotent call is retried when it was throttled as the API rejects
+	// throttled requests before processing them.
+	t.Run("Non-idempotent call is retried when throttled", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		mockWallet := NewMockWalletClient(t)
+		client := &walletClient{mockWallet, NewRetryMiddleware(retryConfig)}
+		createResponse := &models.CreateWalletResponse{}
+
+		// Setup Expectations
+		mockWallet.EXPECT().CreateWallet(mock.Anything).Times(1).Return(nil, throttledError)
+		mockWallet.EXPECT().CreateWallet(mock.Anything).Times(1).Return(createResponse, nil)
+
+		res, apiErr := client.CreateWallet(&models.CreateWalletV1Request{})
+		assert.Nil(t, apiErr)
+		assert.Equal(t, createResponse, res)
+		assert.Len(t, *backoffs, 1)
+	})
+
+	// Tests that a call which failed with a client error is not retried.
+	t.Run("Call is not retried on client error", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewRetryMiddleware(retryConfig)}
+		apiErr := &apiutils.APIError{
+			ResponseCode: 404,
+			Reason:       "test",
+			Response:     []byte("Not Found"),
+		}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(nil, apiErr)
+
+		_, err := client.ReadTask(taskId)
+		assert.Equal(t, apiErr, err)
+		assert.Empty(t, *backoffs)
+	})
+
+	// Tests that a read call is retried when the connection was reset.
+	t.Run("Call is retried on connection reset", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{mockTask, NewRetryMiddleware(retryConfig)}
+		apiErr := &apiutils.APIError{
+			Reason: "read tcp 10.0.0.1:443: read: connection reset by peer",
+		}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(nil, apiErr)
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(readResponse, nil)
+
+		res, err := client.ReadTask(taskId)
+		assert.Nil(t, err)
+		assert.Equal(t, readResponse, res)
+		assert.Len(t, *backoffs, 1)
+	})
+
+	// Tests that a call is not retried once the context of the Terraform operation making it is
+	// canceled, so that the operation is not held up by the backoff.
+	t.Run("Call is not retried once its context is done", func(t *testing.T) {
+
+		backoffs := setupRetryTest(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{
+			mockTask, chainMiddlewares(WithContext(ctx, NewRetryMiddleware(retryConfig)))}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(nil, unavailableError)
+
+		res, apiErr := client.ReadTask(taskId)
+		assert.Equal(t, unavailableError, apiErr)
+		assert.Nil(t, res)
+		assert.Len(t, *backoffs, 1)
+	})
+}
+
+// Unit test for the following cases:
+//   - Backoff doubles with every attempt.
+//   - Backoff is bounded by MaxBackoff, including its jitter.
+func TestRetryBackoff(t *testing.T) {
+
+	setupRetryTest(t)
+	retryConfig := RetryConfig{
+		MaxRetries: 10,
+		MinBackoff: time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+
+	// Tests that the backoff starts at MinBackoff and doubles with every attempt.
+	t.Run("Backoff doubles with every attempt", func(t *testing.T) {
+		assert.Equal(t, 500*time.Millisecond, retryBackoff(retryConfig, 0))
+		assert.Equal(t, time.Second, retryBackoff(retryConfig, 1))
+		assert.Equal(t, 4*time.Second, retryBackoff(retryConfig, 3))
+	})
+
+	// Tests that the backoff with the largest jitter does not exceed MaxBackoff.
+	t.Run("Backoff is bounded by MaxBackoff", func(t *testing.T) {
+		jitter = func(d time.Duration) time.Duration { return d - 1 }
+		assert.Equal(t, 30*time.Second, retryBackoff(retryConfig, 9))
+	})
+}
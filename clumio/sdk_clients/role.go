@@ -5,14 +5,53 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkRoles "github.com/clumio-code/clumio-go-sdk/controllers/roles"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type RoleClient interface {
 	sdkRoles.RolesV1Client
 }
 
-func NewRoleClient(config config.Config) RoleClient {
-	return sdkRoles.NewRolesV1(config)
+// NewRoleClient returns a RoleClient for the given config. Every call made through the client is
+// passed through the given middlewares, in order.
+func NewRoleClient(
+	config config.Config, middlewares ...Middleware) RoleClient {
+
+	client := sdkRoles.NewRolesV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &roleClient{client, chainMiddlewares(middlewares)}
+}
+
+// roleClient is a RoleClient which passes every call through a middleware.
+type roleClient struct {
+	RoleClient
+	middleware Middleware
+}
+
+func (c *roleClient) ListPermissions() (*models.ListPermissionsResponse, *apiutils.APIError) {
+	return invoke(c.middleware, readCall("ListPermissions"),
+		func() (*models.ListPermissionsResponse, *apiutils.APIError) {
+			return c.RoleClient.ListPermissions()
+		})
+}
+
+func (c *roleClient) ListRoles(filter *string) (*models.ListRolesResponse, *apiutils.APIError) {
+	call := readCall("ListRoles", "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListRolesResponse, *apiutils.APIError) {
+			return c.RoleClient.ListRoles(filter)
+		})
+}
+
+func (c *roleClient) ReadRole(roleId string) (*models.ReadRoleResponse, *apiutils.APIError) {
+	call := readCall("ReadRole", "roleId", roleId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadRoleResponse, *apiutils.APIError) {
+			return c.RoleClient.ReadRole(roleId)
+		})
 }
@@ -5,14 +5,50 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkS3Buckets "github.com/clumio-code/clumio-go-sdk/controllers/aws_s3_buckets"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type S3BucketClient interface {
 	sdkS3Buckets.AwsS3BucketsV1Client
 }
 
-func NewS3BucketClient(config config.Config) S3BucketClient {
-	return sdkS3Buckets.NewAwsS3BucketsV1(config)
+// NewS3BucketClient returns a S3BucketClient for the given config. Every call made through the
+// client is passed through the given middlewares, in order.
+func NewS3BucketClient(
+	config config.Config, middlewares ...Middleware) S3BucketClient {
+
+	client := sdkS3Buckets.NewAwsS3BucketsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &s3BucketClient{client, chainMiddlewares(middlewares)}
+}
+
+// s3BucketClient is a S3BucketClient which passes every call through a middleware.
+type s3BucketClient struct {
+	S3BucketClient
+	middleware Middleware
+}
+
+func (c *s3BucketClient) ListAwsS3Buckets(limit *int64, start *string, filter *string) (
+	*models.ListBucketsResponse, *apiutils.APIError) {
+
+	call := readCall("ListAwsS3Buckets", "limit", limit, "start", start, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListBucketsResponse, *apiutils.APIError) {
+			return c.S3BucketClient.ListAwsS3Buckets(limit, start, filter)
+		})
+}
+
+func (c *s3BucketClient) ReadAwsS3Bucket(bucketId string) (
+	*models.ReadBucketResponse, *apiutils.APIError) {
+
+	call := readCall("ReadAwsS3Bucket", "bucketId", bucketId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadBucketResponse, *apiutils.APIError) {
+			return c.S3BucketClient.ReadAwsS3Bucket(bucketId)
+		})
 }
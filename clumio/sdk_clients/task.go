@@ -5,14 +5,58 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	sdkTasks "github.com/clumio-code/clumio-go-sdk/controllers/tasks"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type TaskClient interface {
 	sdkTasks.TasksV1Client
 }
 
-func NewTaskClient(config config.Config) TaskClient {
-	return sdkTasks.NewTasksV1(config)
+// NewTaskClient returns a TaskClient for the given config. Every call made through the client is
+// passed through the given middlewares, in order.
+func NewTaskClient(
+	config config.Config, middlewares ...Middleware) TaskClient {
+
+	client := sdkTasks.NewTasksV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &taskClient{client, chainMiddlewares(middlewares)}
+}
+
+// taskClient is a TaskClient which passes every call through a middleware.
+type taskClient struct {
+	TaskClient
+	middleware Middleware
+}
+
+func (c *taskClient) ListTasks(limit *int64, start *string, filter *string) (
+	*models.ListTasksResponse, *apiutils.APIError) {
+
+	call := readCall("ListTasks", "limit", limit, "start", start, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListTasksResponse, *apiutils.APIError) {
+			return c.TaskClient.ListTasks(limit, start, filter)
+		})
+}
+
+func (c *taskClient) ReadTask(taskId string) (*models.ReadTaskResponse, *apiutils.APIError) {
+	call := readCall("ReadTask", "taskId", taskId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadTaskResponse, *apiutils.APIError) {
+			return c.TaskClient.ReadTask(taskId)
+		})
+}
+
+func (c *taskClient) UpdateTask(taskId string, body *models.UpdateTaskV1Request) (
+	*models.UpdateTaskResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateTask", true, "taskId", taskId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateTaskResponse, *apiutils.APIError) {
+			return c.TaskClient.UpdateTask(taskId, body)
+		})
 }
@@ -0,0 +1,167 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the middleware which traces Clumio API calls to a tflog subsystem.
+
+package sdkclients
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// TracingSubsystem is the tflog subsystem to which the Clumio API calls are traced.
+	TracingSubsystem = "clumio_http"
+	// tracingLevelEnv is the environment variable setting the level of the tracing subsystem. If
+	// not set, the subsystem logs at the level of the provider.
+	tracingLevelEnv = "TF_LOG_PROVIDER_CLUMIO_HTTP"
+
+	// bodyParam is the name of the parameter holding the request body of a call.
+	bodyParam = "body"
+	// redactedValue replaces the values of the redacted fields.
+	redactedValue = "***"
+)
+
+// now is the function used to measure the latency of a call. It is overridden in the unit tests.
+var now = time.Now
+
+// NewTracingMiddleware returns a Middleware which traces every call to the clumio_http tflog
+// subsystem of the context of the call, so that its entries carry the fields of the Terraform
+// operation making it. The operation, parameters, status and latency of a call are logged at DEBUG
+// and the request and response bodies at TRACE. The SDK does not expose the HTTP exchange, so the
+// method, path and request ID of the API are not logged. Instead, every call is given a call_id
+// generated by the provider to correlate its log entries. Authorization headers, token fields and
+// the given API token are redacted.
+func NewTracingMiddleware(token string) Middleware {
+	var options tflog.Options
+	if os.Getenv(tracingLevelEnv) != "" {
+		options = append(options, tflog.WithLevelFromEnv(tracingLevelEnv))
+	}
+
+	return func(call Call, next func() (any, *apiutils.APIError)) (any, *apiutils.APIError) {
+		ctx := call.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		ctx = tflog.NewSubsystem(ctx, TracingSubsystem, options...)
+		ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(
+			ctx, TracingSubsystem, "authorization", "Authorization", "token")
+		if token != "" {
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, TracingSubsystem, token)
+			ctx = tflog.SubsystemMaskMessageStrings(ctx, TracingSubsystem, token)
+		}
+
+		fields := map[string]any{
+			"call_id":   uuid.NewString(),
+			"operation": call.Operation,
+		}
+		for name, value := range call.Params {
+			if name != bodyParam {
+				fields[name] = derefValue(value)
+			}
+		}
+		if body, ok := call.Params[bodyParam]; ok && !isNil(body) {
+			tflog.SubsystemTrace(ctx, TracingSubsystem, "Clumio API request", fields,
+				map[string]any{"request_body": redactedJSON(body)})
+		}
+
+		start := now()
+		res, apiErr := next()
+		fields["latency_ms"] = now().Sub(start).Milliseconds()
+		fields["success"] = apiErr == nil
+		if apiErr != nil {
+			fields["status"] = apiErr.ResponseCode
+			if apiErr.ResponseCode == 0 {
+				fields["error"] = apiErr.Reason
+			}
+		}
+		tflog.SubsystemDebug(ctx, TracingSubsystem, "Clumio API call", fields)
+
+		if apiErr != nil && len(apiErr.Response) > 0 {
+			tflog.SubsystemTrace(ctx, TracingSubsystem, "Clumio API response", fields,
+				map[string]any{"response_body": redactedJSONBytes(apiErr.Response)})
+		} else if apiErr == nil && !isNil(res) {
+			tflog.SubsystemTrace(ctx, TracingSubsystem, "Clumio API response", fields,
+				map[string]any{"response_body": redactedJSON(res)})
+		}
+		return res, apiErr
+	}
+}
+
+// isNil returns whether the given value is nil or a nil pointer.
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+// derefValue returns the value the given pointer points to, or nil for a nil pointer, so that
+// parameters such as filters are logged by value.
+func derefValue(value any) any {
+	if isNil(value) {
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Pointer {
+		return v.Elem().Interface()
+	}
+	return value
+}
+
+// redactedJSON returns the JSON encoding of the given value with the token fields redacted.
+func redactedJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return redactedJSONBytes(data)
+}
+
+// redactedJSONBytes returns the given JSON with the token fields redacted. Data which is not JSON
+// is returned as is.
+func redactedJSONBytes(data []byte) string {
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return string(data)
+	}
+	redacted, err := json.Marshal(redactFields(decoded))
+	if err != nil {
+		return string(data)
+	}
+	return string(redacted)
+}
+
+// redactFields replaces the values of the fields holding credentials in the given decoded JSON.
+func redactFields(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isRedactedField(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactFields(field)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactFields(item)
+		}
+	}
+	return value
+}
+
+// isRedactedField returns whether the field with the given name holds credentials.
+func isRedactedField(name string) bool {
+	name = strings.ToLower(name)
+	return name == "authorization" || name == "token" || strings.HasSuffix(name, "_token")
+}
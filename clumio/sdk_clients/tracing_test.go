@@ -0,0 +1,172 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the tracing middleware.
+
+//go:build unit
+
+package sdkclients
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
+	"github.com/clumio-code/clumio-go-sdk/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// setupTracingTest returns a context whose log entries are written to the returned buffer and
+// makes every call take one second.
+func setupTracingTest(t *testing.T) (context.Context, *bytes.Buffer) {
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	origNow := now
+	start := time.Now()
+	calls := 0
+	now = func() time.Time {
+		calls++
+		return start.Add(time.Duration(calls) * time.Second)
+	}
+	t.Cleanup(func() {
+		now = origNow
+	})
+	return ctx, &output
+}
+
+// tracedEntries decodes the log entries of the tracing subsystem written to the given buffer.
+func tracedEntries(t *testing.T, output *bytes.Buffer) []map[string]any {
+
+	entries, err := tflogtest.MultilineJSONDecode(output)
+	assert.Nil(t, err)
+	traced := make([]map[string]any, 0)
+	for _, entry := range entries {
+		if entry["@module"] == "provider."+TracingSubsystem {
+			traced = append(traced, entry)
+		}
+	}
+	return traced
+}
+
+// Unit test for the following cases:
+//   - Successful call is traced with its parameters, latency and response body.
+//   - Call is traced with the fields of the context it is made in.
+//   - Failed call is traced with its status and response body.
+//   - Token fields and the API token are redacted.
+func TestTracingMiddleware(t *testing.T) {
+
+	taskId := "test-task-id"
+	status := "completed"
+	token := "test-secret-token"
+
+	// Tests that a successful call is logged at DEBUG with its parameters and latency and that the
+	// response body is logged at TRACE.
+	t.Run("Successful call is traced", func(t *testing.T) {
+		ctx, output := setupTracingTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{
+			mockTask, chainMiddlewares(WithContext(ctx, NewTracingMiddleware(token)))}
+		readResponse := &models.ReadTaskResponse{Status: &status}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(readResponse, nil)
+
+		_, apiErr := client.ReadTask(taskId)
+		assert.Nil(t, apiErr)
+
+		entries := tracedEntries(t, output)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, "Clumio API call", entries[0]["@message"])
+		assert.Equal(t, "debug", entries[0]["@level"])
+		assert.Equal(t, "ReadTask", entries[0]["operation"])
+		assert.Equal(t, taskId, entries[0]["taskId"])
+		assert.Equal(t, float64(1000), entries[0]["latency_ms"])
+		assert.Equal(t, true, entries[0]["success"])
+		assert.NotEmpty(t, entries[0]["call_id"])
+		assert.Equal(t, "trace", entries[1]["@level"])
+		assert.Contains(t, entries[1]["response_body"], status)
+		assert.Equal(t, entries[0]["call_id"], entries[1]["call_id"])
+	})
+
+	// Tests that a call is traced with the fields of the context of the Terraform operation making
+	// it rather than those of the context the middleware was created in.
+	t.Run("Call is traced in its context", func(t *testing.T) {
+		ctx, output := setupTracingTest(t)
+		mockTask := NewMockTaskClient(t)
+		tracing := NewTracingMiddleware(token)
+		readResponse := &models.ReadTaskResponse{Status: &status}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(2).Return(readResponse, nil)
+
+		for _, reqId := range []string{"test-req-1", "test-req-2"} {
+			callCtx := tflog.SetField(ctx, "tf_req_id", reqId)
+			client := &taskClient{mockTask, chainMiddlewares(WithContext(callCtx, tracing))}
+			_, apiErr := client.ReadTask(taskId)
+			assert.Nil(t, apiErr)
+		}
+
+		entries := tracedEntries(t, output)
+		assert.Len(t, entries, 4)
+		assert.Equal(t, "test-req-1", entries[0]["tf_req_id"])
+		assert.Equal(t, "test-req-2", entries[2]["tf_req_id"])
+	})
+
+	// Tests that the status and response body of a failed call are traced.
+	t.Run("Failed call is traced", func(t *testing.T) {
+		ctx, output := setupTracingTest(t)
+		mockTask := NewMockTaskClient(t)
+		client := &taskClient{
+			mockTask, chainMiddlewares(WithContext(ctx, NewTracingMiddleware(token)))}
+		apiErr := &apiutils.APIError{
+			ResponseCode: 404,
+			Reason:       "test",
+			Response:     []byte(`{"message": "task not found"}`),
+		}
+
+		// Setup Expectations
+		mockTask.EXPECT().ReadTask(taskId).Times(1).Return(nil, apiErr)
+
+		_, err := client.ReadTask(taskId)
+		assert.Equal(t, apiErr, err)
+
+		entries := tracedEntries(t, output)
+		assert.Len(t, entries, 2)
+		assert.Equal(t, float64(404), entries[0]["status"])
+		assert.Equal(t, false, entries[0]["success"])
+		assert.Contains(t, entries[1]["response_body"], "task not found")
+	})
+
+	// Tests that token fields of the bodies and the API token itself are redacted.
+	t.Run("Tokens are redacted", func(t *testing.T) {
+		ctx, output := setupTracingTest(t)
+		mockPostProcess := NewMockPostProcessAWSConnectionClient(t)
+		client := &postProcessAWSConnectionClient{
+			mockPostProcess, chainMiddlewares(WithContext(ctx, NewTracingMiddleware(token)))}
+		apiErr := &apiutils.APIError{
+			ResponseCode: 400,
+			Reason:       "test",
+			Response:     []byte(`{"errors": [{"token": "other-token", "message": "invalid"}]}`),
+		}
+
+		// Setup Expectations
+		mockPostProcess.EXPECT().PostProcessAwsConnection(mock.Anything).Times(1).Return(
+			nil, apiErr)
+
+		_, err := client.PostProcessAwsConnection(&models.PostProcessAwsConnectionV1Request{
+			Token: &token,
+		})
+		assert.Equal(t, apiErr, err)
+
+		logs := output.String()
+		assert.False(t, strings.Contains(logs, token))
+		assert.False(t, strings.Contains(logs, "other-token"))
+		assert.Contains(t, logs, redactedValue)
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright 2025. Clumio, Inc.
+
+// Contains the settings of the HTTP transport through which the SDK clients reach the Clumio API
+// and the local proxy applying them.
+
+package sdkclients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+)
+
+// TransportConfig holds the settings of the HTTP transport through which the SDK clients reach the
+// Clumio API.
+type TransportConfig struct {
+	// HTTPSProxy is the URL of the proxy through which the Clumio API is reached. If not set, the
+	// standard HTTPS_PROXY and NO_PROXY environment variables are honored.
+	HTTPSProxy string
+	// CABundleFile is the path of a PEM file holding certificate authorities to trust in addition
+	// to those of the operating system.
+	CABundleFile string
+	// InsecureSkipVerify disables the verification of the certificate of the Clumio API.
+	InsecureSkipVerify bool
+}
+
+// IsSet returns whether any of the settings is given.
+func (c TransportConfig) IsSet() bool {
+	return c != TransportConfig{}
+}
+
+// transportProxyKey identifies a running transport proxy.
+type transportProxyKey struct {
+	baseUrl         string
+	transportConfig TransportConfig
+}
+
+var (
+	// transportProxies holds the base URL of the running transport proxies. The provider is
+	// configured again for every Terraform operation, so the proxies are reused rather than
+	// started again.
+	transportProxies     = make(map[transportProxyKey]string)
+	transportProxiesLock sync.Mutex
+)
+
+// StartTransportProxy returns the base URL through which the SDK clients reach the Clumio API at
+// the given base URL using the given transport settings. The SDK creates its HTTP clients
+// internally and does not accept a transport, so the calls are sent to a reverse proxy listening
+// on the loopback interface, which forwards them to the Clumio API through a transport applying
+// the settings. The proxy runs until the process exits and is shared by all the clients created
+// with the returned base URL.
+func StartTransportProxy(baseUrl string, transportConfig TransportConfig) (string, error) {
+
+	transportProxiesLock.Lock()
+	defer transportProxiesLock.Unlock()
+	key := transportProxyKey{baseUrl: baseUrl, transportConfig: transportConfig}
+	if proxyUrl, ok := transportProxies[key]; ok {
+		return proxyUrl, nil
+	}
+
+	target, err := url.Parse(baseUrl)
+	if err != nil || target.Host == "" {
+		return "", fmt.Errorf("invalid API base URL %q", baseUrl)
+	}
+	transport, err := newTransport(transportConfig)
+	if err != nil {
+		return "", err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("unable to start the transport proxy: %w", err)
+	}
+	server := &http.Server{
+		Handler:           newReverseProxy(target, transport),
+		ReadHeaderTimeout: time.Minute,
+	}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	proxyUrl := "http://" + listener.Addr().String()
+	transportProxies[key] = proxyUrl
+	return proxyUrl, nil
+}
+
+// newTransport returns the HTTP transport applying the given settings.
+func newTransport(transportConfig TransportConfig) (*http.Transport, error) {
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if transportConfig.HTTPSProxy != "" {
+		proxyUrl, err := url.Parse(transportConfig.HTTPSProxy)
+		if err != nil || proxyUrl.Host == "" ||
+			(proxyUrl.Scheme != "http" && proxyUrl.Scheme != "https") {
+			return nil, fmt.Errorf("invalid proxy URL %q, expected http://host:port or"+
+				" https://host:port", transportConfig.HTTPSProxy)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: transportConfig.InsecureSkipVerify,
+	}
+	if transportConfig.CABundleFile != "" {
+		pem, err := os.ReadFile(transportConfig.CABundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM encoded certificates found in %s",
+				transportConfig.CABundleFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
+
+// newReverseProxy returns the handler forwarding the requests it receives to the given target
+// through the given transport. A request which cannot be forwarded fails with the error envelope
+// of the Clumio API, so that the reason is reported like any other error of the API.
+func newReverseProxy(target *url.URL, transport http.RoundTripper) http.Handler {
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+		},
+		Transport: transport,
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadGateway)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"errors": []any{map[string]any{
+					"error_code":    http.StatusBadGateway,
+					"error_message": fmt.Sprintf("Unable to reach %s: %v", target, err),
+				}},
+			})
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2025. Clumio, Inc.
+
+// This file contains the unit tests for the transport proxy.
+
+//go:build unit
+
+package sdkclients
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCACertificate is a self-signed certificate authority used to test the loading of CA bundles.
+const testCACertificate = `-----BEGIN CERTIFICATE-----
+MIIBiTCCAS+gAwIBAgIURdWy/DQPmcWpFgDbrljcUQP0sWMwCgYIKoZIzj0EAwIw
+GTEXMBUGA1UECgwOQ2x1bWlvIFRlc3QgQ0EwIBcNMjYxMDE4MDAzMDA0WhgPMjEy
+NjA5MjQwMDMwMDRaMBkxFzAVBgNVBAoMDkNsdW1pbyBUZXN0IENBMFkwEwYHKoZI
+zj0CAQYIKoZIzj0DAQcDQgAEiY3vYLPDNLI1l3tsDmP6Jppa0SNd6thIi9DiD1fv
+mbhb6NdngD9zUp60PIB3L84z8CVVKZ4D0OYdT3nSYYAukKNTMFEwHQYDVR0OBBYE
+FCASocWGASgdD1DigFnuyoSnCuR9MB8GA1UdIwQYMBaAFCASocWGASgdD1DigFnu
+yoSnCuR9MA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDSAAwRQIgSlbj63ed
+VX/RpHH/Dr7IYdIyywd6mR3V/CXvy/3v2yoCIQCSAehL5khhBJZvObNbsBtbzVfa
+pF3OfgZeijcmxnFwoQ==
+-----END CERTIFICATE-----
+`
+
+// Unit test for the following cases:
+//   - Transport uses the given proxy.
+//   - Transport trusts the certificate authorities of the given CA bundle.
+//   - Transport does not verify the certificate when InsecureSkipVerify is set.
+//   - Proxy URL or CA bundle which is not valid returns an error.
+func TestNewTransport(t *testing.T) {
+
+	// Tests that the requests are sent through the given proxy.
+	t.Run("Proxy is used", func(t *testing.T) {
+		transport, err := newTransport(TransportConfig{HTTPSProxy: "http://proxy.example.com:3128"})
+		assert.Nil(t, err)
+
+		request, _ := http.NewRequest(http.MethodGet, "https://us-west-2.api.clumio.com", nil)
+		proxyUrl, err := transport.Proxy(request)
+		assert.Nil(t, err)
+		assert.Equal(t, "http://proxy.example.com:3128", proxyUrl.String())
+	})
+
+	// Tests that the certificate authorities of the CA bundle are added to the trusted ones.
+	t.Run("CA bundle is trusted", func(t *testing.T) {
+		caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+		assert.Nil(t, os.WriteFile(caBundleFile, []byte(testCACertificate), 0600))
+
+		transport, err := newTransport(TransportConfig{CABundleFile: caBundleFile})
+		assert.Nil(t, err)
+		assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+		assert.False(t, transport.TLSClientConfig.InsecureSkipVerify)
+	})
+
+	// Tests that a server with a self-signed certificate is reached when the verification of the
+	// certificate is disabled, and not otherwise.
+	t.Run("Certificate verification is skipped", func(t *testing.T) {
+		server := httptest.NewTLSServer(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+		defer server.Close()
+
+		transport, err := newTransport(TransportConfig{InsecureSkipVerify: true})
+		assert.Nil(t, err)
+		response, err := (&http.Client{Transport: transport}).Get(server.URL)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		_ = response.Body.Close()
+
+		transport, err = newTransport(TransportConfig{})
+		assert.Nil(t, err)
+		_, err = (&http.Client{Transport: transport}).Get(server.URL)
+		assert.NotNil(t, err)
+	})
+
+	// Tests that invalid settings return an error.
+	t.Run("Invalid settings", func(t *testing.T) {
+		_, err := newTransport(TransportConfig{HTTPSProxy: "proxy.example.com:3128"})
+		assert.NotNil(t, err)
+
+		_, err = newTransport(
+			TransportConfig{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")})
+		assert.NotNil(t, err)
+
+		caBundleFile := filepath.Join(t.TempDir(), "ca.pem")
+		assert.Nil(t, os.WriteFile(caBundleFile, []byte("not a certificate"), 0600))
+		_, err = newTransport(TransportConfig{CABundleFile: caBundleFile})
+		assert.NotNil(t, err)
+	})
+}
+
+// Unit test for the following cases:
+//   - Requests are forwarded to the Clumio API and the proxy is reused.
+//   - Request which cannot be forwarded fails with the error envelope of the Clumio API.
+func TestStartTransportProxy(t *testing.T) {
+
+	// Tests that the requests received by the proxy are forwarded to the base URL with their path,
+	// query and headers, and that the proxy is started only once for the same settings.
+	t.Run("Requests are forwarded", func(t *testing.T) {
+		server := httptest.NewTLSServer(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/organizational-units", r.URL.Path)
+				assert.Equal(t, "limit=1", r.URL.RawQuery)
+				assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+				_, _ = io.WriteString(w, `{"total_count":0}`)
+			}))
+		defer server.Close()
+
+		transportConfig := TransportConfig{InsecureSkipVerify: true}
+		proxyUrl, err := StartTransportProxy(server.URL, transportConfig)
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(proxyUrl, "http://127.0.0.1:"))
+		sameProxyUrl, err := StartTransportProxy(server.URL, transportConfig)
+		assert.Nil(t, err)
+		assert.Equal(t, proxyUrl, sameProxyUrl)
+
+		request, _ := http.NewRequest(
+			http.MethodGet, proxyUrl+"/organizational-units?limit=1", nil)
+		request.Header.Set("Authorization", "Bearer test-token")
+		response, err := http.DefaultClient.Do(request)
+		assert.Nil(t, err)
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, `{"total_count":0}`, string(body))
+	})
+
+	// Tests that a request which cannot be forwarded, here as the proxy cannot be reached, fails
+	// with the error envelope of the Clumio API.
+	t.Run("Error envelope when the request cannot be forwarded", func(t *testing.T) {
+		server := httptest.NewTLSServer(
+			http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+		defer server.Close()
+
+		proxyUrl, err := StartTransportProxy(server.URL, TransportConfig{
+			HTTPSProxy: "http://127.0.0.1:1",
+		})
+		assert.Nil(t, err)
+
+		response, err := http.Get(proxyUrl + "/organizational-units")
+		assert.Nil(t, err)
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		assert.Equal(t, http.StatusBadGateway, response.StatusCode)
+		assert.Contains(t, string(body), `"error_code":502`)
+	})
+}
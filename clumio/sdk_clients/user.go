@@ -5,14 +5,96 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/clumio-code/clumio-go-sdk/controllers/users"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type UserClient interface {
 	users.UsersV2Client
 }
 
-func NewUserClient(config config.Config) UserClient {
-	return users.NewUsersV2(config)
+// NewUserClient returns a UserClient for the given config. Every call made through the client is
+// passed through the given middlewares, in order.
+func NewUserClient(
+	config config.Config, middlewares ...Middleware) UserClient {
+
+	client := users.NewUsersV2(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &userClient{client, chainMiddlewares(middlewares)}
+}
+
+// userClient is a UserClient which passes every call through a middleware.
+type userClient struct {
+	UserClient
+	middleware Middleware
+}
+
+func (c *userClient) ChangePassword(body *models.ChangePasswordV2Request) (
+	*models.ChangePasswordResponse, *apiutils.APIError) {
+
+	call := writeCall("ChangePassword", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.ChangePasswordResponse, *apiutils.APIError) {
+			return c.UserClient.ChangePassword(body)
+		})
+}
+
+func (c *userClient) CreateUser(body *models.CreateUserV2Request) (
+	*models.CreateUserResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateUser", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateUserResponse, *apiutils.APIError) {
+			return c.UserClient.CreateUser(body)
+		})
+}
+
+func (c *userClient) DeleteUser(userId int64) (interface{}, *apiutils.APIError) {
+	call := writeCall("DeleteUser", true, "userId", userId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.UserClient.DeleteUser(userId)
+		})
+}
+
+func (c *userClient) ListUsers(limit *int64, start *string, filter *string) (
+	*models.ListUsersResponse, *apiutils.APIError) {
+
+	call := readCall("ListUsers", "limit", limit, "start", start, "filter", filter)
+	return invoke(c.middleware, call,
+		func() (*models.ListUsersResponse, *apiutils.APIError) {
+			return c.UserClient.ListUsers(limit, start, filter)
+		})
+}
+
+func (c *userClient) ReadUser(userId int64) (*models.ReadUserResponse, *apiutils.APIError) {
+	call := readCall("ReadUser", "userId", userId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadUserResponse, *apiutils.APIError) {
+			return c.UserClient.ReadUser(userId)
+		})
+}
+
+func (c *userClient) UpdateUser(userId int64, body *models.UpdateUserV2Request) (
+	*models.UpdateUserResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateUser", true, "userId", userId, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.UpdateUserResponse, *apiutils.APIError) {
+			return c.UserClient.UpdateUser(userId, body)
+		})
+}
+
+func (c *userClient) UpdateUserProfile(body *models.UpdateUserProfileV2Request) (
+	*models.EditProfileResponse, *apiutils.APIError) {
+
+	call := writeCall("UpdateUserProfile", true, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.EditProfileResponse, *apiutils.APIError) {
+			return c.UserClient.UpdateUserProfile(body)
+		})
 }
@@ -5,14 +5,78 @@
 package sdkclients
 
 import (
+	apiutils "github.com/clumio-code/clumio-go-sdk/api_utils"
 	"github.com/clumio-code/clumio-go-sdk/config"
 	"github.com/clumio-code/clumio-go-sdk/controllers/wallets"
+	"github.com/clumio-code/clumio-go-sdk/models"
 )
 
 type WalletClient interface {
 	wallets.WalletsV1Client
 }
 
-func NewWalletClient(config config.Config) WalletClient {
-	return wallets.NewWalletsV1(config)
+// NewWalletClient returns a WalletClient for the given config. Every call made through the client
+// is passed through the given middlewares, in order.
+func NewWalletClient(
+	config config.Config, middlewares ...Middleware) WalletClient {
+
+	client := wallets.NewWalletsV1(config)
+	if len(middlewares) == 0 {
+		return client
+	}
+	return &walletClient{client, chainMiddlewares(middlewares)}
+}
+
+// walletClient is a WalletClient which passes every call through a middleware.
+type walletClient struct {
+	WalletClient
+	middleware Middleware
+}
+
+func (c *walletClient) CreateWallet(body *models.CreateWalletV1Request) (
+	*models.CreateWalletResponse, *apiutils.APIError) {
+
+	call := writeCall("CreateWallet", false, "body", body)
+	return invoke(c.middleware, call,
+		func() (*models.CreateWalletResponse, *apiutils.APIError) {
+			return c.WalletClient.CreateWallet(body)
+		})
+}
+
+func (c *walletClient) DeleteWallet(walletId string) (interface{}, *apiutils.APIError) {
+	call := writeCall("DeleteWallet", true, "walletId", walletId)
+	return invoke(c.middleware, call,
+		func() (interface{}, *apiutils.APIError) {
+			return c.WalletClient.DeleteWallet(walletId)
+		})
+}
+
+func (c *walletClient) ListWallets(limit *int64, start *string) (
+	*models.ListWalletsResponse, *apiutils.APIError) {
+
+	call := readCall("ListWallets", "limit", limit, "start", start)
+	return invoke(c.middleware, call,
+		func() (*models.ListWalletsResponse, *apiutils.APIError) {
+			return c.WalletClient.ListWallets(limit, start)
+		})
+}
+
+func (c *walletClient) ReadWallet(walletId string) (
+	*models.ReadWalletResponse, *apiutils.APIError) {
+
+	call := readCall("ReadWallet", "walletId", walletId)
+	return invoke(c.middleware, call,
+		func() (*models.ReadWalletResponse, *apiutils.APIError) {
+			return c.WalletClient.ReadWallet(walletId)
+		})
+}
+
+func (c *walletClient) RefreshWallet(walletId string) (
+	*models.RefreshWalletResponse, *apiutils.APIError) {
+
+	call := writeCall("RefreshWallet", false, "walletId", walletId)
+	return invoke(c.middleware, call,
+		func() (*models.RefreshWalletResponse, *apiutils.APIError) {
+			return c.WalletClient.RefreshWallet(walletId)
+		})
 }
@@ -3,3 +3,7 @@ data "clumio_policy" "example" {
   activation_status = "activated"
   operation_types = ["protection_group_backup", "aws_ebs_volume_backup"]
 }
+
+data "clumio_policy" "by_id" {
+  id = "example_policy_id"
+}
@@ -0,0 +1,15 @@
+data "clumio_policy_schedule_preview" "example" {
+  policy_id    = clumio_policy.example.id
+  start_time   = "2025-01-01T00:00:00Z"
+  horizon_days = 14
+}
+
+check "daily_rpo" {
+  assert {
+    condition = alltrue([
+      for schedule in data.clumio_policy_schedule_preview.example.schedules :
+      schedule.max_interval_minutes <= 28 * 60
+    ])
+    error_message = "Backups of the example policy can be more than 28 hours apart."
+  }
+}
@@ -74,39 +74,45 @@ resource "clumio_protection_group" "protection_group" {
 # Create a Clumio policy with support for S3 and EBS
 resource "clumio_policy" "policy" {
   name = "Gold"
-  operations {
-    action_setting = "immediate"
-    type           = "protection_group_backup"
-    slas {
-      retention_duration {
-        unit  = "months"
-        value = 3
-      }
-      rpo_frequency {
-        unit  = "days"
-        value = 7
-      }
-    }
-    advanced_settings {
-      protection_group_backup {
-        backup_tier = "cold"
-      }
-    }
-  }
-  operations {
-    action_setting = "immediate"
-    type           = "aws_ebs_volume_backup"
-    slas {
-      retention_duration {
-        unit  = "days"
-        value = 30
-      }
-      rpo_frequency {
-        unit  = "days"
-        value = 1
+  operations = [
+    {
+      action_setting = "immediate"
+      type           = "protection_group_backup"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "months"
+            value = 3
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 7
+          }
+        },
+      ]
+      advanced_settings = {
+        protection_group_backup = {
+          backup_tier = "cold"
+        }
       }
-    }
-  }
+    },
+    {
+      action_setting = "immediate"
+      type           = "aws_ebs_volume_backup"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "days"
+            value = 30
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 1
+          }
+        },
+      ]
+    },
+  ]
 }
 
 # Assign the policy to the protection group
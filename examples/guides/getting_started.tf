@@ -74,25 +74,29 @@ resource "clumio_protection_group" "protection_group" {
 # Create a Clumio policy for protection groups with a 7-day RPO and 3-month retention
 resource "clumio_policy" "policy" {
   name = "S3 Gold"
-  operations {
-    action_setting = "immediate"
-    type           = "protection_group_backup"
-    slas {
-      retention_duration {
-        unit  = "months"
-        value = 3
+  operations = [
+    {
+      action_setting = "immediate"
+      type           = "protection_group_backup"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "months"
+            value = 3
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 7
+          }
+        },
+      ]
+      advanced_settings = {
+        protection_group_backup = {
+          backup_tier = "cold"
+        }
       }
-      rpo_frequency {
-        unit  = "days"
-        value = 7
-      }
-    }
-    advanced_settings {
-      protection_group_backup {
-        backup_tier = "cold"
-      }
-    }
-  }
+    },
+  ]
 }
 
 # Assign the policy to the protection group
@@ -0,0 +1,7 @@
+list "clumio_aws_connection" "example" {
+  provider = clumio
+
+  config {
+    aws_region = "us-west-2"
+  }
+}
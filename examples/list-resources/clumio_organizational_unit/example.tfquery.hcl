@@ -0,0 +1,7 @@
+list "clumio_organizational_unit" "example" {
+  provider = clumio
+
+  config {
+    name = "example"
+  }
+}
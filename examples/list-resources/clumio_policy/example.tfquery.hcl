@@ -0,0 +1,8 @@
+list "clumio_policy" "example" {
+  provider = clumio
+
+  config {
+    activation_status = "activated"
+    operation_types   = ["protection_group_backup"]
+  }
+}
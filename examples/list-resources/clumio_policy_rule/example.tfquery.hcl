@@ -0,0 +1,7 @@
+list "clumio_policy_rule" "example" {
+  provider = clumio
+
+  config {
+    policy_id = "example_policy_id"
+  }
+}
@@ -0,0 +1,3 @@
+list "clumio_protection_group" "example" {
+  provider = clumio
+}
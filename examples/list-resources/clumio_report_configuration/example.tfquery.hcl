@@ -0,0 +1,3 @@
+list "clumio_report_configuration" "example" {
+  provider = clumio
+}
@@ -0,0 +1,7 @@
+list "clumio_user" "example" {
+  provider = clumio
+
+  config {
+    role_id = "example_role_id"
+  }
+}
@@ -0,0 +1,7 @@
+# Replace RULE_ID with the correct Clumio Auto User Provisioning Rule ID.
+import {
+  to = clumio_auto_user_provisioning_rule.example
+  identity = {
+    id = "RULE_ID"
+  }
+}
@@ -0,0 +1,2 @@
+# Replace RULE_ID with the correct Clumio Auto User Provisioning Rule ID.
+terraform import clumio_auto_user_provisioning_rule.example RULE_ID
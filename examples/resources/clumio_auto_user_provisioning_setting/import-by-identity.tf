@@ -0,0 +1,7 @@
+# The auto user provisioning setting is unique to the organization, so any ID can be used to import it.
+import {
+  to = clumio_auto_user_provisioning_setting.example
+  identity = {
+    id = "auto_user_provisioning_setting"
+  }
+}
@@ -0,0 +1,2 @@
+# The auto user provisioning setting is unique to the organization, so any ID can be used to import it.
+terraform import clumio_auto_user_provisioning_setting.example auto_user_provisioning_setting
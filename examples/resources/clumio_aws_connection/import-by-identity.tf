@@ -0,0 +1,8 @@
+# Replace the AWS account ID and region with the ones of the connected AWS account.
+import {
+  to = clumio_aws_connection.example
+  identity = {
+    account_native_id = "123456789012"
+    aws_region        = "us-west-2"
+  }
+}
@@ -0,0 +1,8 @@
+# Replace ACCOUNT_ID and AWS_REGION with the AWS Account ID and Region of the Clumio AWS Connection.
+import {
+  to = clumio_aws_manual_connection.example
+  identity = {
+    account_id = "ACCOUNT_ID"
+    aws_region = "AWS_REGION"
+  }
+}
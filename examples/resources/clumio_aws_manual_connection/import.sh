@@ -0,0 +1,2 @@
+# Replace <ACCOUNT_ID> and <AWS_REGION> with the AWS Account ID and Region of the Clumio AWS Connection.
+terraform import clumio_aws_manual_connection.example <ACCOUNT_ID>_<AWS_REGION>
@@ -0,0 +1,7 @@
+# Replace PROJECT_ID with the ID of the GCP project of the Clumio GCP Connection.
+import {
+  to = clumio_gcp_connection.example
+  identity = {
+    project_id = "PROJECT_ID"
+  }
+}
@@ -0,0 +1,2 @@
+# Replace PROJECT_ID with the ID of the GCP project of the Clumio GCP Connection.
+terraform import clumio_gcp_connection.example PROJECT_ID
@@ -0,0 +1,7 @@
+# The general settings are unique to the organization, so any ID can be used to import them.
+import {
+  to = clumio_general_settings.example
+  identity = {
+    id = "general_settings"
+  }
+}
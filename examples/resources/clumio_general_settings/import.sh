@@ -0,0 +1,2 @@
+# The general settings are unique to the organization, so any ID can be used to import them.
+terraform import clumio_general_settings.example general_settings
@@ -0,0 +1,7 @@
+# Replace OU_ID with the correct Clumio Organizational Unit ID.
+import {
+  to = clumio_organizational_unit.example
+  identity = {
+    id = "OU_ID"
+  }
+}
@@ -0,0 +1,7 @@
+# Replace POLICY_ID with the correct Clumio Policy ID.
+import {
+  to = clumio_policy.example
+  identity = {
+    id = "POLICY_ID"
+  }
+}
@@ -1,23 +1,27 @@
 resource "clumio_policy" "example_s3_protection_group" {
   name              = "example-policy-S3-Protection-Group"
   activation_status = "activated"
-  operations {
-    action_setting = "immediate"
-    type           = "protection_group_backup"
-    slas {
-      retention_duration {
-        unit  = "months"
-        value = 3
+  operations = [
+    {
+      action_setting = "immediate"
+      type           = "protection_group_backup"
+      slas = [
+        {
+          retention_duration = {
+            unit  = "months"
+            value = 3
+          }
+          rpo_frequency = {
+            unit  = "days"
+            value = 1
+          }
+        },
+      ]
+      advanced_settings = {
+        protection_group_backup = {
+          backup_tier = "cold"
+        }
       }
-      rpo_frequency {
-        unit  = "days"
-        value = 1
-      }
-    }
-    advanced_settings {
-      protection_group_backup {
-        backup_tier = "cold"
-      }
-    }
-  }
+    },
+  ]
 }
@@ -0,0 +1,10 @@
+# Replace ENTITY_TYPE, ENTITY_ID and POLICY_ID with the correct Entity Type, Entity ID and Clumio
+# Policy ID.
+import {
+  to = clumio_policy_assignment.example
+  identity = {
+    entity_type = "ENTITY_TYPE"
+    entity_id   = "ENTITY_ID"
+    policy_id   = "POLICY_ID"
+  }
+}
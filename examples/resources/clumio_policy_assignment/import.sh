@@ -1,2 +1,2 @@
-# Replace <POLICY_ID>, <ENTITY_ID and <ENTITY_TYPE> with the correct Clumio Policy ID, Entity ID and Entity Type.
-terraform import clumio_policy.example <POLICY_ID>_<ENTITY_ID>_<ENTITY_TYPE>
+# Replace <ENTITY_TYPE>, <ENTITY_ID> and <POLICY_ID> with the correct Entity Type, Entity ID and Clumio Policy ID.
+terraform import clumio_policy_assignment.example <ENTITY_TYPE>:<ENTITY_ID>:<POLICY_ID>
@@ -0,0 +1,7 @@
+# Replace POLICY_RULE_ID with the correct Clumio Policy Rule ID.
+import {
+  to = clumio_policy_rule.example
+  identity = {
+    id = "POLICY_RULE_ID"
+  }
+}
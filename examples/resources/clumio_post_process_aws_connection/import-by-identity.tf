@@ -0,0 +1,9 @@
+# Replace ACCOUNT_ID and AWS_REGION with the AWS Account ID and Region of the Clumio AWS Connection.
+# The remaining attributes are set by the next apply, which post-processes the connection again.
+import {
+  to = clumio_post_process_aws_connection.example
+  identity = {
+    account_id = "ACCOUNT_ID"
+    region     = "AWS_REGION"
+  }
+}
@@ -0,0 +1,3 @@
+# Replace <ACCOUNT_ID> and <AWS_REGION> with the AWS Account ID and Region of the Clumio AWS Connection.
+# The remaining attributes are set by the next apply, which post-processes the connection again.
+terraform import clumio_post_process_aws_connection.example <ACCOUNT_ID>/<AWS_REGION>
@@ -0,0 +1,8 @@
+# Replace PROJECT_ID with the ID of the GCP project of the Clumio GCP Connection.
+# The remaining attributes are set by the next apply, which post-processes the connection again.
+import {
+  to = clumio_post_process_gcp_connection.example
+  identity = {
+    project_id = "PROJECT_ID"
+  }
+}
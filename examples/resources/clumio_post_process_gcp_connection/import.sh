@@ -0,0 +1,3 @@
+# Replace PROJECT_ID with the ID of the GCP project of the Clumio GCP Connection.
+# The remaining attributes are set by the next apply, which post-processes the connection again.
+terraform import clumio_post_process_gcp_connection.example PROJECT_ID
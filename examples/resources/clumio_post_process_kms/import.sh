@@ -0,0 +1,3 @@
+# Replace <ACCOUNT_ID> and <AWS_REGION> with the AWS Account ID and Region of the Clumio Wallet.
+# The remaining attributes are set by the next apply, which post-processes the BYOK again.
+terraform import clumio_post_process_kms.example <ACCOUNT_ID>/<AWS_REGION>
@@ -0,0 +1,7 @@
+# Replace PROTECTION_GROUP_ID with the correct Clumio Protection Group ID.
+import {
+  to = clumio_protection_group.example
+  identity = {
+    id = "PROTECTION_GROUP_ID"
+  }
+}
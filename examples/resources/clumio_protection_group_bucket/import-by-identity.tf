@@ -0,0 +1,9 @@
+# Replace PROTECTION_GROUP_ID and BUCKET_ID with the correct Clumio Protection Group ID and
+# Clumio S3 bucket ID.
+import {
+  to = clumio_protection_group_bucket.example
+  identity = {
+    protection_group_id = "PROTECTION_GROUP_ID"
+    bucket_id           = "BUCKET_ID"
+  }
+}
@@ -0,0 +1,7 @@
+# Replace REPORT_CONFIGURATION_ID with the correct Clumio Report Configuration ID.
+import {
+  to = clumio_report_configuration.example
+  identity = {
+    id = "REPORT_CONFIGURATION_ID"
+  }
+}
@@ -0,0 +1,2 @@
+# Replace REPORT_CONFIGURATION_ID with the correct Clumio Report Configuration ID.
+terraform import clumio_report_configuration.example REPORT_CONFIGURATION_ID
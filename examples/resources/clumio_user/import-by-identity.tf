@@ -0,0 +1,7 @@
+# Replace USER_ID with the correct Clumio User ID.
+import {
+  to = clumio_user.example
+  identity = {
+    id = "USER_ID"
+  }
+}
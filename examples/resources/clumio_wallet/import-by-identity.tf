@@ -0,0 +1,7 @@
+# Replace WALLET_ID with the correct Clumio Wallet ID.
+import {
+  to = clumio_wallet.example
+  identity = {
+    id = "WALLET_ID"
+  }
+}